@@ -0,0 +1,417 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openstor/console/api/operations"
+	bucketApi "github.com/openstor/console/api/operations/bucket"
+	"github.com/openstor/console/models"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+)
+
+// bucketAccessGrantPolicyPrefix is the prefix of the canned policies generated for the bucket access grants
+const bucketAccessGrantPolicyPrefix = "grant-"
+
+// bucketAccessLevels are the access levels a prefix can be granted with, every level has its own policy
+var bucketAccessLevels = []string{
+	models.BucketAccessGrantRequestAccessReadonly,
+	models.BucketAccessGrantRequestAccessWriteonly,
+	models.BucketAccessGrantRequestAccessReadwrite,
+}
+
+func registerBucketAccessGrantsHandlers(api *operations.ConsoleAPI) {
+	// List the access grants of a bucket
+	api.BucketListBucketAccessGrantsHandler = bucketApi.ListBucketAccessGrantsHandlerFunc(func(params bucketApi.ListBucketAccessGrantsParams, session *models.Principal) middleware.Responder {
+		grantsResponse, err := getListBucketAccessGrantsResponse(session, params)
+		if err != nil {
+			return bucketApi.NewListBucketAccessGrantsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewListBucketAccessGrantsOK().WithPayload(grantsResponse)
+	})
+	// Grant access to a prefix of a bucket
+	api.BucketGrantBucketAccessHandler = bucketApi.GrantBucketAccessHandlerFunc(func(params bucketApi.GrantBucketAccessParams, session *models.Principal) middleware.Responder {
+		grant, err := getGrantBucketAccessResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGrantBucketAccessDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewGrantBucketAccessOK().WithPayload(grant)
+	})
+	// Revoke the access to a prefix of a bucket
+	api.BucketRevokeBucketAccessHandler = bucketApi.RevokeBucketAccessHandlerFunc(func(params bucketApi.RevokeBucketAccessParams, session *models.Principal) middleware.Responder {
+		err := getRevokeBucketAccessResponse(session, params)
+		if err != nil {
+			return bucketApi.NewRevokeBucketAccessDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewRevokeBucketAccessNoContent()
+	})
+}
+
+// getListBucketAccessGrantsResponse performs listBucketAccessGrants() and serializes it to the handler's output
+func getListBucketAccessGrantsResponse(session *models.Principal, params bucketApi.ListBucketAccessGrantsParams) (*models.BucketAccessGrantsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	grants, err := listBucketAccessGrants(ctx, adminClient, params.Bucket)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.BucketAccessGrantsResponse{Grants: grants}, nil
+}
+
+// getGrantBucketAccessResponse validates the request and performs grantBucketAccess()
+func getGrantBucketAccessResponse(session *models.Principal, params bucketApi.GrantBucketAccessParams) (*models.BucketAccessGrant, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body == nil || params.Body.Access == nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("an access level is required"))
+	}
+	if !slices.Contains(bucketAccessLevels, *params.Body.Access) {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("invalid access level %s", *params.Body.Access))
+	}
+	if len(params.Body.Users) == 0 && len(params.Body.Groups) == 0 {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("at least one user or group is required"))
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	grant, err := grantBucketAccess(ctx, adminClient, params.Bucket, params.Body.Prefix, *params.Body.Access, params.Body.Users, params.Body.Groups)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return grant, nil
+}
+
+// getRevokeBucketAccessResponse performs revokeBucketAccess()
+func getRevokeBucketAccessResponse(session *models.Principal, params bucketApi.RevokeBucketAccessParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body == nil {
+		return ErrorWithContext(ctx, ErrBadRequest, errors.New("a prefix is required"))
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	if err := revokeBucketAccess(ctx, adminClient, params.Bucket, params.Body.Prefix, params.Body.Users, params.Body.Groups); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// normalizeGrantPrefix returns the prefix as a folder, e.g. `reports/2025/`, grants to an empty prefix cover
+// the whole bucket
+func normalizeGrantPrefix(prefix string) string {
+	prefix = strings.TrimLeft(strings.TrimSpace(prefix), SlashSeparator)
+	if prefix != "" && !strings.HasSuffix(prefix, SlashSeparator) {
+		prefix += SlashSeparator
+	}
+	return prefix
+}
+
+// bucketAccessGrantPolicyName returns the deterministic name of the policy granting an access level to a
+// prefix. The prefix is hashed since it can contain characters not allowed in policy names.
+func bucketAccessGrantPolicyName(bucket, prefix, access string) string {
+	sum := sha256.Sum256([]byte(prefix))
+	return fmt.Sprintf("%s%s-%s-%s", bucketAccessGrantPolicyPrefix, bucket, access, hex.EncodeToString(sum[:4]))
+}
+
+type bucketAccessGrantStatement struct {
+	Effect    string
+	Action    []string
+	Resource  []string
+	Condition map[string]map[string][]string `json:",omitempty"`
+}
+
+// bucketAccessGrantPolicy returns the policy granting an access level to the objects under a prefix
+func bucketAccessGrantPolicy(bucket, prefix, access string) (*iampolicy.Policy, error) {
+	bucketResource := "arn:aws:s3:::" + bucket
+	objectsResource := bucketResource + SlashSeparator + prefix + "*"
+	bucketActions := []string{"s3:GetBucketLocation"}
+	var objectActions []string
+	switch access {
+	case models.BucketAccessGrantRequestAccessReadonly:
+		objectActions = []string{"s3:GetObject"}
+	case models.BucketAccessGrantRequestAccessWriteonly:
+		bucketActions = append(bucketActions, "s3:ListBucketMultipartUploads")
+		objectActions = []string{"s3:PutObject", "s3:AbortMultipartUpload", "s3:ListMultipartUploadParts"}
+	case models.BucketAccessGrantRequestAccessReadwrite:
+		bucketActions = append(bucketActions, "s3:ListBucketMultipartUploads")
+		objectActions = []string{"s3:GetObject", "s3:PutObject", "s3:DeleteObject", "s3:AbortMultipartUpload", "s3:ListMultipartUploadParts"}
+	default:
+		return nil, fmt.Errorf("invalid access level %s", access)
+	}
+	statements := []bucketAccessGrantStatement{
+		{Effect: "Allow", Action: bucketActions, Resource: []string{bucketResource}},
+	}
+	if access != models.BucketAccessGrantRequestAccessWriteonly {
+		// listing is restricted to the granted prefix
+		list := bucketAccessGrantStatement{Effect: "Allow", Action: []string{"s3:ListBucket"}, Resource: []string{bucketResource}}
+		if prefix != "" {
+			list.Condition = map[string]map[string][]string{"StringLike": {"s3:prefix": {prefix + "*"}}}
+		}
+		statements = append(statements, list)
+	}
+	statements = append(statements, bucketAccessGrantStatement{Effect: "Allow", Action: objectActions, Resource: []string{objectsResource}})
+	rawPolicy, err := json.Marshal(map[string]interface{}{"Version": "2012-10-17", "Statement": statements})
+	if err != nil {
+		return nil, err
+	}
+	return iampolicy.ParseConfig(bytes.NewReader(rawPolicy))
+}
+
+// parseBucketAccessGrant returns the prefix and access level of a policy generated for a grant on the bucket,
+// ok is false for any other policy
+func parseBucketAccessGrant(bucket, name string, policy *iampolicy.Policy) (prefix, access string, ok bool) {
+	namePrefix := bucketAccessGrantPolicyPrefix + bucket + "-"
+	if !strings.HasPrefix(name, namePrefix) {
+		return "", "", false
+	}
+	access, _, _ = strings.Cut(strings.TrimPrefix(name, namePrefix), "-")
+	if !slices.Contains(bucketAccessLevels, access) {
+		return "", "", false
+	}
+	rawPolicy, err := json.Marshal(policy)
+	if err != nil {
+		return "", "", false
+	}
+	var document lintDocument
+	if err := json.Unmarshal(rawPolicy, &document); err != nil {
+		return "", "", false
+	}
+	objectsResource := "arn:aws:s3:::" + bucket + SlashSeparator
+	for _, statement := range document.Statement {
+		for _, resource := range statement.Resource {
+			if strings.HasPrefix(resource, objectsResource) && strings.HasSuffix(resource, "*") {
+				prefix = strings.TrimSuffix(strings.TrimPrefix(resource, objectsResource), "*")
+				// the name tells apart the grants of buckets sharing the beginning of their name
+				return prefix, access, bucketAccessGrantPolicyName(bucket, prefix, access) == name
+			}
+		}
+	}
+	return "", "", false
+}
+
+// bucketAccessAttachments are the policies attached to every user and group
+type bucketAccessAttachments struct {
+	users  map[string][]string
+	groups map[string][]string
+}
+
+func getBucketAccessAttachments(ctx context.Context, client MinioAdmin) (*bucketAccessAttachments, error) {
+	attachments := &bucketAccessAttachments{users: map[string][]string{}, groups: map[string][]string{}}
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for accessKey, user := range users {
+		attachments.users[accessKey] = splitPolicyNames(user.PolicyName)
+	}
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		groupDesc, err := groupInfo(ctx, client, group)
+		if err != nil {
+			return nil, err
+		}
+		attachments.groups[group] = splitPolicyNames(groupDesc.Policy)
+	}
+	return attachments, nil
+}
+
+// holders returns the sorted users and groups a policy is attached to
+func (a *bucketAccessAttachments) holders(policy string) (users, groups []string) {
+	users, groups = []string{}, []string{}
+	for _, accessKey := range sortedKeys(a.users) {
+		if slices.Contains(a.users[accessKey], policy) {
+			users = append(users, accessKey)
+		}
+	}
+	for _, group := range sortedKeys(a.groups) {
+		if slices.Contains(a.groups[group], policy) {
+			groups = append(groups, group)
+		}
+	}
+	return users, groups
+}
+
+// listBucketAccessGrants returns the prefixes of the bucket granted through generated policies along with the
+// users and groups holding them. Generated policies attached to nobody aren't grants anymore and are skipped.
+func listBucketAccessGrants(ctx context.Context, client MinioAdmin, bucket string) ([]*models.BucketAccessGrant, error) {
+	policies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := getBucketAccessAttachments(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	grants := []*models.BucketAccessGrant{}
+	for _, name := range sortedKeys(policies) {
+		prefix, access, ok := parseBucketAccessGrant(bucket, name, policies[name])
+		if !ok {
+			continue
+		}
+		users, groups := attachments.holders(name)
+		if len(users) == 0 && len(groups) == 0 {
+			continue
+		}
+		grants = append(grants, &models.BucketAccessGrant{Prefix: prefix, Access: access, Policy: name, Users: users, Groups: groups})
+	}
+	sort.SliceStable(grants, func(i, j int) bool {
+		return grants[i].Prefix < grants[j].Prefix
+	})
+	return grants, nil
+}
+
+// grantBucketAccess creates the policy granting the access level to the prefix and attaches it to the users and
+// groups. Since an entity holds a single access level per prefix, the policies of the other levels are detached
+// from them, and removed once nobody holds them anymore.
+func grantBucketAccess(ctx context.Context, client MinioAdmin, bucket, prefix, access string, users, groups []string) (*models.BucketAccessGrant, error) {
+	prefix = normalizeGrantPrefix(prefix)
+	name := bucketAccessGrantPolicyName(bucket, prefix, access)
+	policy, err := bucketAccessGrantPolicy(bucket, prefix, access)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := getBucketAccessAttachments(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if _, ok := attachments.users[user]; !ok {
+			return nil, fmt.Errorf("user %s doesn't exist", user)
+		}
+	}
+	for _, group := range groups {
+		if _, ok := attachments.groups[group]; !ok {
+			return nil, fmt.Errorf("group %s doesn't exist", group)
+		}
+	}
+	// the policy is saved every time, so a grant made by an older console gets the current statements
+	if err := client.addPolicy(ctx, name, policy); err != nil {
+		return nil, err
+	}
+	update := func(entity string, current []string, isGroup bool) error {
+		for _, level := range bucketAccessLevels {
+			levelName := bucketAccessGrantPolicyName(bucket, prefix, level)
+			if level == access || !slices.Contains(current, levelName) {
+				continue
+			}
+			if err := client.detachPolicy(ctx, levelName, entity, isGroup); err != nil {
+				return err
+			}
+		}
+		if slices.Contains(current, name) {
+			return nil
+		}
+		return client.setPolicy(ctx, name, entity, isGroup)
+	}
+	for _, user := range users {
+		if err := update(user, attachments.users[user], false); err != nil {
+			return nil, err
+		}
+	}
+	for _, group := range groups {
+		if err := update(group, attachments.groups[group], true); err != nil {
+			return nil, err
+		}
+	}
+	if err := removeUnusedBucketAccessGrants(ctx, client, bucket, prefix); err != nil {
+		return nil, err
+	}
+
+	grant := &models.BucketAccessGrant{Prefix: prefix, Access: access, Policy: name, Users: []string{}, Groups: []string{}}
+	grants, err := listBucketAccessGrants(ctx, client, bucket)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range grants {
+		if g.Policy == name {
+			grant = g
+		}
+	}
+	return grant, nil
+}
+
+// revokeBucketAccess detaches the policies granting access to the prefix from the users and groups, or from
+// everyone holding them when no user or group is given, and removes the policies nobody holds anymore
+func revokeBucketAccess(ctx context.Context, client MinioAdmin, bucket, prefix string, users, groups []string) error {
+	prefix = normalizeGrantPrefix(prefix)
+	attachments, err := getBucketAccessAttachments(ctx, client)
+	if err != nil {
+		return err
+	}
+	revokeAll := len(users) == 0 && len(groups) == 0
+	for _, level := range bucketAccessLevels {
+		name := bucketAccessGrantPolicyName(bucket, prefix, level)
+		holderUsers, holderGroups := attachments.holders(name)
+		for _, user := range holderUsers {
+			if revokeAll || slices.Contains(users, user) {
+				if err := client.detachPolicy(ctx, name, user, false); err != nil {
+					return err
+				}
+			}
+		}
+		for _, group := range holderGroups {
+			if revokeAll || slices.Contains(groups, group) {
+				if err := client.detachPolicy(ctx, name, group, true); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return removeUnusedBucketAccessGrants(ctx, client, bucket, prefix)
+}
+
+// removeUnusedBucketAccessGrants removes the policies generated for the prefix that are attached to nobody
+func removeUnusedBucketAccessGrants(ctx context.Context, client MinioAdmin, bucket, prefix string) error {
+	policies, err := client.listPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	attachments, err := getBucketAccessAttachments(ctx, client)
+	if err != nil {
+		return err
+	}
+	for _, level := range bucketAccessLevels {
+		name := bucketAccessGrantPolicyName(bucket, prefix, level)
+		if _, ok := policies[name]; !ok {
+			continue
+		}
+		if users, groups := attachments.holders(name); len(users) == 0 && len(groups) == 0 {
+			if err := removePolicy(ctx, client, name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
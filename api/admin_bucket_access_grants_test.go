@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketAccessGrantPolicy(t *testing.T) {
+	funcAssert := assert.New(t)
+
+	funcAssert.Equal("reports/2025/", normalizeGrantPrefix("/reports/2025"))
+	funcAssert.Equal("", normalizeGrantPrefix(" "))
+	name := bucketAccessGrantPolicyName("data", "reports/", "readonly")
+	funcAssert.Equal(name, bucketAccessGrantPolicyName("data", "reports/", "readonly"))
+	funcAssert.True(strings.HasPrefix(name, "grant-data-readonly-"))
+	funcAssert.NotEqual(name, bucketAccessGrantPolicyName("data", "logs/", "readonly"))
+
+	policy, err := bucketAccessGrantPolicy("data", "reports/", "readonly")
+	funcAssert.NoError(err)
+	funcAssert.True(policy.IsAllowed(iampolicy.Args{Action: "s3:GetObject", BucketName: "data", ObjectName: "reports/q1.csv"}))
+	funcAssert.False(policy.IsAllowed(iampolicy.Args{Action: "s3:GetObject", BucketName: "data", ObjectName: "private/q1.csv"}))
+	funcAssert.False(policy.IsAllowed(iampolicy.Args{Action: "s3:PutObject", BucketName: "data", ObjectName: "reports/q1.csv"}))
+	funcAssert.False(policy.IsAllowed(iampolicy.Args{Action: "s3:GetObject", BucketName: "logs", ObjectName: "reports/q1.csv"}))
+
+	policy, err = bucketAccessGrantPolicy("data", "", "readwrite")
+	funcAssert.NoError(err)
+	funcAssert.True(policy.IsAllowed(iampolicy.Args{Action: "s3:DeleteObject", BucketName: "data", ObjectName: "any/object"}))
+
+	prefix, access, ok := parseBucketAccessGrant("data", name, mustBucketAccessGrantPolicy(t, "data", "reports/", "readonly"))
+	funcAssert.True(ok)
+	funcAssert.Equal("reports/", prefix)
+	funcAssert.Equal("readonly", access)
+	// a grant on bucket `data-readonly` isn't one of bucket `data`
+	_, _, ok = parseBucketAccessGrant("data", bucketAccessGrantPolicyName("data-readonly", "", "readonly"), mustBucketAccessGrantPolicy(t, "data-readonly", "", "readonly"))
+	funcAssert.False(ok)
+
+	_, err = bucketAccessGrantPolicy("data", "", "admin")
+	funcAssert.Error(err)
+}
+
+func mustBucketAccessGrantPolicy(t *testing.T, bucket, prefix, access string) *iampolicy.Policy {
+	policy, err := bucketAccessGrantPolicy(bucket, prefix, access)
+	assert.NoError(t, err)
+	return policy
+}
+
+func TestGrantBucketAccess(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+
+	policies := map[string]*iampolicy.Policy{"readwrite": {}}
+	userPolicies := map[string][]string{"alice": {"readwrite"}, "bob": {}}
+	groupPolicies := map[string][]string{"auditors": {}}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		// policies are returned the way the server does, decoded from their JSON
+		listed := map[string]*iampolicy.Policy{}
+		for name, policy := range policies {
+			rawPolicy, err := json.Marshal(policy)
+			funcAssert.NoError(err)
+			listed[name] = &iampolicy.Policy{}
+			funcAssert.NoError(json.Unmarshal(rawPolicy, listed[name]))
+		}
+		return listed, nil
+	}
+	minioAddPolicyMock = func(name string, policy *iampolicy.Policy) error {
+		policies[name] = policy
+		return nil
+	}
+	minioRemovePolicyMock = func(name string) error {
+		delete(policies, name)
+		return nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		users := map[string]madmin.UserInfo{}
+		for user, names := range userPolicies {
+			users[user] = madmin.UserInfo{PolicyName: strings.Join(names, ",")}
+		}
+		return users, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return sortedKeys(groupPolicies), nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Policy: strings.Join(groupPolicies[group], ",")}, nil
+	}
+	entityPolicies := func(isGroup bool) map[string][]string {
+		if isGroup {
+			return groupPolicies
+		}
+		return userPolicies
+	}
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		entities := entityPolicies(isGroup)
+		entities[entityName] = append(entities[entityName], policyName)
+		return nil
+	}
+	minioDetachPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		entities := entityPolicies(isGroup)
+		entities[entityName] = slices.DeleteFunc(entities[entityName], func(name string) bool { return name == policyName })
+		return nil
+	}
+	readonly := bucketAccessGrantPolicyName("data", "reports/", "readonly")
+	readwrite := bucketAccessGrantPolicyName("data", "reports/", "readwrite")
+
+	// Test-1: the generated policy is attached to the users and groups
+	grant, err := grantBucketAccess(ctx, adminClient, "data", "reports", "readonly", []string{"alice"}, []string{"auditors"})
+	funcAssert.NoError(err)
+	funcAssert.Equal(&models.BucketAccessGrant{Prefix: "reports/", Access: "readonly", Policy: readonly, Users: []string{"alice"}, Groups: []string{"auditors"}}, grant)
+	funcAssert.Equal([]string{"readwrite", readonly}, userPolicies["alice"])
+
+	// granting again doesn't attach the policy twice
+	_, err = grantBucketAccess(ctx, adminClient, "data", "reports/", "readonly", []string{"alice"}, nil)
+	funcAssert.NoError(err)
+	funcAssert.Len(userPolicies["alice"], 2)
+
+	// Test-2: changing the access level swaps the policies and removes the ones nobody holds
+	_, err = grantBucketAccess(ctx, adminClient, "data", "reports/", "readwrite", []string{"alice"}, []string{"auditors"})
+	funcAssert.NoError(err)
+	funcAssert.Equal([]string{"readwrite", readwrite}, userPolicies["alice"])
+	funcAssert.NotContains(policies, readonly)
+	grants, err := listBucketAccessGrants(ctx, adminClient, "data")
+	funcAssert.NoError(err)
+	funcAssert.Equal([]*models.BucketAccessGrant{
+		{Prefix: "reports/", Access: "readwrite", Policy: readwrite, Users: []string{"alice"}, Groups: []string{"auditors"}},
+	}, grants)
+
+	// Test-3: unknown users are rejected
+	_, err = grantBucketAccess(ctx, adminClient, "data", "reports/", "readonly", []string{"mallory"}, nil)
+	funcAssert.Error(err)
+	funcAssert.NotContains(policies, readonly)
+
+	// Test-4: revoking some entities keeps the grant for the others
+	funcAssert.NoError(revokeBucketAccess(ctx, adminClient, "data", "reports", nil, []string{"auditors"}))
+	funcAssert.Empty(groupPolicies["auditors"])
+	funcAssert.Contains(policies, readwrite)
+
+	// Test-5: revoking everyone removes the policy
+	funcAssert.NoError(revokeBucketAccess(ctx, adminClient, "data", "reports/", nil, nil))
+	funcAssert.Equal([]string{"readwrite"}, userPolicies["alice"])
+	funcAssert.NotContains(policies, readwrite)
+	grants, err = listBucketAccessGrants(ctx, adminClient, "data")
+	funcAssert.NoError(err)
+	funcAssert.Empty(grants)
+}
@@ -37,11 +37,15 @@ var (
 
 	minioServerHealthInfoMock func(ctx context.Context, deadline time.Duration) (interface{}, string, error)
 
+	minioListIDPConfigMock func(ctx context.Context, idpType string) ([]madmin.IDPListItem, error)
+	minioGetIDPConfigMock  func(ctx context.Context, cfgType, cfgName string) (madmin.IDPConfig, error)
+
 	minioListPoliciesMock func() (map[string]*iampolicy.Policy, error)
 	minioGetPolicyMock    func(name string) (*iampolicy.Policy, error)
 	minioRemovePolicyMock func(name string) error
 	minioAddPolicyMock    func(name string, policy *iampolicy.Policy) error
 	minioSetPolicyMock    func(policyName, entityName string, isGroup bool) error
+	minioDetachPolicyMock func(policyName, entityName string, isGroup bool) error
 
 	minioStartProfiling func(profiler madmin.ProfilerType, duration time.Duration) (io.ReadCloser, error)
 
@@ -75,6 +79,8 @@ var (
 	minioInfoServiceAccountMock    func(ctx context.Context, serviceAccount string) (madmin.InfoServiceAccountResp, error)
 	minioUpdateServiceAccountMock  func(ctx context.Context, serviceAccount string, opts madmin.UpdateServiceAccountReq) error
 	minioGetLDAPPolicyEntitiesMock func(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error)
+	minioAttachPolicyLDAPMock      func(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error)
+	minioDetachPolicyLDAPMock      func(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error)
 
 	minioListRemoteBucketsMock func(ctx context.Context, bucket, arnType string) (targets []madmin.BucketTarget, err error)
 	minioGetRemoteBucketMock   func(ctx context.Context, bucket, arnType string) (targets *madmin.BucketTarget, err error)
@@ -171,16 +177,16 @@ func (ac AdminClientMock) addOrUpdateIDPConfig(_ context.Context, _, _, _ string
 	return true, nil
 }
 
-func (ac AdminClientMock) listIDPConfig(_ context.Context, _ string) ([]madmin.IDPListItem, error) {
-	return []madmin.IDPListItem{{Name: "mock"}}, nil
+func (ac AdminClientMock) listIDPConfig(ctx context.Context, idpType string) ([]madmin.IDPListItem, error) {
+	return minioListIDPConfigMock(ctx, idpType)
 }
 
 func (ac AdminClientMock) deleteIDPConfig(_ context.Context, _, _ string) (restart bool, err error) {
 	return true, nil
 }
 
-func (ac AdminClientMock) getIDPConfig(_ context.Context, _, _ string) (c madmin.IDPConfig, err error) {
-	return madmin.IDPConfig{Info: []madmin.IDPCfgInfo{{Key: "mock", Value: "mock"}}}, nil
+func (ac AdminClientMock) getIDPConfig(ctx context.Context, cfgType, cfgName string) (c madmin.IDPConfig, err error) {
+	return minioGetIDPConfigMock(ctx, cfgType, cfgName)
 }
 
 func (ac AdminClientMock) kmsStatus(_ context.Context) (madmin.KMSStatus, error) {
@@ -234,6 +240,10 @@ func (ac AdminClientMock) setPolicy(_ context.Context, policyName, entityName st
 	return minioSetPolicyMock(policyName, entityName, isGroup)
 }
 
+func (ac AdminClientMock) detachPolicy(_ context.Context, policyName, entityName string, isGroup bool) error {
+	return minioDetachPolicyMock(policyName, entityName, isGroup)
+}
+
 // mock function for startProfiling()
 func (ac AdminClientMock) startProfiling(_ context.Context, profiler madmin.ProfilerType, duration time.Duration) (io.ReadCloser, error) {
 	return minioStartProfiling(profiler, duration)
@@ -335,3 +345,11 @@ func (ac AdminClientMock) updateServiceAccount(ctx context.Context, serviceAccou
 func (ac AdminClientMock) getLDAPPolicyEntities(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
 	return minioGetLDAPPolicyEntitiesMock(ctx, query)
 }
+
+func (ac AdminClientMock) attachPolicyLDAP(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+	return minioAttachPolicyLDAPMock(ctx, req)
+}
+
+func (ac AdminClientMock) detachPolicyLDAP(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+	return minioDetachPolicyLDAPMock(ctx, req)
+}
@@ -0,0 +1,754 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openstor/console/api/operations"
+	cfgApi "github.com/openstor/console/api/operations/configuration"
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+)
+
+// iamBundleVersion is the version of the IAM bundle format, bumped on breaking changes
+const iamBundleVersion = 1
+
+// Conflict strategies applied when an entity of the bundle differs from the existing one
+const (
+	iamImportConflictFail      = "fail"
+	iamImportConflictSkip      = "skip"
+	iamImportConflictOverwrite = "overwrite"
+)
+
+// Actions reported for every entity of an imported bundle
+const (
+	iamImportActionCreate    = "create"
+	iamImportActionUpdate    = "update"
+	iamImportActionUnchanged = "unchanged"
+	iamImportActionSkip      = "skip"
+	iamImportActionConflict  = "conflict"
+)
+
+const (
+	iamEntityPolicy         = "policy"
+	iamEntityUser           = "user"
+	iamEntityGroup          = "group"
+	iamEntityPolicyMapping  = "policyMapping"
+	iamEntityServiceAccount = "serviceAccount"
+)
+
+// iamGeneratedSecretLength is the length of the secret keys of the users and service accounts created on import,
+// secrets are never exported so they have to be reset once the bundle is imported
+const iamGeneratedSecretLength = 40
+
+func registerIAMBundleHandlers(api *operations.ConsoleAPI) {
+	// Export IAM entities as a bundle
+	api.ConfigurationExportIAMHandler = cfgApi.ExportIAMHandlerFunc(func(params cfgApi.ExportIAMParams, session *models.Principal) middleware.Responder {
+		bundle, err := getExportIAMResponse(session, params)
+		if err != nil {
+			return cfgApi.NewExportIAMDefault(err.Code).WithPayload(err.APIError)
+		}
+		return cfgApi.NewExportIAMOK().WithPayload(bundle)
+	})
+	// Import an IAM bundle
+	api.ConfigurationImportIAMHandler = cfgApi.ImportIAMHandlerFunc(func(params cfgApi.ImportIAMParams, session *models.Principal) middleware.Responder {
+		importResponse, err := getImportIAMResponse(session, params)
+		if err != nil {
+			return cfgApi.NewImportIAMDefault(err.Code).WithPayload(err.APIError)
+		}
+		return cfgApi.NewImportIAMOK().WithPayload(importResponse)
+	})
+}
+
+// getExportIAMResponse performs exportIAM() and serializes it to the handler's output
+func getExportIAMResponse(session *models.Principal, params cfgApi.ExportIAMParams) (*models.IamBundle, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	bundle, err := exportIAM(ctx, adminClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return bundle, nil
+}
+
+// getImportIAMResponse validates the bundle and performs importIAM()
+func getImportIAMResponse(session *models.Principal, params cfgApi.ImportIAMParams) (*models.IamImportResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body == nil || params.Body.Bundle == nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("an IAM bundle is required"))
+	}
+	strategy := params.Body.ConflictStrategy
+	if strategy == "" {
+		strategy = iamImportConflictFail
+	}
+	if strategy != iamImportConflictFail && strategy != iamImportConflictSkip && strategy != iamImportConflictOverwrite {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("invalid conflict strategy %s", strategy))
+	}
+	if err := validateIAMBundle(params.Body.Bundle); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	importResponse, err := importIAM(ctx, adminClient, params.Body.Bundle, strategy, params.Body.DryRun)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return importResponse, nil
+}
+
+// exportIAM returns the canned policies, users, groups, policy mappings and service accounts of the
+// built-in identity provider. Secrets are never exported.
+func exportIAM(ctx context.Context, client MinioAdmin) (*models.IamBundle, error) {
+	bundle := &models.IamBundle{
+		Version:    iamBundleVersion,
+		ExportedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	policies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range sortedKeys(policies) {
+		rawPolicy, err := canonicalPolicyJSON(policies[name])
+		if err != nil {
+			return nil, err
+		}
+		bundle.Policies = append(bundle.Policies, &models.IamBundlePolicy{Name: name, Policy: string(rawPolicy)})
+	}
+
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, accessKey := range sortedKeys(users) {
+		user := users[accessKey]
+		bundle.Users = append(bundle.Users, &models.IamBundleUser{AccessKey: accessKey, Status: string(user.Status)})
+		if userPolicies := splitPolicyNames(user.PolicyName); len(userPolicies) > 0 {
+			bundle.PolicyMappings = append(bundle.PolicyMappings, &models.IamBundlePolicyMapping{
+				EntityType: iamEntityUser,
+				Entity:     accessKey,
+				Policies:   userPolicies,
+			})
+		}
+	}
+
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(groups)
+	for _, group := range groups {
+		groupDesc, err := groupInfo(ctx, client, group)
+		if err != nil {
+			return nil, err
+		}
+		members := append([]string{}, groupDesc.Members...)
+		sort.Strings(members)
+		bundle.Groups = append(bundle.Groups, &models.IamBundleGroup{Name: group, Status: groupDesc.Status, Members: members})
+		if groupPolicies := splitPolicyNames(groupDesc.Policy); len(groupPolicies) > 0 {
+			bundle.PolicyMappings = append(bundle.PolicyMappings, &models.IamBundlePolicyMapping{
+				EntityType: iamEntityGroup,
+				Entity:     group,
+				Policies:   groupPolicies,
+			})
+		}
+	}
+
+	for _, accessKey := range sortedKeys(users) {
+		serviceAccounts, err := client.listServiceAccounts(ctx, accessKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, sa := range serviceAccounts.Accounts {
+			saInfo, err := client.infoServiceAccount(ctx, sa.AccessKey)
+			if err != nil {
+				return nil, err
+			}
+			bundle.ServiceAccounts = append(bundle.ServiceAccounts, serviceAccountToBundle(sa.AccessKey, saInfo))
+		}
+	}
+	return bundle, nil
+}
+
+func serviceAccountToBundle(accessKey string, saInfo madmin.InfoServiceAccountResp) *models.IamBundleServiceAccount {
+	sa := &models.IamBundleServiceAccount{
+		AccessKey:     accessKey,
+		ParentUser:    saInfo.ParentUser,
+		Name:          saInfo.Name,
+		Description:   saInfo.Description,
+		Status:        saInfo.AccountStatus,
+		ImpliedPolicy: saInfo.ImpliedPolicy,
+	}
+	if saInfo.Expiration != nil {
+		sa.Expiration = saInfo.Expiration.Format(time.RFC3339)
+	}
+	if !saInfo.ImpliedPolicy {
+		sa.Policy = saInfo.Policy
+	}
+	return sa
+}
+
+// validateIAMBundle checks the bundle can be imported before any change is planned
+func validateIAMBundle(bundle *models.IamBundle) error {
+	if bundle.Version != iamBundleVersion {
+		return fmt.Errorf("unsupported IAM bundle version %d", bundle.Version)
+	}
+	for _, p := range bundle.Policies {
+		if p.Name == "" {
+			return fmt.Errorf("policy name is required")
+		}
+		if _, err := iampolicy.ParseConfig(strings.NewReader(p.Policy)); err != nil {
+			return fmt.Errorf("invalid policy %s: %v", p.Name, err)
+		}
+	}
+	for _, u := range bundle.Users {
+		if u.AccessKey == "" {
+			return fmt.Errorf("user access key is required")
+		}
+	}
+	for _, g := range bundle.Groups {
+		if g.Name == "" {
+			return fmt.Errorf("group name is required")
+		}
+	}
+	for _, m := range bundle.PolicyMappings {
+		if m.EntityType != iamEntityUser && m.EntityType != iamEntityGroup {
+			return fmt.Errorf("invalid policy mapping entity type %s", m.EntityType)
+		}
+	}
+	for _, sa := range bundle.ServiceAccounts {
+		if sa.AccessKey == "" || sa.ParentUser == "" {
+			return fmt.Errorf("service account access key and parent user are required")
+		}
+		if sa.Expiration != "" {
+			if _, err := time.Parse(time.RFC3339, sa.Expiration); err != nil {
+				return fmt.Errorf("invalid expiration of service account %s: %v", sa.AccessKey, err)
+			}
+		}
+		if sa.Policy != "" {
+			if _, err := iampolicy.ParseConfig(strings.NewReader(sa.Policy)); err != nil {
+				return fmt.Errorf("invalid policy of service account %s: %v", sa.AccessKey, err)
+			}
+		}
+	}
+	return nil
+}
+
+// iamImportStep is a change planned for an entity of the bundle, apply is nil when nothing has to be done
+type iamImportStep struct {
+	change *models.IamImportChange
+	apply  func(ctx context.Context) error
+}
+
+type iamImportPlan struct {
+	strategy  string
+	steps     []iamImportStep
+	conflicts int
+}
+
+func (p *iamImportPlan) add(entityType, name, action, detail string, apply func(ctx context.Context) error) {
+	p.steps = append(p.steps, iamImportStep{
+		change: &models.IamImportChange{EntityType: entityType, Name: name, Action: action, Detail: detail},
+		apply:  apply,
+	})
+}
+
+// conflict records an entity differing from the existing one according to the conflict strategy, entities
+// with a nil overwrite function can't be overwritten and are always reported as a conflict unless skipped
+func (p *iamImportPlan) conflict(entityType, name, detail string, overwrite func(ctx context.Context) error) {
+	switch {
+	case p.strategy == iamImportConflictSkip:
+		p.add(entityType, name, iamImportActionSkip, detail, nil)
+	case p.strategy == iamImportConflictOverwrite && overwrite != nil:
+		p.add(entityType, name, iamImportActionUpdate, detail, overwrite)
+	default:
+		p.add(entityType, name, iamImportActionConflict, detail, nil)
+		p.conflicts++
+	}
+}
+
+// importIAM plans the changes required to import the bundle and applies them unless it's a dry run. Entities
+// are imported in dependency order: policies, users, groups, policy mappings and finally service accounts.
+func importIAM(ctx context.Context, client MinioAdmin, bundle *models.IamBundle, strategy string, dryRun bool) (*models.IamImportResponse, error) {
+	plan, err := planIAMImport(ctx, client, bundle, strategy)
+	if err != nil {
+		return nil, err
+	}
+	response := &models.IamImportResponse{DryRun: dryRun, Changes: []*models.IamImportChange{}}
+	for _, step := range plan.steps {
+		response.Changes = append(response.Changes, step.change)
+	}
+	if dryRun {
+		return response, nil
+	}
+	if plan.conflicts > 0 {
+		return nil, fmt.Errorf("%w: %d entities differ from the bundle", ErrIAMImportConflict, plan.conflicts)
+	}
+	if err := checkIAMImportLintGate(ctx, client, bundle, plan, getPolicyLintGate()); err != nil {
+		return nil, err
+	}
+	for _, step := range plan.steps {
+		if step.apply == nil {
+			continue
+		}
+		if err := step.apply(ctx); err != nil {
+			return nil, fmt.Errorf("unable to import %s %s: %w", step.change.EntityType, step.change.Name, err)
+		}
+	}
+	return response, nil
+}
+
+// checkIAMImportLintGate runs the policy lint gate on the policies the import creates or overwrites, before any
+// change is applied
+func checkIAMImportLintGate(ctx context.Context, client MinioAdmin, bundle *models.IamBundle, plan *iamImportPlan, gate string) error {
+	documents := map[string]string{}
+	for _, p := range bundle.Policies {
+		documents[p.Name] = p.Policy
+	}
+	for _, step := range plan.steps {
+		if step.apply == nil || step.change.EntityType != iamEntityPolicy {
+			continue
+		}
+		if err := checkPolicyLintGate(ctx, client, documents[step.change.Name], gate); err != nil {
+			return fmt.Errorf("policy %s: %w", step.change.Name, err)
+		}
+	}
+	return nil
+}
+
+func planIAMImport(ctx context.Context, client MinioAdmin, bundle *models.IamBundle, strategy string) (*iamImportPlan, error) {
+	plan := &iamImportPlan{strategy: strategy}
+	if err := planPoliciesImport(ctx, client, bundle, plan); err != nil {
+		return nil, err
+	}
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	planUsersImport(client, bundle, users, plan)
+	groups, err := planGroupsImport(ctx, client, bundle, plan)
+	if err != nil {
+		return nil, err
+	}
+	planPolicyMappingsImport(client, bundle, users, groups, plan)
+	if err := planServiceAccountsImport(ctx, client, bundle, users, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func planPoliciesImport(ctx context.Context, client MinioAdmin, bundle *models.IamBundle, plan *iamImportPlan) error {
+	existing, err := client.listPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range bundle.Policies {
+		policy, err := iampolicy.ParseConfig(strings.NewReader(p.Policy))
+		if err != nil {
+			return err
+		}
+		name := p.Name
+		apply := func(ctx context.Context) error {
+			return client.addPolicy(ctx, name, policy)
+		}
+		current, ok := existing[name]
+		if !ok {
+			plan.add(iamEntityPolicy, name, iamImportActionCreate, "", apply)
+			continue
+		}
+		equal, err := policiesEqual(current, policy)
+		if err != nil {
+			return err
+		}
+		if equal {
+			plan.add(iamEntityPolicy, name, iamImportActionUnchanged, "", nil)
+			continue
+		}
+		plan.conflict(iamEntityPolicy, name, "policy document differs", apply)
+	}
+	return nil
+}
+
+func planUsersImport(client MinioAdmin, bundle *models.IamBundle, users map[string]madmin.UserInfo, plan *iamImportPlan) {
+	for _, u := range bundle.Users {
+		accessKey := u.AccessKey
+		status := madmin.AccountEnabled
+		if u.Status == string(madmin.AccountDisabled) {
+			status = madmin.AccountDisabled
+		}
+		current, ok := users[accessKey]
+		if !ok {
+			plan.add(iamEntityUser, accessKey, iamImportActionCreate, "created with a random secret key", func(ctx context.Context) error {
+				if err := client.addUser(ctx, accessKey, RandomCharString(iamGeneratedSecretLength)); err != nil {
+					return err
+				}
+				if status == madmin.AccountDisabled {
+					return client.setUserStatus(ctx, accessKey, status)
+				}
+				return nil
+			})
+			continue
+		}
+		if current.Status == status {
+			plan.add(iamEntityUser, accessKey, iamImportActionUnchanged, "", nil)
+			continue
+		}
+		plan.conflict(iamEntityUser, accessKey, fmt.Sprintf("status is %s, bundle has %s", current.Status, status), func(ctx context.Context) error {
+			return client.setUserStatus(ctx, accessKey, status)
+		})
+	}
+}
+
+// planGroupsImport plans the import of the groups and returns the description of the existing ones
+func planGroupsImport(ctx context.Context, client MinioAdmin, bundle *models.IamBundle, plan *iamImportPlan) (map[string]*madmin.GroupDesc, error) {
+	groupNames, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// only the groups referenced by the bundle are described
+	referenced := map[string]bool{}
+	for _, g := range bundle.Groups {
+		referenced[g.Name] = true
+	}
+	for _, m := range bundle.PolicyMappings {
+		if m.EntityType == iamEntityGroup {
+			referenced[m.Entity] = true
+		}
+	}
+	groups := map[string]*madmin.GroupDesc{}
+	for _, name := range groupNames {
+		if !referenced[name] {
+			continue
+		}
+		groupDesc, err := groupInfo(ctx, client, name)
+		if err != nil {
+			return nil, err
+		}
+		groups[name] = groupDesc
+	}
+	for _, g := range bundle.Groups {
+		name := g.Name
+		members := g.Members
+		status := madmin.GroupEnabled
+		if g.Status == string(madmin.GroupDisabled) {
+			status = madmin.GroupDisabled
+		}
+		current, ok := groups[name]
+		if !ok {
+			plan.add(iamEntityGroup, name, iamImportActionCreate, "", func(ctx context.Context) error {
+				if err := client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: members}); err != nil {
+					return err
+				}
+				if status == madmin.GroupDisabled {
+					return client.setGroupStatus(ctx, name, status)
+				}
+				return nil
+			})
+			continue
+		}
+		missing := DifferenceArrays(members, current.Members)
+		extra := DifferenceArrays(current.Members, members)
+		statusChanged := current.Status != string(status)
+		if len(missing) == 0 && len(extra) == 0 && !statusChanged {
+			plan.add(iamEntityGroup, name, iamImportActionUnchanged, "", nil)
+			continue
+		}
+		var details []string
+		if len(missing) > 0 {
+			details = append(details, "missing members "+strings.Join(missing, ", "))
+		}
+		if len(extra) > 0 {
+			details = append(details, "extra members "+strings.Join(extra, ", "))
+		}
+		if statusChanged {
+			details = append(details, fmt.Sprintf("status is %s, bundle has %s", current.Status, status))
+		}
+		plan.conflict(iamEntityGroup, name, strings.Join(details, "; "), func(ctx context.Context) error {
+			if len(missing) > 0 {
+				if err := client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: missing}); err != nil {
+					return err
+				}
+			}
+			if len(extra) > 0 {
+				if err := client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: extra, IsRemove: true}); err != nil {
+					return err
+				}
+			}
+			if statusChanged {
+				return client.setGroupStatus(ctx, name, status)
+			}
+			return nil
+		})
+	}
+	return groups, nil
+}
+
+func planPolicyMappingsImport(client MinioAdmin, bundle *models.IamBundle, users map[string]madmin.UserInfo, groups map[string]*madmin.GroupDesc, plan *iamImportPlan) {
+	// the users and groups of the bundle without a mapping have no policies, the ones they have on the deployment
+	// are detached. The entities missing from the bundle are left as they are.
+	mappings := append([]*models.IamBundlePolicyMapping{}, bundle.PolicyMappings...)
+	mapped := map[string]bool{}
+	for _, m := range bundle.PolicyMappings {
+		mapped[m.EntityType+":"+m.Entity] = true
+	}
+	for _, u := range bundle.Users {
+		if !mapped[iamEntityUser+":"+u.AccessKey] && len(splitPolicyNames(users[u.AccessKey].PolicyName)) > 0 {
+			mappings = append(mappings, &models.IamBundlePolicyMapping{EntityType: iamEntityUser, Entity: u.AccessKey})
+		}
+	}
+	for _, g := range bundle.Groups {
+		if groupDesc, ok := groups[g.Name]; ok && !mapped[iamEntityGroup+":"+g.Name] && len(splitPolicyNames(groupDesc.Policy)) > 0 {
+			mappings = append(mappings, &models.IamBundlePolicyMapping{EntityType: iamEntityGroup, Entity: g.Name})
+		}
+	}
+	for _, m := range mappings {
+		entity := m.Entity
+		isGroup := m.EntityType == iamEntityGroup
+		policies := append([]string{}, m.Policies...)
+		sort.Strings(policies)
+		name := m.EntityType + ":" + entity
+		var current []string
+		if isGroup {
+			if groupDesc, ok := groups[entity]; ok {
+				current = splitPolicyNames(groupDesc.Policy)
+			}
+		} else if userInfo, ok := users[entity]; ok {
+			current = splitPolicyNames(userInfo.PolicyName)
+		}
+		// attaching only adds policies, the ones the bundle doesn't have are detached for the mapping to match it
+		apply := func(ctx context.Context) error {
+			for _, policy := range current {
+				if !slices.Contains(policies, policy) {
+					if err := client.detachPolicy(ctx, policy, entity, isGroup); err != nil {
+						return err
+					}
+				}
+			}
+			for _, policy := range policies {
+				if !slices.Contains(current, policy) {
+					if err := client.setPolicy(ctx, policy, entity, isGroup); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+		switch {
+		case len(current) == 0:
+			plan.add(iamEntityPolicyMapping, name, iamImportActionCreate, "", apply)
+		case slices.Equal(current, policies):
+			plan.add(iamEntityPolicyMapping, name, iamImportActionUnchanged, "", nil)
+		default:
+			bundlePolicies := strings.Join(policies, ",")
+			if bundlePolicies == "" {
+				bundlePolicies = "none"
+			}
+			plan.conflict(iamEntityPolicyMapping, name, fmt.Sprintf("policies are %s, bundle has %s", strings.Join(current, ","), bundlePolicies), apply)
+		}
+	}
+}
+
+func planServiceAccountsImport(ctx context.Context, client MinioAdmin, bundle *models.IamBundle, users map[string]madmin.UserInfo, plan *iamImportPlan) error {
+	// service accounts are listed per parent, parents missing from the deployment can't have any
+	existing := map[string]bool{}
+	for _, sa := range bundle.ServiceAccounts {
+		if _, ok := users[sa.ParentUser]; !ok || existing[sa.ParentUser] {
+			continue
+		}
+		existing[sa.ParentUser] = true
+		serviceAccounts, err := client.listServiceAccounts(ctx, sa.ParentUser)
+		if err != nil {
+			return err
+		}
+		for _, account := range serviceAccounts.Accounts {
+			existing["sa:"+account.AccessKey] = true
+		}
+	}
+	for _, sa := range bundle.ServiceAccounts {
+		accessKey := sa.AccessKey
+		var expiry *time.Time
+		if sa.Expiration != "" {
+			expiration, err := time.Parse(time.RFC3339, sa.Expiration)
+			if err != nil {
+				return err
+			}
+			expiry = &expiration
+		}
+		policy := sa.Policy
+		if sa.ImpliedPolicy {
+			policy = ""
+		}
+		if !existing["sa:"+accessKey] {
+			if expiry != nil && expiry.Before(time.Now()) {
+				plan.add(iamEntityServiceAccount, accessKey, iamImportActionSkip, "service account is expired", nil)
+				continue
+			}
+			parent, name, description, status := sa.ParentUser, sa.Name, sa.Description, sa.Status
+			plan.add(iamEntityServiceAccount, accessKey, iamImportActionCreate, "created with a random secret key", func(ctx context.Context) error {
+				if _, err := client.addServiceAccount(ctx, policy, parent, accessKey, RandomCharString(iamGeneratedSecretLength), name, description, expiry); err != nil {
+					return err
+				}
+				if status == "off" {
+					return updateServiceAccountDetails(ctx, client, accessKey, "", nil, "", "", status, "")
+				}
+				return nil
+			})
+			continue
+		}
+		current, err := client.infoServiceAccount(ctx, accessKey)
+		if err != nil {
+			return err
+		}
+		if current.ParentUser != sa.ParentUser {
+			plan.conflict(iamEntityServiceAccount, accessKey, fmt.Sprintf("parent user is %s, bundle has %s", current.ParentUser, sa.ParentUser), nil)
+			continue
+		}
+		details, err := serviceAccountDifferences(serviceAccountToBundle(accessKey, current), sa)
+		if err != nil {
+			return err
+		}
+		if len(details) == 0 {
+			plan.add(iamEntityServiceAccount, accessKey, iamImportActionUnchanged, "", nil)
+			continue
+		}
+		name, description, status := sa.Name, sa.Description, sa.Status
+		plan.conflict(iamEntityServiceAccount, accessKey, strings.Join(details, "; "), func(ctx context.Context) error {
+			return updateServiceAccountDetails(ctx, client, accessKey, policy, expiry, name, description, status, "")
+		})
+	}
+	return nil
+}
+
+// serviceAccountDifferences returns the fields of the service account differing from the bundle
+func serviceAccountDifferences(current, sa *models.IamBundleServiceAccount) ([]string, error) {
+	var details []string
+	if current.Name != sa.Name {
+		details = append(details, "name differs")
+	}
+	if current.Description != sa.Description {
+		details = append(details, "description differs")
+	}
+	if current.Status != sa.Status {
+		details = append(details, fmt.Sprintf("status is %s, bundle has %s", current.Status, sa.Status))
+	}
+	if current.Expiration != sa.Expiration {
+		details = append(details, "expiration differs")
+	}
+	switch {
+	case current.ImpliedPolicy != sa.ImpliedPolicy || (current.Policy == "") != (sa.Policy == ""):
+		details = append(details, "policy differs")
+	case current.Policy != "":
+		currentPolicy, err := iampolicy.ParseConfig(strings.NewReader(current.Policy))
+		if err != nil {
+			return nil, err
+		}
+		policy, err := iampolicy.ParseConfig(strings.NewReader(sa.Policy))
+		if err != nil {
+			return nil, err
+		}
+		equal, err := policiesEqual(currentPolicy, policy)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			details = append(details, "policy differs")
+		}
+	}
+	return details, nil
+}
+
+// policiesEqual compares two policies by their canonical JSON representation
+func policiesEqual(a, b *iampolicy.Policy) (bool, error) {
+	rawA, err := canonicalPolicyJSON(a)
+	if err != nil {
+		return false, err
+	}
+	rawB, err := canonicalPolicyJSON(b)
+	if err != nil {
+		return false, err
+	}
+	return string(rawA) == string(rawB), nil
+}
+
+// canonicalPolicyJSON serializes the policy with sorted keys and sorted string sets, actions and resources
+// are sets marshaled in no particular order
+func canonicalPolicyJSON(policy *iampolicy.Policy) ([]byte, error) {
+	rawPolicy, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	if err := json.Unmarshal(rawPolicy, &document); err != nil {
+		return nil, err
+	}
+	return json.Marshal(sortStringSets(document))
+}
+
+func sortStringSets(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = sortStringSets(item)
+		}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for i, item := range v {
+			v[i] = sortStringSets(item)
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		if len(values) == len(v) {
+			sort.Strings(values)
+			for i, s := range values {
+				v[i] = s
+			}
+		}
+	}
+	return value
+}
+
+// splitPolicyNames returns the sorted policy names of a comma separated list
+func splitPolicyNames(names string) []string {
+	var policies []string
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			policies = append(policies, name)
+		}
+	}
+	sort.Strings(policies)
+	return policies
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	iamBundleReadPolicy  = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]}]}`
+	iamBundleWritePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::data/*"]}]}`
+)
+
+func setIAMBundleMocks() {
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		read, _ := iampolicy.ParseConfig(strings.NewReader(iamBundleReadPolicy))
+		return map[string]*iampolicy.Policy{"read": read}, nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"alice": {Status: madmin.AccountEnabled, PolicyName: "read"},
+		}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"auditors"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Status: string(madmin.GroupEnabled), Members: []string{"alice"}, Policy: "read"}, nil
+	}
+	minioListServiceAccountsMock = func(_ context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		if user != "alice" {
+			return madmin.ListServiceAccountsResp{}, nil
+		}
+		return madmin.ListServiceAccountsResp{Accounts: []madmin.ServiceAccountInfo{{AccessKey: "alicesvc", ParentUser: "alice"}}}, nil
+	}
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{ParentUser: "alice", AccountStatus: "on", ImpliedPolicy: true, Name: "backup"}, nil
+	}
+}
+
+func TestExportIAM(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+	setIAMBundleMocks()
+
+	bundle, err := exportIAM(ctx, adminClient)
+	funcAssert.NoError(err)
+	funcAssert.Equal(int32(iamBundleVersion), bundle.Version)
+	funcAssert.Len(bundle.Policies, 1)
+	funcAssert.Equal("read", bundle.Policies[0].Name)
+	funcAssert.Equal([]*models.IamBundleUser{{AccessKey: "alice", Status: "enabled"}}, bundle.Users)
+	funcAssert.Equal([]*models.IamBundleGroup{{Name: "auditors", Status: "enabled", Members: []string{"alice"}}}, bundle.Groups)
+	funcAssert.Equal([]*models.IamBundlePolicyMapping{
+		{EntityType: "user", Entity: "alice", Policies: []string{"read"}},
+		{EntityType: "group", Entity: "auditors", Policies: []string{"read"}},
+	}, bundle.PolicyMappings)
+	funcAssert.Equal([]*models.IamBundleServiceAccount{{AccessKey: "alicesvc", ParentUser: "alice", Name: "backup", Status: "on", ImpliedPolicy: true}}, bundle.ServiceAccounts)
+
+	// errors listing the entities are returned
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return nil, errors.New("error")
+	}
+	_, err = exportIAM(ctx, adminClient)
+	funcAssert.Error(err)
+}
+
+func TestImportIAM(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+
+	newBundle := func() *models.IamBundle {
+		return &models.IamBundle{
+			Version: iamBundleVersion,
+			Policies: []*models.IamBundlePolicy{
+				{Name: "read", Policy: iamBundleReadPolicy},
+				{Name: "write", Policy: iamBundleWritePolicy},
+			},
+			Users: []*models.IamBundleUser{
+				{AccessKey: "alice", Status: "enabled"},
+				{AccessKey: "bob", Status: "disabled"},
+			},
+			Groups: []*models.IamBundleGroup{
+				{Name: "auditors", Status: "enabled", Members: []string{"alice", "bob"}},
+			},
+			PolicyMappings: []*models.IamBundlePolicyMapping{
+				{EntityType: "user", Entity: "alice", Policies: []string{"read"}},
+				{EntityType: "user", Entity: "bob", Policies: []string{"write"}},
+				{EntityType: "group", Entity: "auditors", Policies: []string{"read"}},
+			},
+			ServiceAccounts: []*models.IamBundleServiceAccount{
+				{AccessKey: "alicesvc", ParentUser: "alice", Name: "backup", Status: "on", ImpliedPolicy: true},
+				{AccessKey: "bobsvc", ParentUser: "bob", Status: "on", Policy: iamBundleReadPolicy},
+			},
+		}
+	}
+	actions := func(res *models.IamImportResponse) map[string]string {
+		result := map[string]string{}
+		for _, c := range res.Changes {
+			result[c.EntityType+"/"+c.Name] = c.Action
+		}
+		return result
+	}
+
+	var applied []string
+	setIAMBundleMocks()
+	minioAddPolicyMock = func(name string, _ *iampolicy.Policy) error {
+		applied = append(applied, "addPolicy:"+name)
+		return nil
+	}
+	minioAddUserMock = func(accessKey, secretKey string) error {
+		funcAssert.Len(secretKey, iamGeneratedSecretLength)
+		applied = append(applied, "addUser:"+accessKey)
+		return nil
+	}
+	minioSetUserStatusMock = func(accessKey string, status madmin.AccountStatus) error {
+		applied = append(applied, "setUserStatus:"+accessKey+":"+string(status))
+		return nil
+	}
+	minioUpdateGroupMembersMock = func(req madmin.GroupAddRemove) error {
+		applied = append(applied, "updateGroupMembers:"+req.Group+":"+strings.Join(req.Members, ","))
+		return nil
+	}
+	minioSetPolicyMock = func(policyName, entityName string, _ bool) error {
+		applied = append(applied, "setPolicy:"+entityName+":"+policyName)
+		return nil
+	}
+	minioAddServiceAccountMock = func(_ context.Context, _ string, user string, accessKey string, _ string, _ string, _ string, _ *time.Time) (madmin.Credentials, error) {
+		applied = append(applied, "addServiceAccount:"+user+":"+accessKey)
+		return madmin.Credentials{}, nil
+	}
+
+	// Test-1: dry run reports the changes without applying them
+	res, err := importIAM(ctx, adminClient, newBundle(), iamImportConflictFail, true)
+	funcAssert.NoError(err)
+	funcAssert.True(res.DryRun)
+	funcAssert.Empty(applied)
+	funcAssert.Equal(map[string]string{
+		"policy/read":                  "unchanged",
+		"policy/write":                 "create",
+		"user/alice":                   "unchanged",
+		"user/bob":                     "create",
+		"group/auditors":               "conflict",
+		"policyMapping/user:alice":     "unchanged",
+		"policyMapping/user:bob":       "create",
+		"policyMapping/group:auditors": "unchanged",
+		"serviceAccount/alicesvc":      "unchanged",
+		"serviceAccount/bobsvc":        "create",
+	}, actions(res))
+
+	// Test-2: conflicts abort the import with the fail strategy
+	_, err = importIAM(ctx, adminClient, newBundle(), iamImportConflictFail, false)
+	funcAssert.ErrorIs(err, ErrIAMImportConflict)
+	funcAssert.Empty(applied)
+
+	// Test-3: conflicting entities are left untouched with the skip strategy
+	res, err = importIAM(ctx, adminClient, newBundle(), iamImportConflictSkip, false)
+	funcAssert.NoError(err)
+	funcAssert.Equal("skip", actions(res)["group/auditors"])
+	funcAssert.Equal([]string{
+		"addPolicy:write",
+		"addUser:bob",
+		"setUserStatus:bob:disabled",
+		"setPolicy:bob:write",
+		"addServiceAccount:bob:bobsvc",
+	}, applied)
+
+	// Test-4: conflicting entities are updated with the overwrite strategy
+	applied = nil
+	res, err = importIAM(ctx, adminClient, newBundle(), iamImportConflictOverwrite, false)
+	funcAssert.NoError(err)
+	funcAssert.Equal("update", actions(res)["group/auditors"])
+	funcAssert.Contains(applied, "updateGroupMembers:auditors:bob")
+
+	// Test-5: overwriting a policy mapping detaches the policies the bundle doesn't have
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"alice": {Status: madmin.AccountEnabled, PolicyName: "read,audit"},
+		}, nil
+	}
+	minioDetachPolicyMock = func(policyName, entityName string, _ bool) error {
+		applied = append(applied, "detachPolicy:"+entityName+":"+policyName)
+		return nil
+	}
+	bundle := newBundle()
+	bundle.PolicyMappings[0].Policies = []string{"read", "write"}
+	applied = nil
+	res, err = importIAM(ctx, adminClient, bundle, iamImportConflictOverwrite, false)
+	funcAssert.NoError(err)
+	funcAssert.Equal("update", actions(res)["policyMapping/user:alice"])
+	funcAssert.Contains(applied, "detachPolicy:alice:audit")
+	funcAssert.Contains(applied, "setPolicy:alice:write")
+	funcAssert.NotContains(applied, "setPolicy:alice:read")
+
+	// Test-6: the policies of the users and groups of the bundle without a mapping are detached
+	bundle = newBundle()
+	bundle.PolicyMappings = bundle.PolicyMappings[1:2]
+	res, err = importIAM(ctx, adminClient, bundle, iamImportConflictFail, true)
+	funcAssert.NoError(err)
+	funcAssert.Equal("conflict", actions(res)["policyMapping/user:alice"])
+	funcAssert.Equal("conflict", actions(res)["policyMapping/group:auditors"])
+	applied = nil
+	_, err = importIAM(ctx, adminClient, bundle, iamImportConflictOverwrite, false)
+	funcAssert.NoError(err)
+	funcAssert.Contains(applied, "detachPolicy:alice:read")
+	funcAssert.Contains(applied, "detachPolicy:alice:audit")
+	funcAssert.Contains(applied, "detachPolicy:auditors:read")
+	setIAMBundleMocks()
+
+	// Test-7: service accounts owned by another user can't be overwritten
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{ParentUser: "carol", AccountStatus: "on", ImpliedPolicy: true, Name: "backup"}, nil
+	}
+	res, err = importIAM(ctx, adminClient, newBundle(), iamImportConflictOverwrite, true)
+	funcAssert.NoError(err)
+	funcAssert.Equal("conflict", actions(res)["serviceAccount/alicesvc"])
+
+	// Test-8: the policies failing the lint gate abort the import before anything is applied
+	t.Setenv(ConsolePolicyLintGate, policyLintGateWarning)
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{{Name: "data"}}}, nil
+	}
+	bundle = newBundle()
+	bundle.Policies[1].Policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`
+	applied = nil
+	_, err = importIAM(ctx, adminClient, bundle, iamImportConflictSkip, false)
+	funcAssert.ErrorIs(err, ErrPolicyLintFailed)
+	funcAssert.Empty(applied)
+	t.Setenv(ConsolePolicyLintGate, policyLintGateOff)
+
+	// Test-9: errors getting the existing entities are returned
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return nil, errors.New("error")
+	}
+	_, err = importIAM(ctx, adminClient, newBundle(), iamImportConflictSkip, true)
+	funcAssert.Error(err)
+}
+
+func TestValidateIAMBundle(t *testing.T) {
+	tests := []struct {
+		name    string
+		bundle  *models.IamBundle
+		wantErr bool
+	}{
+		{
+			name:   "valid bundle",
+			bundle: &models.IamBundle{Version: iamBundleVersion, Policies: []*models.IamBundlePolicy{{Name: "read", Policy: iamBundleReadPolicy}}},
+		},
+		{
+			name:    "unsupported version",
+			bundle:  &models.IamBundle{Version: iamBundleVersion + 1},
+			wantErr: true,
+		},
+		{
+			name:    "invalid policy",
+			bundle:  &models.IamBundle{Version: iamBundleVersion, Policies: []*models.IamBundlePolicy{{Name: "read", Policy: "{"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid policy mapping entity",
+			bundle:  &models.IamBundle{Version: iamBundleVersion, PolicyMappings: []*models.IamBundlePolicyMapping{{EntityType: "role", Entity: "x"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid service account expiration",
+			bundle:  &models.IamBundle{Version: iamBundleVersion, ServiceAccounts: []*models.IamBundleServiceAccount{{AccessKey: "svc", ParentUser: "alice", Expiration: "tomorrow"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(_ *testing.T) {
+			err := validateIAMBundle(tt.bundle)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPoliciesEqual(t *testing.T) {
+	a, err := iampolicy.ParseConfig(strings.NewReader(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject","s3:ListBucket"],"Resource":["arn:aws:s3:::data/*","arn:aws:s3:::data"]}]}`))
+	assert.NoError(t, err)
+	b, err := iampolicy.ParseConfig(strings.NewReader(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListBucket","s3:PutObject","s3:GetObject"],"Resource":["arn:aws:s3:::data","arn:aws:s3:::data/*"]}]}`))
+	assert.NoError(t, err)
+	c, err := iampolicy.ParseConfig(strings.NewReader(iamBundleReadPolicy))
+	assert.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		equal, err := policiesEqual(a, b)
+		assert.NoError(t, err)
+		assert.True(t, equal)
+	}
+	equal, err := policiesEqual(a, c)
+	assert.NoError(t, err)
+	assert.False(t, equal)
+}
@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openstor/console/api/operations"
+	cfgApi "github.com/openstor/console/api/operations/configuration"
+	"github.com/openstor/console/models"
+	"github.com/openstor/console/pkg/auth/ldap"
+	"github.com/openstor/madmin-go/v4"
+)
+
+// Cleanup actions offered by every stale identity finding, each one maps to an existing remove function
+const (
+	staleCleanupRemoveUser           = "removeUser"
+	staleCleanupRemoveGroup          = "removeGroup"
+	staleCleanupRemovePolicy         = "removePolicy"
+	staleCleanupDeleteServiceAccount = "deleteServiceAccount"
+)
+
+const (
+	staleCleanupStatusSuccess = "success"
+	staleCleanupStatusFailed  = "failed"
+	staleCleanupStatusSkipped = "skipped"
+)
+
+// staleBuiltinPolicies are the policies created by the server, they are never reported as unused since
+// the server creates them again on restart
+var staleBuiltinPolicies = map[string]bool{
+	"consoleAdmin": true,
+	"diagnostics":  true,
+	"readonly":     true,
+	"readwrite":    true,
+	"writeonly":    true,
+}
+
+// staleCleanupOrder is the order entities are removed in, service accounts go before their parent users
+// and policies last so they are no longer attached to the removed users and groups
+var staleCleanupOrder = map[string]int{
+	iamEntityServiceAccount: 0,
+	iamEntityUser:           1,
+	iamEntityGroup:          2,
+	iamEntityPolicy:         3,
+}
+
+func registerStaleIdentityHandlers(api *operations.ConsoleAPI) {
+	// Get the stale identity report
+	api.ConfigurationGetStaleIdentityReportHandler = cfgApi.GetStaleIdentityReportHandlerFunc(func(params cfgApi.GetStaleIdentityReportParams, session *models.Principal) middleware.Responder {
+		report, err := getStaleIdentityReportResponse(session, params)
+		if err != nil {
+			return cfgApi.NewGetStaleIdentityReportDefault(err.Code).WithPayload(err.APIError)
+		}
+		return cfgApi.NewGetStaleIdentityReportOK().WithPayload(report)
+	})
+	// Remove the identities flagged by the stale identity report
+	api.ConfigurationCleanupStaleIdentitiesHandler = cfgApi.CleanupStaleIdentitiesHandlerFunc(func(params cfgApi.CleanupStaleIdentitiesParams, session *models.Principal) middleware.Responder {
+		cleanupResponse, err := getCleanupStaleIdentitiesResponse(session, params)
+		if err != nil {
+			return cfgApi.NewCleanupStaleIdentitiesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return cfgApi.NewCleanupStaleIdentitiesOK().WithPayload(cleanupResponse)
+	})
+}
+
+// staleDisabledDays returns the requested number of days or the configured one when it isn't set
+func staleDisabledDays(days *int32) (int, error) {
+	if days == nil {
+		return getStaleDisabledDays(), nil
+	}
+	if *days < 0 {
+		return 0, errors.New("disabledDays can't be negative")
+	}
+	return int(*days), nil
+}
+
+// getStaleIdentityReportResponse performs staleIdentityReport() and serializes it to the handler's output
+func getStaleIdentityReportResponse(session *models.Principal, params cfgApi.GetStaleIdentityReportParams) (*models.StaleIdentityReport, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	disabledDays, err := staleDisabledDays(params.DisabledDays)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	report, err := staleIdentityReport(ctx, adminClient, disabledDays, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return report, nil
+}
+
+// getCleanupStaleIdentitiesResponse validates the request and performs cleanupStaleIdentities()
+func getCleanupStaleIdentitiesResponse(session *models.Principal, params cfgApi.CleanupStaleIdentitiesParams) (*models.StaleIdentityCleanupResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body == nil || len(params.Body.Items) == 0 {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("at least one identity to clean up is required"))
+	}
+	for _, item := range params.Body.Items {
+		if _, ok := staleCleanupOrder[item.EntityType]; !ok || item.Name == "" {
+			return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("invalid identity %s %q", item.EntityType, item.Name))
+		}
+	}
+	// the report is generated again with the days it was displayed with
+	disabledDays := int(params.Body.DisabledDays)
+	if disabledDays < 0 {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("disabledDays can't be negative"))
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	cleanupResponse, err := cleanupStaleIdentities(ctx, adminClient, params.Body.Items, disabledDays, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return cleanupResponse, nil
+}
+
+// staleIdentityReport flags the users without any policy, either their own or one of their groups', the
+// empty groups, the policies attached to nobody, the users disabled for at least disabledDays and the
+// service accounts whose parent user is disabled.
+func staleIdentityReport(ctx context.Context, client MinioAdmin, disabledDays int, now time.Time) (*models.StaleIdentityReport, error) {
+	report := &models.StaleIdentityReport{
+		GeneratedAt:  now.UTC().Format(time.RFC3339),
+		DisabledDays: int32(disabledDays),
+		Findings:     []*models.StaleIdentityFinding{},
+	}
+	add := func(kind, entityType, name, detail, action string) {
+		report.Findings = append(report.Findings, &models.StaleIdentityFinding{
+			Kind:          kind,
+			EntityType:    entityType,
+			Name:          name,
+			Detail:        detail,
+			CleanupAction: action,
+		})
+	}
+
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groupNames, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(groupNames)
+	policies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the policies mapped to LDAP users and groups only exist when MinIO uses LDAP
+	var entities madmin.PolicyEntitiesResult
+	if ldap.GetLDAPEnabled() {
+		entities, err = client.getLDAPPolicyEntities(ctx, madmin.PolicyEntitiesQuery{})
+		if err != nil {
+			return nil, err
+		}
+	}
+	rolePolicies, claimedPolicies, err := openIDPolicies(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	attached := map[string]bool{}
+	for _, policy := range rolePolicies {
+		attached[policy] = true
+	}
+	groupHasPolicy := map[string]bool{}
+	userGroups := map[string][]string{}
+	for _, group := range groupNames {
+		groupDesc, err := groupInfo(ctx, client, group)
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range splitPolicyNames(groupDesc.Policy) {
+			attached[policy] = true
+			groupHasPolicy[group] = true
+		}
+		for _, member := range groupDesc.Members {
+			userGroups[member] = append(userGroups[member], group)
+		}
+		if len(groupDesc.Members) == 0 {
+			add(models.StaleIdentityFindingKindEmptyGroup, iamEntityGroup, group, "the group has no members", staleCleanupRemoveGroup)
+		}
+	}
+	for _, mapping := range entities.UserMappings {
+		for _, policy := range mapping.Policies {
+			attached[policy] = true
+		}
+	}
+	for _, mapping := range entities.GroupMappings {
+		for _, policy := range mapping.Policies {
+			attached[policy] = true
+		}
+	}
+
+	for _, accessKey := range sortedKeys(users) {
+		user := users[accessKey]
+		userPolicies := splitPolicyNames(user.PolicyName)
+		for _, policy := range userPolicies {
+			attached[policy] = true
+		}
+		hasPolicy := len(userPolicies) > 0
+		for _, group := range append(userGroups[accessKey], user.MemberOf...) {
+			hasPolicy = hasPolicy || groupHasPolicy[group]
+		}
+		if !hasPolicy {
+			add(models.StaleIdentityFindingKindUserWithoutPolicy, iamEntityUser, accessKey, "neither the user nor its groups have a policy", staleCleanupRemoveUser)
+		}
+		if user.Status != madmin.AccountDisabled {
+			continue
+		}
+		// the last update of a disabled user is the closest the server gets to when it was disabled
+		if !user.UpdatedAt.IsZero() && now.Sub(user.UpdatedAt) >= time.Duration(disabledDays)*24*time.Hour {
+			add(models.StaleIdentityFindingKindDisabledUser, iamEntityUser, accessKey,
+				fmt.Sprintf("the user has been disabled since %s", user.UpdatedAt.UTC().Format(time.RFC3339)), staleCleanupRemoveUser)
+		}
+		serviceAccounts, err := client.listServiceAccounts(ctx, accessKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, sa := range serviceAccounts.Accounts {
+			add(models.StaleIdentityFindingKindOrphanServiceAccount, iamEntityServiceAccount, sa.AccessKey,
+				fmt.Sprintf("the parent user %s is disabled", accessKey), staleCleanupDeleteServiceAccount)
+		}
+	}
+
+	// the policies named in the claims of an OpenID provider can't be listed, any of them may be in use
+	if claimedPolicies {
+		return report, nil
+	}
+	for _, name := range sortedKeys(policies) {
+		if !attached[name] && !staleBuiltinPolicies[name] {
+			add(models.StaleIdentityFindingKindUnusedPolicy, iamEntityPolicy, name, "the policy isn't attached to any user or group", staleCleanupRemovePolicy)
+		}
+	}
+	return report, nil
+}
+
+// openIDPolicies returns the role policies of the enabled OpenID providers of MinIO. claimed is true when one of
+// them has no role policy, the users logging in with it get the policies named in a claim of their token instead.
+func openIDPolicies(ctx context.Context, client MinioAdmin) (policies []string, claimed bool, err error) {
+	providers, err := client.listIDPConfig(ctx, madmin.OpenidIDPCfg)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, provider := range providers {
+		if !provider.Enabled {
+			continue
+		}
+		config, err := client.getIDPConfig(ctx, madmin.OpenidIDPCfg, provider.Name)
+		if err != nil {
+			return nil, false, err
+		}
+		rolePolicy := ""
+		for _, info := range config.Info {
+			if info.Key == "role_policy" {
+				rolePolicy = info.Value
+			}
+		}
+		if rolePolicy == "" {
+			claimed = true
+			continue
+		}
+		policies = append(policies, splitPolicyNames(rolePolicy)...)
+	}
+	return policies, claimed, nil
+}
+
+// cleanupStaleIdentities removes the requested identities through the existing remove functions. The report
+// is generated again first and the identities that are no longer flagged are skipped, so nothing that became
+// in use since the report was displayed gets removed. A failure doesn't stop the cleanup of the remaining
+// identities, the result of each one is reported instead.
+func cleanupStaleIdentities(ctx context.Context, client MinioAdmin, items []*models.StaleIdentityCleanupItem, disabledDays int, now time.Time) (*models.StaleIdentityCleanupResponse, error) {
+	report, err := staleIdentityReport(ctx, client, disabledDays, now)
+	if err != nil {
+		return nil, err
+	}
+	actions := map[string]string{}
+	for _, finding := range report.Findings {
+		actions[finding.EntityType+"/"+finding.Name] = finding.CleanupAction
+	}
+
+	seen := map[string]bool{}
+	var pending []*models.StaleIdentityCleanupItem
+	for _, item := range items {
+		key := item.EntityType + "/" + item.Name
+		if !seen[key] {
+			seen[key] = true
+			pending = append(pending, item)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		return staleCleanupOrder[pending[i].EntityType] < staleCleanupOrder[pending[j].EntityType]
+	})
+
+	response := &models.StaleIdentityCleanupResponse{Results: []*models.StaleIdentityCleanupResult{}}
+	for _, item := range pending {
+		result := &models.StaleIdentityCleanupResult{EntityType: item.EntityType, Name: item.Name, Status: staleCleanupStatusSuccess}
+		var err error
+		switch actions[item.EntityType+"/"+item.Name] {
+		case staleCleanupRemoveUser:
+			err = removeUser(ctx, client, item.Name)
+		case staleCleanupRemoveGroup:
+			err = removeGroup(ctx, client, item.Name)
+		case staleCleanupRemovePolicy:
+			err = removePolicy(ctx, client, item.Name)
+		case staleCleanupDeleteServiceAccount:
+			err = deleteServiceAccount(ctx, client, item.Name)
+		default:
+			result.Status = staleCleanupStatusSkipped
+			result.Error = "the identity is no longer reported as stale"
+		}
+		if err != nil {
+			result.Status = staleCleanupStatusFailed
+			result.Error = err.Error()
+		}
+		response.Results = append(response.Results, result)
+	}
+	return response, nil
+}
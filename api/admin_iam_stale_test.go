@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/console/pkg/auth/ldap"
+	"github.com/openstor/madmin-go/v4"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func setStaleIdentityMocks(now time.Time) {
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"alice": {PolicyName: "readwrite", Status: madmin.AccountEnabled},
+			"bob":   {Status: madmin.AccountEnabled},
+			"carol": {Status: madmin.AccountEnabled},
+			"dave":  {PolicyName: "readonly", Status: madmin.AccountDisabled, UpdatedAt: now.Add(-100 * 24 * time.Hour)},
+			"erin":  {PolicyName: "readonly", Status: madmin.AccountDisabled, UpdatedAt: now.Add(-10 * 24 * time.Hour)},
+		}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"empty", "auditors"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		if group == "auditors" {
+			return &madmin.GroupDesc{Name: group, Members: []string{"carol"}, Policy: "audit"}, nil
+		}
+		return &madmin.GroupDesc{Name: group}, nil
+	}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{
+			"readwrite": {}, "readonly": {}, "writeonly": {}, "audit": {}, "ldap-only": {}, "unused": {},
+		}, nil
+	}
+	minioGetLDAPPolicyEntitiesMock = func(_ context.Context, _ madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
+		return madmin.PolicyEntitiesResult{
+			GroupMappings: []madmin.GroupPolicyEntities{{Group: "cn=ops,dc=example,dc=com", Policies: []string{"ldap-only"}}},
+		}, nil
+	}
+	minioListIDPConfigMock = func(_ context.Context, _ string) ([]madmin.IDPListItem, error) {
+		return []madmin.IDPListItem{{Type: madmin.OpenidIDPCfg, Name: "_"}}, nil
+	}
+	minioListServiceAccountsMock = func(_ context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		if user == "dave" {
+			return madmin.ListServiceAccountsResp{Accounts: []madmin.ServiceAccountInfo{{AccessKey: "davekey", ParentUser: user}}}, nil
+		}
+		return madmin.ListServiceAccountsResp{}, nil
+	}
+}
+
+func TestStaleIdentityReport(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	setStaleIdentityMocks(now)
+	t.Setenv(ldap.ConsoleLDAPEnabled, "on")
+
+	// Test-1: every kind of stale identity is flagged along with its cleanup action
+	report, err := staleIdentityReport(ctx, adminClient, 90, now)
+	funcAssert.NoError(err)
+	var got []string
+	for _, finding := range report.Findings {
+		funcAssert.NotEmpty(finding.Detail)
+		got = append(got, fmt.Sprintf("%s %s %s %s", finding.Kind, finding.EntityType, finding.Name, finding.CleanupAction))
+	}
+	funcAssert.Equal([]string{
+		"emptyGroup group empty removeGroup",
+		"userWithoutPolicy user bob removeUser",
+		"disabledUser user dave removeUser",
+		"orphanServiceAccount serviceAccount davekey deleteServiceAccount",
+		"unusedPolicy policy unused removePolicy",
+	}, got)
+	funcAssert.Equal(int32(90), report.DisabledDays)
+
+	// Test-2: the number of days disabled users are reported after is honored
+	report, err = staleIdentityReport(ctx, adminClient, 5, now)
+	funcAssert.NoError(err)
+	funcAssert.Len(report.Findings, 6)
+
+	// Test-3: without LDAP the LDAP policy mappings aren't queried, a policy only LDAP entities had is unused
+	t.Setenv(ldap.ConsoleLDAPEnabled, "off")
+	minioGetLDAPPolicyEntitiesMock = func(_ context.Context, _ madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
+		return madmin.PolicyEntitiesResult{}, errors.New("LDAP is not configured")
+	}
+	report, err = staleIdentityReport(ctx, adminClient, 90, now)
+	funcAssert.NoError(err)
+	got = nil
+	for _, finding := range report.Findings {
+		if finding.Kind == models.StaleIdentityFindingKindUnusedPolicy {
+			got = append(got, finding.Name)
+		}
+	}
+	funcAssert.Equal([]string{"ldap-only", "unused"}, got)
+
+	// Test-4: the role policies of the OpenID providers are used, disabled providers are ignored
+	minioListIDPConfigMock = func(_ context.Context, _ string) ([]madmin.IDPListItem, error) {
+		return []madmin.IDPListItem{
+			{Type: madmin.OpenidIDPCfg, Name: "_", Enabled: true},
+			{Type: madmin.OpenidIDPCfg, Name: "legacy"},
+		}, nil
+	}
+	minioGetIDPConfigMock = func(_ context.Context, _, name string) (madmin.IDPConfig, error) {
+		funcAssert.Equal("_", name)
+		return madmin.IDPConfig{Info: []madmin.IDPCfgInfo{{Key: "role_policy", Value: "ldap-only, readonly"}}}, nil
+	}
+	report, err = staleIdentityReport(ctx, adminClient, 90, now)
+	funcAssert.NoError(err)
+	got = nil
+	for _, finding := range report.Findings {
+		if finding.Kind == models.StaleIdentityFindingKindUnusedPolicy {
+			got = append(got, finding.Name)
+		}
+	}
+	funcAssert.Equal([]string{"unused"}, got)
+
+	// the policies of an OpenID provider granting the policies of a claim can't be known, none is unused
+	minioGetIDPConfigMock = func(_ context.Context, _, _ string) (madmin.IDPConfig, error) {
+		return madmin.IDPConfig{Info: []madmin.IDPCfgInfo{{Key: "claim_name", Value: "policy"}}}, nil
+	}
+	report, err = staleIdentityReport(ctx, adminClient, 90, now)
+	funcAssert.NoError(err)
+	for _, finding := range report.Findings {
+		funcAssert.NotEqual(models.StaleIdentityFindingKindUnusedPolicy, finding.Kind)
+	}
+	funcAssert.NotEmpty(report.Findings)
+
+	// Test-5: errors listing the identities are returned
+	minioListGroupsMock = func() ([]string, error) {
+		return nil, errors.New("error")
+	}
+	_, err = staleIdentityReport(ctx, adminClient, 90, now)
+	funcAssert.Error(err)
+}
+
+func TestCleanupStaleIdentities(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	setStaleIdentityMocks(now)
+
+	var removed []string
+	minioRemoveUserMock = func(accessKey string) error {
+		removed = append(removed, "user:"+accessKey)
+		return nil
+	}
+	minioUpdateGroupMembersMock = func(req madmin.GroupAddRemove) error {
+		removed = append(removed, "group:"+req.Group)
+		return nil
+	}
+	minioRemovePolicyMock = func(name string) error {
+		return errors.New("policy in use")
+	}
+	minioDeleteServiceAccountMock = func(_ context.Context, serviceAccount string) error {
+		removed = append(removed, "serviceAccount:"+serviceAccount)
+		return nil
+	}
+
+	// Test-1: flagged identities are removed, service accounts first, and the rest are skipped
+	res, err := cleanupStaleIdentities(ctx, adminClient, []*models.StaleIdentityCleanupItem{
+		{EntityType: "policy", Name: "unused"},
+		{EntityType: "user", Name: "dave"},
+		{EntityType: "group", Name: "empty"},
+		{EntityType: "user", Name: "alice"},
+		{EntityType: "serviceAccount", Name: "davekey"},
+		{EntityType: "user", Name: "dave"},
+	}, 90, now)
+	funcAssert.NoError(err)
+	funcAssert.Equal([]string{"serviceAccount:davekey", "user:dave", "group:empty"}, removed)
+	funcAssert.Equal([]*models.StaleIdentityCleanupResult{
+		{EntityType: "serviceAccount", Name: "davekey", Status: "success"},
+		{EntityType: "user", Name: "dave", Status: "success"},
+		{EntityType: "user", Name: "alice", Status: "skipped", Error: "the identity is no longer reported as stale"},
+		{EntityType: "group", Name: "empty", Status: "success"},
+		{EntityType: "policy", Name: "unused", Status: "failed", Error: "policy in use"},
+	}, res.Results)
+
+	// Test-2: errors generating the report are returned
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return nil, errors.New("error")
+	}
+	_, err = cleanupStaleIdentities(ctx, adminClient, []*models.StaleIdentityCleanupItem{{EntityType: "user", Name: "bob"}}, 90, now)
+	funcAssert.Error(err)
+}
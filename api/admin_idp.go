@@ -61,6 +61,27 @@ func registerIDPHandlers(api *operations.ConsoleAPI) {
 		}
 		return idp.NewGetLDAPEntitiesOK().WithPayload(response)
 	})
+	api.IdpAttachLDAPPoliciesHandler = idp.AttachLDAPPoliciesHandlerFunc(func(params idp.AttachLDAPPoliciesParams, session *models.Principal) middleware.Responder {
+		response, err := getAttachLDAPPoliciesResponse(session, params)
+		if err != nil {
+			return idp.NewAttachLDAPPoliciesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return idp.NewAttachLDAPPoliciesOK().WithPayload(response)
+	})
+	api.IdpDetachLDAPPoliciesHandler = idp.DetachLDAPPoliciesHandlerFunc(func(params idp.DetachLDAPPoliciesParams, session *models.Principal) middleware.Responder {
+		response, err := getDetachLDAPPoliciesResponse(session, params)
+		if err != nil {
+			return idp.NewDetachLDAPPoliciesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return idp.NewDetachLDAPPoliciesOK().WithPayload(response)
+	})
+	api.IdpSearchLDAPEntitiesHandler = idp.SearchLDAPEntitiesHandlerFunc(func(params idp.SearchLDAPEntitiesParams, session *models.Principal) middleware.Responder {
+		response, err := getSearchLDAPEntitiesResponse(session, params)
+		if err != nil {
+			return idp.NewSearchLDAPEntitiesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return idp.NewSearchLDAPEntitiesOK().WithPayload(response)
+	})
 }
 
 func createIDPConfigurationResponse(session *models.Principal, params idp.CreateConfigurationParams) (*models.SetIDPResponse, *CodedAPIError) {
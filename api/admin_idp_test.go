@@ -37,6 +37,12 @@ func (suite *IDPTestSuite) SetupSuite() {
 	minioServiceRestartMock = func(_ context.Context) error {
 		return nil
 	}
+	minioListIDPConfigMock = func(_ context.Context, _ string) ([]madmin.IDPListItem, error) {
+		return []madmin.IDPListItem{{Name: "mock"}}, nil
+	}
+	minioGetIDPConfigMock = func(_ context.Context, _, _ string) (madmin.IDPConfig, error) {
+		return madmin.IDPConfig{Info: []madmin.IDPCfgInfo{{Key: "mock", Value: "mock"}}}, nil
+	}
 }
 
 func (suite *IDPTestSuite) SetupTest() {
@@ -41,6 +41,30 @@ func registerAdminInfoHandlers(api *operations.ConsoleAPI) {
 		}
 		return systemApi.NewDashboardWidgetDetailsOK().WithPayload(infoResp)
 	})
+	// return the user-defined widgets
+	api.SystemListCustomWidgetsHandler = systemApi.ListCustomWidgetsHandlerFunc(func(params systemApi.ListCustomWidgetsParams, session *models.Principal) middleware.Responder {
+		widgetsResp, err := getListCustomWidgetsResponse(session, params)
+		if err != nil {
+			return systemApi.NewListCustomWidgetsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewListCustomWidgetsOK().WithPayload(widgetsResp)
+	})
+	// return single user-defined widget results
+	api.SystemCustomWidgetDetailsHandler = systemApi.CustomWidgetDetailsHandlerFunc(func(params systemApi.CustomWidgetDetailsParams, session *models.Principal) middleware.Responder {
+		infoResp, err := getCustomWidgetDetailsResponse(session, params)
+		if err != nil {
+			return systemApi.NewCustomWidgetDetailsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewCustomWidgetDetailsOK().WithPayload(infoResp)
+	})
+	// run the queries of a widget definition
+	api.SystemTestCustomWidgetHandler = systemApi.TestCustomWidgetHandlerFunc(func(params systemApi.TestCustomWidgetParams, session *models.Principal) middleware.Responder {
+		infoResp, err := getTestCustomWidgetResponse(session, params)
+		if err != nil {
+			return systemApi.NewTestCustomWidgetDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewTestCustomWidgetOK().WithPayload(infoResp)
+	})
 }
 
 type UsageInfo struct {
@@ -122,36 +146,36 @@ func GetAdminInfo(ctx context.Context, client MinioAdmin) (*UsageInfo, error) {
 }
 
 type Target struct {
-	Expr         string
-	Interval     string
-	LegendFormat string
-	Step         int32
-	InitialTime  int64
+	Expr         string `json:"expr" yaml:"expr"`
+	Interval     string `json:"interval,omitempty" yaml:"interval,omitempty"`
+	LegendFormat string `json:"legendFormat,omitempty" yaml:"legendFormat,omitempty"`
+	Step         int32  `json:"step,omitempty" yaml:"step,omitempty"`
+	InitialTime  int64  `json:"initialTime,omitempty" yaml:"initialTime,omitempty"`
 }
 
 type ReduceOptions struct {
-	Calcs []string
+	Calcs []string `json:"calcs,omitempty" yaml:"calcs,omitempty"`
 }
 
 type MetricOptions struct {
-	ReduceOptions ReduceOptions
+	ReduceOptions ReduceOptions `json:"reduceOptions,omitempty" yaml:"reduceOptions,omitempty"`
 }
 
 type Metric struct {
-	ID            int32
-	Title         string
-	Type          string
-	Options       MetricOptions
-	Targets       []Target
-	GridPos       GridPos
-	MaxDataPoints int32
+	ID            int32         `json:"id" yaml:"id"`
+	Title         string        `json:"title" yaml:"title"`
+	Type          string        `json:"type" yaml:"type"`
+	Options       MetricOptions `json:"options,omitempty" yaml:"options,omitempty"`
+	Targets       []Target      `json:"targets" yaml:"targets"`
+	GridPos       GridPos       `json:"gridPos,omitempty" yaml:"gridPos,omitempty"`
+	MaxDataPoints int32         `json:"maxDataPoints,omitempty" yaml:"maxDataPoints,omitempty"`
 }
 
 type GridPos struct {
-	H int32
-	W int32
-	X int32
-	Y int32
+	H int32 `json:"h" yaml:"h"`
+	W int32 `json:"w" yaml:"w"`
+	X int32 `json:"x" yaml:"x"`
+	Y int32 `json:"y" yaml:"y"`
 }
 
 type WidgetLabel struct {
@@ -885,6 +909,12 @@ func getAdminInfoResponse(session *models.Principal, params systemApi.AdminInfoP
 		return nil, ErrorWithContext(ctx, err2)
 	}
 
+	// without Prometheus, the widgets are answered from the sampled server info
+	if !*params.DefaultOnly && prometheusURL == "" && globalInfoSampler != nil {
+		sessionResp.AdvancedMetricsStatus = models.AdminInfoResponseAdvancedMetricsStatusAvailable
+		sessionResp.Widgets = sampledWidgetList()
+	}
+
 	return sessionResp, nil
 }
 
@@ -948,10 +978,19 @@ func getUsageWidgetsForDeployment(ctx context.Context, prometheusURL string, adm
 }
 
 func unmarshalPrometheus(ctx context.Context, httpClnt *http.Client, endpoint string, data interface{}) bool {
+	if err := fetchPrometheus(ctx, httpClnt, endpoint, data); err != nil {
+		ErrorWithContext(ctx, err)
+		return true
+	}
+	return false
+}
+
+// fetchPrometheus decodes the response of a Prometheus API call, the errors include the message returned by
+// Prometheus when there is one
+func fetchPrometheus(ctx context.Context, httpClnt *http.Client, endpoint string, data interface{}) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
-		ErrorWithContext(ctx, fmt.Errorf("Unable to create the request to fetch labels from prometheus: %w", err))
-		return true
+		return fmt.Errorf("Unable to create the request to fetch labels from prometheus: %w", err)
 	}
 
 	prometheusBearer := getPrometheusAuthToken()
@@ -962,23 +1001,26 @@ func unmarshalPrometheus(ctx context.Context, httpClnt *http.Client, endpoint st
 
 	resp, err := httpClnt.Do(req)
 	if err != nil {
-		ErrorWithContext(ctx, fmt.Errorf("Unable to fetch labels from prometheus: %w", err))
-		return true
+		return fmt.Errorf("Unable to fetch labels from prometheus: %w", err)
 	}
 
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		ErrorWithContext(ctx, fmt.Errorf("Unexpected status code from prometheus (%s)", resp.Status))
-		return true
+		var promErr struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&promErr) == nil && promErr.Error != "" {
+			return fmt.Errorf("Unexpected status code from prometheus (%s): %s", resp.Status, promErr.Error)
+		}
+		return fmt.Errorf("Unexpected status code from prometheus (%s)", resp.Status)
 	}
 
 	if err = json.NewDecoder(resp.Body).Decode(data); err != nil {
-		ErrorWithContext(ctx, fmt.Errorf("Unexpected error from prometheus: %w", err))
-		return true
+		return fmt.Errorf("Unexpected error from prometheus: %w", err)
 	}
 
-	return false
+	return nil
 }
 
 func testPrometheusURL(ctx context.Context, url string) bool {
@@ -1020,6 +1062,16 @@ func getAdminInfoWidgetResponse(params systemApi.DashboardWidgetDetailsParams) (
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
 	prometheusURL := getPrometheusURL()
+	if prometheusURL == "" && globalInfoSampler != nil {
+		return globalInfoSampler.widgetDetails(params.WidgetID, params.Step, params.Start, params.End)
+	}
+	clientIP := getClientIP(params.HTTPRequest)
+	ctx = context.WithValue(ctx, utils.ContextClientIP, clientIP)
+	return getWidgetDetails(ctx, prometheusURL, getPrometheusSelector(), params.WidgetID, params.Step, params.Start, params.End)
+}
+
+// getPrometheusSelector returns the selector replacing `$__query` in the widget queries
+func getPrometheusSelector() string {
 	prometheusJobID := getPrometheusJobID()
 	prometheusExtraLabels := getPrometheusExtraLabels()
 
@@ -1027,12 +1079,22 @@ func getAdminInfoWidgetResponse(params systemApi.DashboardWidgetDetailsParams) (
 	if strings.TrimSpace(prometheusExtraLabels) != "" {
 		selector = fmt.Sprintf(`job="%s",%s`, prometheusJobID, prometheusExtraLabels)
 	}
-	clientIP := getClientIP(params.HTTPRequest)
-	ctx = context.WithValue(ctx, utils.ContextClientIP, clientIP)
-	return getWidgetDetails(ctx, prometheusURL, selector, params.WidgetID, params.Step, params.Start, params.End)
+	return selector
 }
 
 func getWidgetDetails(ctx context.Context, prometheusURL string, selector string, widgetID int32, step *int32, start *int64, end *int64) (*models.WidgetDetails, *CodedAPIError) {
+	for _, m := range widgets {
+		if m.ID == widgetID {
+			return getMetricDetails(ctx, prometheusURL, selector, m, step, start, end, false)
+		}
+	}
+
+	return nil, &CodedAPIError{Code: 404, APIError: &models.APIError{Message: "Widget not found"}}
+}
+
+// getMetricDetails returns the results of the queries of a widget, built-in or user-defined. The targets whose
+// query failed are left out, unless strict is set and the failures are returned.
+func getMetricDetails(ctx context.Context, prometheusURL string, selector string, m Metric, step *int32, start *int64, end *int64, strict bool) (*models.WidgetDetails, *CodedAPIError) {
 	// We test if prometheus URL is reachable. this is meant to avoid unuseful calls and application hang.
 	if !testPrometheusURL(ctx, prometheusURL) {
 		return nil, ErrorWithContext(ctx, errors.New("prometheus URL is unreachable"))
@@ -1071,111 +1133,119 @@ LabelsWaitLoop:
 		}
 	}
 
-	// launch a goroutines per widget
-
-	for _, m := range widgets {
-		if m.ID != widgetID {
-			continue
+	wdgtResult, err := queryWidget(ctx, httpClnt, prometheusURL, selector, labelMap, m, step, start, end)
+	if err != nil && strict {
+		return nil, &CodedAPIError{
+			Code: 400,
+			APIError: &models.APIError{
+				Message:         ErrBadRequest.Error(),
+				DetailedMessage: err.Error(),
+			},
 		}
+	}
+	return wdgtResult, nil
+}
 
-		var (
-			wg            sync.WaitGroup
-			targetResults = make([]*models.ResultTarget, len(m.Targets))
-		)
+// queryWidget returns the results of the queries of the targets of a widget, and the errors of the failed ones
+func queryWidget(ctx context.Context, httpClnt *http.Client, prometheusURL, selector string, labelMap map[string][]string, m Metric, step *int32, start *int64, end *int64) (*models.WidgetDetails, error) {
+	var (
+		wg            sync.WaitGroup
+		targetResults = make([]*models.ResultTarget, len(m.Targets))
+		targetErrors  = make([]error, len(m.Targets))
+	)
 
-		// for each target we will launch another goroutine to fetch the values
-		for idx, target := range m.Targets {
-			wg.Add(1)
-			go func(idx int, target Target, inStep *int32, inStart *int64, inEnd *int64) {
-				defer wg.Done()
+	// for each target we will launch another goroutine to fetch the values
+	for idx, target := range m.Targets {
+		wg.Add(1)
+		go func(idx int, target Target, inStep *int32, inStart *int64, inEnd *int64) {
+			defer wg.Done()
 
-				apiType := "query_range"
-				now := time.Now()
+			apiType := "query_range"
+			now := time.Now()
 
-				var initTime int64 = -15
+			var initTime int64 = -15
 
-				if target.InitialTime != 0 {
-					initTime = target.InitialTime
-				}
+			if target.InitialTime != 0 {
+				initTime = target.InitialTime
+			}
 
-				timeCalculated := time.Duration(initTime * int64(time.Minute))
+			timeCalculated := time.Duration(initTime * int64(time.Minute))
 
-				extraParamters := fmt.Sprintf("&start=%d&end=%d", now.Add(timeCalculated).Unix(), now.Unix())
+			extraParamters := fmt.Sprintf("&start=%d&end=%d", now.Add(timeCalculated).Unix(), now.Unix())
 
-				var step int32 = 60
-				if target.Step > 0 {
-					step = target.Step
-				}
-				if inStep != nil && *inStep > 0 {
-					step = *inStep
-				}
+			var step int32 = 60
+			if target.Step > 0 {
+				step = target.Step
+			}
+			if inStep != nil && *inStep > 0 {
+				step = *inStep
+			}
 
-				if inStart != nil && inEnd != nil {
-					extraParamters = fmt.Sprintf("&start=%d&end=%d", *inStart, *inEnd)
-				}
+			if inStart != nil && inEnd != nil {
+				extraParamters = fmt.Sprintf("&start=%d&end=%d", *inStart, *inEnd)
+			}
 
-				if step > 0 {
-					extraParamters = fmt.Sprintf("%s&step=%d", extraParamters, step)
-				}
+			if step > 0 {
+				extraParamters = fmt.Sprintf("%s&step=%d", extraParamters, step)
+			}
 
-				// replace the `$__rate_interval` global for step with unit (s for seconds)
-				queryExpr := strings.ReplaceAll(target.Expr, "$__rate_interval", fmt.Sprintf("%ds", 240))
-				if strings.Contains(queryExpr, "$") {
-					re := regexp.MustCompile(`\$([a-z]+)`)
+			// replace the `$__rate_interval` global for step with unit (s for seconds)
+			queryExpr := strings.ReplaceAll(target.Expr, "$__rate_interval", fmt.Sprintf("%ds", 240))
+			if strings.Contains(queryExpr, "$") {
+				re := regexp.MustCompile(`\$([a-z]+)`)
 
-					for _, match := range re.FindAllStringSubmatch(queryExpr, -1) {
-						if val, ok := labelMap[match[1]]; ok {
-							queryExpr = strings.ReplaceAll(queryExpr, "$"+match[1], fmt.Sprintf("(%s)", strings.Join(val, "|")))
-						}
+				for _, match := range re.FindAllStringSubmatch(queryExpr, -1) {
+					if val, ok := labelMap[match[1]]; ok {
+						queryExpr = strings.ReplaceAll(queryExpr, "$"+match[1], fmt.Sprintf("(%s)", strings.Join(val, "|")))
 					}
 				}
+			}
 
-				queryExpr = strings.ReplaceAll(queryExpr, "$__query", selector)
-				endpoint := fmt.Sprintf("%s/api/v1/%s?query=%s%s", prometheusURL, apiType, url.QueryEscape(queryExpr), extraParamters)
+			queryExpr = strings.ReplaceAll(queryExpr, "$__query", selector)
+			endpoint := fmt.Sprintf("%s/api/v1/%s?query=%s%s", prometheusURL, apiType, url.QueryEscape(queryExpr), extraParamters)
 
-				var response PromResp
-				if unmarshalPrometheus(ctx, httpClnt, endpoint, &response) {
-					return
-				}
+			var response PromResp
+			if err := fetchPrometheus(ctx, httpClnt, endpoint, &response); err != nil {
+				ErrorWithContext(ctx, err)
+				targetErrors[idx] = fmt.Errorf("target %d: %w", idx, err)
+				return
+			}
 
-				targetResult := models.ResultTarget{
-					LegendFormat: target.LegendFormat,
-					ResultType:   response.Data.ResultType,
-				}
+			targetResult := models.ResultTarget{
+				LegendFormat: target.LegendFormat,
+				ResultType:   response.Data.ResultType,
+			}
 
-				for _, r := range response.Data.Result {
-					targetResult.Result = append(targetResult.Result, &models.WidgetResult{
-						Metric: r.Metric,
-						Values: r.Values,
-					})
-				}
+			for _, r := range response.Data.Result {
+				targetResult.Result = append(targetResult.Result, &models.WidgetResult{
+					Metric: r.Metric,
+					Values: r.Values,
+				})
+			}
 
-				targetResults[idx] = &targetResult
-			}(idx, target, step, start, end)
-		}
+			targetResults[idx] = &targetResult
+		}(idx, target, step, start, end)
+	}
 
-		wg.Wait()
+	wg.Wait()
 
-		wdgtResult := models.WidgetDetails{
-			ID:    m.ID,
-			Title: m.Title,
-			Type:  m.Type,
-		}
-		if len(m.Options.ReduceOptions.Calcs) > 0 {
-			wdgtResult.Options = &models.WidgetDetailsOptions{
-				ReduceOptions: &models.WidgetDetailsOptionsReduceOptions{
-					Calcs: m.Options.ReduceOptions.Calcs,
-				},
-			}
+	wdgtResult := models.WidgetDetails{
+		ID:    m.ID,
+		Title: m.Title,
+		Type:  m.Type,
+	}
+	if len(m.Options.ReduceOptions.Calcs) > 0 {
+		wdgtResult.Options = &models.WidgetDetailsOptions{
+			ReduceOptions: &models.WidgetDetailsOptionsReduceOptions{
+				Calcs: m.Options.ReduceOptions.Calcs,
+			},
 		}
+	}
 
-		for _, res := range targetResults {
-			if res != nil {
-				wdgtResult.Targets = append(wdgtResult.Targets, res)
-			}
+	for _, res := range targetResults {
+		if res != nil {
+			wdgtResult.Targets = append(wdgtResult.Targets, res)
 		}
-		return &wdgtResult, nil
 	}
-
-	return nil, &CodedAPIError{Code: 404, APIError: &models.APIError{Message: "Widget not found"}}
+	return &wdgtResult, errors.Join(targetErrors...)
 }
@@ -0,0 +1,427 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/swag"
+	systemApi "github.com/openstor/console/api/operations/system"
+	"github.com/openstor/console/models"
+	"github.com/openstor/console/pkg/utils"
+	"github.com/openstor/openstor-go/v7"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"gopkg.in/yaml.v3"
+)
+
+// Sources of the user-defined widgets
+const (
+	customWidgetSourceFile   = "file"
+	customWidgetSourceBucket = "bucket"
+)
+
+// customWidgetNamespaceRegexp validates the namespaces of the user-defined widgets
+var customWidgetNamespaceRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// customWidgetTypes are the widget types the dashboard can render
+var customWidgetTypes = map[string]bool{"stat": true, "singlestat": true, "gauge": true, "graph": true, "bargauge": true}
+
+// customWidgetCalcs are the reduce calculations the dashboard supports
+var customWidgetCalcs = map[string]bool{"mean": true, "last": true, "lastNotNull": true}
+
+// customWidgetDefinitions is the format of the files defining widgets, in YAML or JSON:
+//
+//	namespaces:
+//	  storage-team:
+//	    - id: 1
+//	      title: Bucket growth
+//	      type: graph
+//	      targets:
+//	        - expr: minio_cluster_usage_total_bytes{$__query}
+//	          legendFormat: Used Capacity
+type customWidgetDefinitions struct {
+	Namespaces map[string][]Metric `json:"namespaces" yaml:"namespaces"`
+}
+
+// decodeStrict decodes a YAML or JSON document, rejecting the unknown fields
+func decodeStrict(data []byte, v interface{}) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// validateCustomWidget checks that the dashboard can render the widget
+func validateCustomWidget(m Metric) error {
+	if m.ID <= 0 {
+		return fmt.Errorf("the widget id must be positive")
+	}
+	if m.Title == "" {
+		return fmt.Errorf("widget %d: the title is required", m.ID)
+	}
+	if !customWidgetTypes[m.Type] {
+		return fmt.Errorf("widget %d: invalid type %s", m.ID, m.Type)
+	}
+	for _, calc := range m.Options.ReduceOptions.Calcs {
+		if !customWidgetCalcs[calc] {
+			return fmt.Errorf("widget %d: invalid calculation %s", m.ID, calc)
+		}
+	}
+	if len(m.Targets) == 0 {
+		return fmt.Errorf("widget %d: at least one target is required", m.ID)
+	}
+	for idx, target := range m.Targets {
+		if target.Expr == "" {
+			return fmt.Errorf("widget %d: the expression of target %d is required", m.ID, idx)
+		}
+		if target.Step < 0 {
+			return fmt.Errorf("widget %d: the step of target %d can't be negative", m.ID, idx)
+		}
+	}
+	return nil
+}
+
+// parseCustomWidgets parses and validates the widgets of a definitions file
+func parseCustomWidgets(data []byte) (map[string][]Metric, error) {
+	var definitions customWidgetDefinitions
+	if err := decodeStrict(data, &definitions); err != nil {
+		return nil, fmt.Errorf("invalid widget definitions: %w", err)
+	}
+	for namespace, metrics := range definitions.Namespaces {
+		if !customWidgetNamespaceRegexp.MatchString(namespace) {
+			return nil, fmt.Errorf("invalid widget namespace %s", namespace)
+		}
+		ids := map[int32]bool{}
+		for _, m := range metrics {
+			if err := validateCustomWidget(m); err != nil {
+				return nil, fmt.Errorf("namespace %s: %w", namespace, err)
+			}
+			if ids[m.ID] {
+				return nil, fmt.Errorf("namespace %s: duplicate widget %d", namespace, m.ID)
+			}
+			ids[m.ID] = true
+		}
+	}
+	return definitions.Namespaces, nil
+}
+
+// customWidgetSet holds the widgets of a source. The last definitions that could be loaded are kept when the
+// source becomes invalid, the error is reported instead.
+type customWidgetSet struct {
+	version    string
+	namespaces map[string][]Metric
+	err        error
+	checkedAt  time.Time
+}
+
+// update replaces the widgets when the definitions changed
+func (s *customWidgetSet) update(data []byte, version string) {
+	if version == s.version {
+		return
+	}
+	s.version = version
+	if data == nil {
+		s.namespaces, s.err = nil, nil
+		return
+	}
+	namespaces, err := parseCustomWidgets(data)
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.namespaces, s.err = namespaces, nil
+}
+
+// customWidgetRegistry holds the user-defined widgets of the definitions file and of the system bucket, both
+// reloaded when they change
+type customWidgetRegistry struct {
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	file   customWidgetSet
+	bucket customWidgetSet
+}
+
+// globalCustomWidgets holds the user-defined widgets
+var globalCustomWidgets = &customWidgetRegistry{interval: 30 * time.Second, now: time.Now}
+
+// customWidgetStore reads the widget definitions kept in the system bucket
+type customWidgetStore interface {
+	// load returns the definitions and their version, or nil definitions when there are none. The definitions
+	// aren't read again while their version doesn't change.
+	load(ctx context.Context, version string) ([]byte, string, error)
+}
+
+// bucketCustomWidgetStore reads the widget definitions object of the system bucket
+type bucketCustomWidgetStore struct {
+	client *openstor.Client
+	bucket string
+	object string
+}
+
+func (s *bucketCustomWidgetStore) load(ctx context.Context, version string) ([]byte, string, error) {
+	info, err := s.client.StatObject(ctx, s.bucket, s.object, openstor.StatObjectOptions{})
+	if err != nil {
+		// no widget is defined in the bucket
+		switch openstor.ToErrorResponse(err).Code {
+		case "NoSuchBucket", "NoSuchKey":
+			return nil, "", nil
+		}
+		return nil, version, err
+	}
+	if info.ETag == version {
+		return nil, version, nil
+	}
+	object, err := s.client.GetObject(ctx, s.bucket, s.object, openstor.GetObjectOptions{})
+	if err != nil {
+		return nil, version, err
+	}
+	defer object.Close()
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return nil, version, err
+	}
+	return data, info.ETag, nil
+}
+
+// reloadFile reloads the definitions file when it changed
+func (r *customWidgetRegistry) reloadFile(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.file.checkedAt = r.now()
+	info, err := os.Stat(path)
+	if err != nil {
+		r.file.err = err
+		return
+	}
+	version := info.ModTime().String() + "/" + strconv.FormatInt(info.Size(), 10)
+	if version == r.file.version {
+		return
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		r.file.err = err
+		return
+	}
+	r.file.update(data, version)
+}
+
+// refreshBucket reloads the definitions of the system bucket when they changed, they are checked at most once
+// per reload interval
+func (r *customWidgetRegistry) refreshBucket(ctx context.Context, store customWidgetStore) {
+	r.mu.Lock()
+	if r.now().Sub(r.bucket.checkedAt) < r.interval {
+		r.mu.Unlock()
+		return
+	}
+	r.bucket.checkedAt = r.now()
+	version := r.bucket.version
+	r.mu.Unlock()
+
+	data, newVersion, err := store.load(ctx, version)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		r.bucket.err = err
+		return
+	}
+	if newVersion == version {
+		r.bucket.err = nil
+		return
+	}
+	r.bucket.update(data, newVersion)
+}
+
+// list returns the user-defined widgets sorted by namespace and id, and the errors of the sources. A namespace
+// defined by the file can't be redefined in the bucket.
+func (r *customWidgetRegistry) list() ([]*models.CustomWidget, []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var (
+		wdgts []*models.CustomWidget
+		errs  []string
+	)
+	for _, source := range []struct {
+		name string
+		set  *customWidgetSet
+	}{{customWidgetSourceFile, &r.file}, {customWidgetSourceBucket, &r.bucket}} {
+		if source.set.err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", source.name, source.set.err))
+		}
+		for namespace, metrics := range source.set.namespaces {
+			if source.set == &r.bucket && r.file.namespaces[namespace] != nil {
+				errs = append(errs, fmt.Sprintf("%s: namespace %s is already defined by the file", source.name, namespace))
+				continue
+			}
+			for _, m := range metrics {
+				wdgts = append(wdgts, &models.CustomWidget{
+					ID:        fmt.Sprintf("%s/%d", namespace, m.ID),
+					Namespace: namespace,
+					WidgetID:  m.ID,
+					Title:     m.Title,
+					Type:      m.Type,
+					Source:    source.name,
+				})
+			}
+		}
+	}
+	sort.Slice(wdgts, func(i, j int) bool {
+		if wdgts[i].Namespace != wdgts[j].Namespace {
+			return wdgts[i].Namespace < wdgts[j].Namespace
+		}
+		return wdgts[i].WidgetID < wdgts[j].WidgetID
+	})
+	sort.Strings(errs)
+	return wdgts, errs
+}
+
+// find returns a user-defined widget, the file has precedence over the bucket
+func (r *customWidgetRegistry) find(namespace string, widgetID int32) (Metric, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	metrics, ok := r.file.namespaces[namespace]
+	if !ok {
+		metrics = r.bucket.namespaces[namespace]
+	}
+	for _, m := range metrics {
+		if m.ID == widgetID {
+			return m, true
+		}
+	}
+	return Metric{}, false
+}
+
+// startCustomWidgets loads the widget definitions file when one is set and reloads it when it changes, it
+// returns the function stopping the reloads
+func startCustomWidgets() func() {
+	globalCustomWidgets.interval = getDashboardWidgetsReloadInterval()
+	path := getDashboardWidgetsFile()
+	if path == "" {
+		return func() {}
+	}
+	globalCustomWidgets.reloadFile(path)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(globalCustomWidgets.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				globalCustomWidgets.reloadFile(path)
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// refreshCustomWidgets reloads the widget definitions of the system bucket with the background credentials, the
+// widgets shared by every session don't depend on what the session calling it can read. The bucket isn't a source
+// of widgets when the background credentials aren't set.
+func refreshCustomWidgets(ctx context.Context) {
+	background := globalBackgroundService
+	if background == nil {
+		return
+	}
+	globalCustomWidgets.refreshBucket(ctx, &bucketCustomWidgetStore{client: background.client, bucket: getSystemBucket(), object: getDashboardWidgetsObject()})
+}
+
+func getListCustomWidgetsResponse(_ *models.Principal, params systemApi.ListCustomWidgetsParams) (*models.CustomWidgetsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	refreshCustomWidgets(ctx)
+	wdgts, errs := globalCustomWidgets.list()
+	return &models.CustomWidgetsResponse{Widgets: wdgts, Errors: errs}, nil
+}
+
+// errPrometheusNotConfigured is returned when a user-defined widget is queried without Prometheus
+var errPrometheusNotConfigured = &CodedAPIError{
+	Code: 400,
+	APIError: &models.APIError{
+		Message:         ErrBadRequest.Error(),
+		DetailedMessage: "the user-defined widgets require Prometheus to be configured",
+	},
+}
+
+// getCustomWidgetDetailsResponse runs the queries of a user-defined widget. It requires the permission to display
+// the dashboard, the widgets only run the queries of the definitions set by the administrators.
+func getCustomWidgetDetailsResponse(session *models.Principal, params systemApi.CustomWidgetDetailsParams) (*models.WidgetDetails, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if apiErr := checkConsolePermission(ctx, session, iampolicy.ServerInfoAdminAction); apiErr != nil {
+		return nil, apiErr
+	}
+	clientIP := getClientIP(params.HTTPRequest)
+	refreshCustomWidgets(ctx)
+	m, ok := globalCustomWidgets.find(params.Namespace, params.WidgetID)
+	if !ok {
+		return nil, &CodedAPIError{Code: 404, APIError: &models.APIError{Message: "Widget not found"}}
+	}
+	prometheusURL := getPrometheusURL()
+	if prometheusURL == "" {
+		return nil, errPrometheusNotConfigured
+	}
+	ctx = context.WithValue(ctx, utils.ContextClientIP, clientIP)
+	return getMetricDetails(ctx, prometheusURL, getPrometheusSelector(), m, params.Step, params.Start, params.End, false)
+}
+
+// getTestCustomWidgetResponse validates a widget definition and runs its queries, the queries that fail are
+// reported. It requires the permission to update the configuration, as any query can be run.
+func getTestCustomWidgetResponse(session *models.Principal, params systemApi.TestCustomWidgetParams) (*models.WidgetDetails, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if apiErr := checkConsolePermission(ctx, session, iampolicy.ConfigUpdateAdminAction); apiErr != nil {
+		return nil, apiErr
+	}
+
+	var m Metric
+	err := decodeStrict([]byte(swag.StringValue(params.Body.Definition)), &m)
+	if err == nil {
+		err = validateCustomWidget(m)
+	}
+	if err != nil {
+		return nil, &CodedAPIError{
+			Code: 400,
+			APIError: &models.APIError{
+				Message:         ErrBadRequest.Error(),
+				DetailedMessage: err.Error(),
+			},
+		}
+	}
+	prometheusURL := getPrometheusURL()
+	if prometheusURL == "" {
+		return nil, errPrometheusNotConfigured
+	}
+	var step *int32
+	if params.Body.Step > 0 {
+		step = &params.Body.Step
+	}
+	var start, end *int64
+	if params.Body.Start != 0 && params.Body.End != 0 {
+		start, end = &params.Body.Start, &params.Body.End
+	}
+	ctx = context.WithValue(ctx, utils.ContextClientIP, getClientIP(params.HTTPRequest))
+	return getMetricDetails(ctx, prometheusURL, getPrometheusSelector(), m, step, start, end, true)
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const customWidgetsTestDefinitions = `
+namespaces:
+  storage-team:
+    - id: 2
+      title: Bucket growth
+      type: graph
+      targets:
+        - expr: minio_cluster_usage_total_bytes{$__query}
+          legendFormat: Used Capacity
+    - id: 1
+      title: Objects
+      type: stat
+      options:
+        reduceOptions:
+          calcs: [lastNotNull]
+      targets:
+        - expr: minio_cluster_usage_object_total{$__query}
+`
+
+func TestParseCustomWidgets(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    int
+		wantErr string
+	}{
+		{name: "yaml", data: customWidgetsTestDefinitions, want: 2},
+		{name: "json", data: `{"namespaces":{"ops":[{"id":1,"title":"Up","type":"stat","targets":[{"expr":"up"}]}]}}`, want: 1},
+		{name: "empty", data: "", want: 0},
+		{name: "unknown field", data: `{"namespaces":{"ops":[{"id":1,"title":"Up","type":"stat","query":"up"}]}}`, wantErr: "field query not found"},
+		{name: "invalid namespace", data: `{"namespaces":{"Ops Team":[]}}`, wantErr: "invalid widget namespace"},
+		{name: "invalid type", data: `{"namespaces":{"ops":[{"id":1,"title":"Up","type":"pie","targets":[{"expr":"up"}]}]}}`, wantErr: "invalid type pie"},
+		{name: "invalid calculation", data: `{"namespaces":{"ops":[{"id":1,"title":"Up","type":"stat","options":{"reduceOptions":{"calcs":["max"]}},"targets":[{"expr":"up"}]}]}}`, wantErr: "invalid calculation max"},
+		{name: "missing target", data: `{"namespaces":{"ops":[{"id":1,"title":"Up","type":"stat"}]}}`, wantErr: "at least one target"},
+		{name: "missing expression", data: `{"namespaces":{"ops":[{"id":1,"title":"Up","type":"stat","targets":[{"legendFormat":"up"}]}]}}`, wantErr: "expression of target 0"},
+		{name: "duplicate id", data: `{"namespaces":{"ops":[{"id":1,"title":"Up","type":"stat","targets":[{"expr":"up"}]},{"id":1,"title":"Down","type":"stat","targets":[{"expr":"up"}]}]}}`, wantErr: "duplicate widget 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespaces, err := parseCustomWidgets([]byte(tt.data))
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			count := 0
+			for _, metrics := range namespaces {
+				count += len(metrics)
+			}
+			assert.Equal(t, tt.want, count)
+		})
+	}
+}
+
+type customWidgetStoreMock struct {
+	data    string
+	version string
+	loads   int
+}
+
+func (s *customWidgetStoreMock) load(_ context.Context, version string) ([]byte, string, error) {
+	s.loads++
+	if s.version == version || s.data == "" {
+		return nil, s.version, nil
+	}
+	return []byte(s.data), s.version, nil
+}
+
+func TestCustomWidgetRegistry(t *testing.T) {
+	funcAssert := assert.New(t)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	registry := &customWidgetRegistry{interval: time.Minute, now: func() time.Time { return now }}
+	path := filepath.Join(t.TempDir(), "widgets.yaml")
+	funcAssert.NoError(os.WriteFile(path, []byte(customWidgetsTestDefinitions), 0o600))
+
+	// Test-1: the widgets of the file are listed sorted
+	registry.reloadFile(path)
+	wdgts, errs := registry.list()
+	funcAssert.Empty(errs)
+	if funcAssert.Len(wdgts, 2) {
+		funcAssert.Equal("storage-team/1", wdgts[0].ID)
+		funcAssert.Equal(customWidgetSourceFile, wdgts[0].Source)
+	}
+
+	// Test-2: the previous widgets are kept when the file becomes invalid
+	funcAssert.NoError(os.WriteFile(path, []byte("namespaces: {ops: [{id: 1}]}"), 0o600))
+	funcAssert.NoError(os.Chtimes(path, now, now.Add(time.Second)))
+	registry.reloadFile(path)
+	wdgts, errs = registry.list()
+	funcAssert.Len(wdgts, 2)
+	funcAssert.Len(errs, 1)
+
+	// Test-3: the widgets of the bucket are merged, a namespace of the file can't be redefined
+	store := &customWidgetStoreMock{
+		version: "etag-1",
+		data: `{"namespaces":{"ops":[{"id":1,"title":"Up","type":"stat","targets":[{"expr":"up"}]}],
+		"storage-team":[{"id":9,"title":"Other","type":"stat","targets":[{"expr":"up"}]}]}}`,
+	}
+	registry.refreshBucket(context.Background(), store)
+	wdgts, errs = registry.list()
+	if funcAssert.Len(wdgts, 3) {
+		funcAssert.Equal("ops/1", wdgts[0].ID)
+		funcAssert.Equal(customWidgetSourceBucket, wdgts[0].Source)
+	}
+	funcAssert.Len(errs, 2)
+	_, ok := registry.find("ops", 1)
+	funcAssert.True(ok)
+	_, ok = registry.find("storage-team", 9)
+	funcAssert.False(ok)
+
+	// Test-4: the bucket is only checked once per interval
+	registry.refreshBucket(context.Background(), store)
+	funcAssert.Equal(1, store.loads)
+	now = now.Add(time.Minute)
+	store.data, store.version = "", ""
+	registry.refreshBucket(context.Background(), store)
+	funcAssert.Equal(2, store.loads)
+	_, ok = registry.find("ops", 1)
+	funcAssert.False(ok)
+}
+
+func TestGetMetricDetailsStrict(t *testing.T) {
+	funcAssert := assert.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/-/healthy":
+		case strings.HasPrefix(r.URL.Path, "/api/v1/label/"):
+			w.Write([]byte(`{"status":"success","data":[]}`))
+		case r.URL.Query().Get("query") == "up":
+			w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"job":"minio"},"values":[[1740830400,"1"]]}]}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+		}
+	}))
+	defer server.Close()
+
+	m := Metric{ID: 1, Title: "Up", Type: "graph", Targets: []Target{{Expr: "up"}, {Expr: "up{"}}}
+
+	// Test-1: the dashboard ignores the queries that fail
+	details, apiErr := getMetricDetails(context.Background(), server.URL, "", m, nil, nil, nil, false)
+	funcAssert.Nil(apiErr)
+	funcAssert.Len(details.Targets, 1)
+
+	// Test-2: testing a widget reports them
+	_, apiErr = getMetricDetails(context.Background(), server.URL, "", m, nil, nil, nil, true)
+	if funcAssert.NotNil(apiErr) {
+		funcAssert.Equal(400, apiErr.Code)
+		funcAssert.Contains(apiErr.APIError.DetailedMessage, "target 1")
+		funcAssert.Contains(apiErr.APIError.DetailedMessage, "parse error")
+	}
+}
@@ -0,0 +1,470 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+)
+
+// infoSampleMaxSize is the maximum size of a sample in the sampler file, the drives of large clusters are listed
+const infoSampleMaxSize = 16 << 20
+
+// infoSamplerFile is the file of the sampler directory keeping the samples, one JSON sample per line
+const infoSamplerFile = "samples.ndjson"
+
+// globalInfoSampler records the history of the server info when Prometheus isn't configured
+var globalInfoSampler *infoSampler
+
+// infoDriveSample is the usage of a drive at the time of a sample
+type infoDriveSample struct {
+	Server    string `json:"server"`
+	Drive     string `json:"drive"`
+	State     string `json:"state"`
+	UsedSpace int64  `json:"usedSpace"`
+}
+
+// infoSample is the server info recorded by the sampler
+type infoSample struct {
+	Time           time.Time         `json:"time"`
+	Buckets        int64             `json:"buckets"`
+	Objects        int64             `json:"objects"`
+	Usage          int64             `json:"usage"`
+	TotalSpace     int64             `json:"totalSpace"`
+	UsedSpace      int64             `json:"usedSpace"`
+	AvailableSpace int64             `json:"availableSpace"`
+	UsableSpace    int64             `json:"usableSpace"`
+	UsableFree     int64             `json:"usableFree"`
+	OnlineDrives   int64             `json:"onlineDrives"`
+	OfflineDrives  int64             `json:"offlineDrives"`
+	HealingDrives  int64             `json:"healingDrives"`
+	OnlineServers  int64             `json:"onlineServers"`
+	OfflineServers int64             `json:"offlineServers"`
+	Uptime         int64             `json:"uptime"`
+	Drives         []infoDriveSample `json:"drives,omitempty"`
+}
+
+// infoSeriesPoint is the value of a series of a sampled widget target
+type infoSeriesPoint struct {
+	labels map[string]string
+	value  int64
+}
+
+// infoClusterLabels are the labels of the series of the cluster wide values
+var infoClusterLabels = map[string]string{"instance": "cluster"}
+
+func infoClusterPoint(value int64) []infoSeriesPoint {
+	return []infoSeriesPoint{{labels: infoClusterLabels, value: value}}
+}
+
+// sampledWidgets are the widgets the sampler answers without Prometheus, the series are listed in the order of
+// the targets of the widget
+var sampledWidgets = map[int32][]func(s infoSample) []infoSeriesPoint{
+	// Uptime
+	1: {func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.Uptime) }},
+	// Current Usable Free Capacity
+	50: {
+		func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.UsableSpace) },
+		func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.UsableFree) },
+		func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.UsableSpace - s.UsableFree) },
+	},
+	// Current Usable Total Bytes
+	51: {func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.UsableSpace) }},
+	// Data Usage Growth
+	68: {func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.Usage) }},
+	// Total Online Servers
+	53: {func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.OnlineServers) }},
+	// Total Offline Servers
+	69: {func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.OfflineServers) }},
+	// Total Online Drives
+	9: {func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.OnlineDrives) }},
+	// Total Offline Drives
+	78: {func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.OfflineDrives) }},
+	// Number of Buckets
+	66: {func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.Buckets) }},
+	// Number of Objects
+	44: {func(s infoSample) []infoSeriesPoint { return infoClusterPoint(s.Objects) }},
+	// Drive Used Capacity
+	74: {func(s infoSample) []infoSeriesPoint {
+		points := make([]infoSeriesPoint, 0, len(s.Drives))
+		for _, drive := range s.Drives {
+			points = append(points, infoSeriesPoint{
+				labels: map[string]string{"server": drive.Server, "drive": drive.Drive},
+				value:  drive.UsedSpace,
+			})
+		}
+		return points
+	}},
+}
+
+// infoSampler periodically records the server info into a ring buffer, so the dashboard can show trends
+// without a monitoring stack. When a directory is set, every sample is appended to the sampler file so they
+// survive a restart of the console.
+type infoSampler struct {
+	client   MinioAdmin
+	interval time.Duration
+	path     string
+	now      func() time.Time
+	// lines is the number of samples in the sampler file, it is compacted once it holds twice the capacity
+	lines int
+
+	mu      sync.RWMutex
+	samples []infoSample
+	next    int
+	count   int
+}
+
+// newInfoSampler returns a sampler keeping the samples of the retention period, loading the samples kept in the
+// directory when one is set
+func newInfoSampler(client MinioAdmin, interval, retention time.Duration, dir string) (*infoSampler, error) {
+	capacity := 1
+	if interval > 0 && retention > interval {
+		capacity = int(retention / interval)
+	}
+	s := &infoSampler{
+		client:   client,
+		interval: interval,
+		now:      time.Now,
+		samples:  make([]infoSample, capacity),
+	}
+	if dir == "" {
+		return s, nil
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	s.path = filepath.Join(dir, infoSamplerFile)
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, infoSampleMaxSize)
+	for scanner.Scan() {
+		var sample infoSample
+		if err := json.Unmarshal(scanner.Bytes(), &sample); err != nil {
+			// a sample partially written by a crash is skipped
+			continue
+		}
+		s.add(sample)
+		s.lines++
+	}
+	if err := scanner.Err(); err != nil {
+		// a corrupted file only loses the rest of the history
+		LogError("unable to load the server info samples: %v", err)
+	}
+	return s, nil
+}
+
+// add records a sample, replacing the oldest one when the ring buffer is full
+func (s *infoSampler) add(sample infoSample) {
+	s.samples[s.next] = sample
+	s.next = (s.next + 1) % len(s.samples)
+	if s.count < len(s.samples) {
+		s.count++
+	}
+}
+
+// list returns the samples between from and to, oldest first
+func (s *infoSampler) list(from, to time.Time) []infoSample {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var samples []infoSample
+	first := (s.next - s.count + len(s.samples)) % len(s.samples)
+	for i := 0; i < s.count; i++ {
+		sample := s.samples[(first+i)%len(s.samples)]
+		if sample.Time.Before(from) || sample.Time.After(to) {
+			continue
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+// save appends a sample to the sampler file, the file is rewritten with the samples of the ring buffer once it
+// holds twice as many
+func (s *infoSampler) save(sample infoSample) error {
+	if s.path == "" {
+		return nil
+	}
+	if s.lines >= 2*len(s.samples) {
+		return s.compact()
+	}
+	raw, err := json.Marshal(sample)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Write(append(raw, '\n')); err != nil {
+		return err
+	}
+	s.lines++
+	return nil
+}
+
+// compact rewrites the sampler file with the samples of the ring buffer, the file is written aside and renamed
+// so a crash never leaves a partial file
+func (s *infoSampler) compact() error {
+	var buf bytes.Buffer
+	samples := s.list(time.Time{}, s.now())
+	for _, sample := range samples {
+		raw, err := json.Marshal(sample)
+		if err != nil {
+			return err
+		}
+		buf.Write(raw)
+		buf.WriteByte('\n')
+	}
+	if err := os.WriteFile(s.path+".tmp", buf.Bytes(), 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(s.path+".tmp", s.path); err != nil {
+		os.Remove(s.path + ".tmp")
+		return err
+	}
+	s.lines = len(samples)
+	return nil
+}
+
+// isUsableDrive returns whether a drive holds data rather than parity, the capacity of the data drives is the
+// usable capacity of the cluster
+func isUsableDrive(backend madmin.ErasureBackend, drive madmin.Disk) bool {
+	if len(backend.DrivesPerSet) == 0 {
+		// a single drive deployment has no parity
+		return true
+	}
+	if drive.PoolIndex < 0 || drive.PoolIndex >= len(backend.DrivesPerSet) {
+		return false
+	}
+	return drive.DiskIndex < backend.DrivesPerSet[drive.PoolIndex]-backend.StandardSCParity
+}
+
+// sample records the current server info
+func (s *infoSampler) sample(ctx context.Context) error {
+	info, err := s.client.serverInfo(ctx)
+	if err != nil {
+		return err
+	}
+	sample := infoSample{
+		Time:    s.now().UTC(),
+		Buckets: int64(info.Buckets.Count),
+		Objects: int64(info.Objects.Count),
+		Usage:   int64(info.Usage.Size),
+	}
+	backend := info.Backend
+	sample.OnlineDrives = int64(backend.OnlineDisks)
+	sample.OfflineDrives = int64(backend.OfflineDisks)
+	for _, server := range info.Servers {
+		if server.State == string(madmin.ItemOnline) {
+			sample.OnlineServers++
+		} else {
+			sample.OfflineServers++
+		}
+		if server.Uptime > sample.Uptime {
+			sample.Uptime = server.Uptime
+		}
+		for _, drive := range server.Disks {
+			sample.TotalSpace += int64(drive.TotalSpace)
+			sample.UsedSpace += int64(drive.UsedSpace)
+			sample.AvailableSpace += int64(drive.AvailableSpace)
+			if isUsableDrive(backend, drive) {
+				sample.UsableSpace += int64(drive.TotalSpace)
+				sample.UsableFree += int64(drive.AvailableSpace)
+			}
+			if drive.Healing {
+				sample.HealingDrives++
+			}
+			sample.Drives = append(sample.Drives, infoDriveSample{
+				Server:    server.Endpoint,
+				Drive:     drive.DrivePath,
+				State:     drive.State,
+				UsedSpace: int64(drive.UsedSpace),
+			})
+		}
+	}
+	s.mu.Lock()
+	s.add(sample)
+	s.mu.Unlock()
+	return s.save(sample)
+}
+
+func (s *infoSampler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.sample(ctx); err != nil && ctx.Err() == nil {
+			LogError("server info sampler: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// startInfoSampler starts the sampler when Prometheus isn't configured, it is enabled and the background
+// credentials are set, it returns the function stopping it
+func startInfoSampler() func() {
+	background := globalBackgroundService
+	if getPrometheusURL() != "" || background == nil || !getInfoSamplerEnabled() {
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	interval := getInfoSamplerInterval()
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	sampler, err := newInfoSampler(background.admin, interval, getInfoSamplerRetention(), getInfoSamplerDir())
+	if err != nil {
+		LogError("unable to start the server info sampler: %v", err)
+		cancel()
+		return func() {}
+	}
+	globalInfoSampler = sampler
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sampler.run(ctx)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// sampledWidgetList returns the widgets of the dashboard answered by the sampler
+func sampledWidgetList() []*models.Widget {
+	var wdgts []*models.Widget
+	for _, m := range widgets {
+		if _, ok := sampledWidgets[m.ID]; !ok {
+			continue
+		}
+		wdgtResult := models.Widget{
+			ID:    m.ID,
+			Title: m.Title,
+			Type:  m.Type,
+		}
+		if len(m.Options.ReduceOptions.Calcs) > 0 {
+			wdgtResult.Options = &models.WidgetOptions{
+				ReduceOptions: &models.WidgetOptionsReduceOptions{
+					Calcs: m.Options.ReduceOptions.Calcs,
+				},
+			}
+		}
+		wdgts = append(wdgts, &wdgtResult)
+	}
+	return wdgts
+}
+
+// widgetDetails returns the history of a widget in the shape of the Prometheus range queries. The widgets that
+// can't be sampled are returned without targets.
+func (s *infoSampler) widgetDetails(widgetID int32, step *int32, start *int64, end *int64) (*models.WidgetDetails, *CodedAPIError) {
+	for _, m := range widgets {
+		if m.ID != widgetID {
+			continue
+		}
+		wdgtResult := models.WidgetDetails{
+			ID:    m.ID,
+			Title: m.Title,
+			Type:  m.Type,
+		}
+		if len(m.Options.ReduceOptions.Calcs) > 0 {
+			wdgtResult.Options = &models.WidgetDetailsOptions{
+				ReduceOptions: &models.WidgetDetailsOptionsReduceOptions{
+					Calcs: m.Options.ReduceOptions.Calcs,
+				},
+			}
+		}
+		series := sampledWidgets[m.ID]
+		for idx, target := range m.Targets {
+			if idx >= len(series) {
+				break
+			}
+			samples := s.targetSamples(target, step, start, end)
+			wdgtResult.Targets = append(wdgtResult.Targets, infoTargetResult(target, samples, series[idx]))
+		}
+		return &wdgtResult, nil
+	}
+
+	return nil, &CodedAPIError{Code: 404, APIError: &models.APIError{Message: "Widget not found"}}
+}
+
+// targetSamples returns the samples of the range of the target, keeping the last sample of every step
+func (s *infoSampler) targetSamples(target Target, inStep *int32, inStart *int64, inEnd *int64) []infoSample {
+	now := s.now()
+	var initTime int64 = -15
+	if target.InitialTime != 0 {
+		initTime = target.InitialTime
+	}
+	from, to := now.Add(time.Duration(initTime)*time.Minute), now
+	if inStart != nil && inEnd != nil {
+		from, to = time.Unix(*inStart, 0), time.Unix(*inEnd, 0)
+	}
+	var step int64 = 60
+	if target.Step > 0 {
+		step = int64(target.Step)
+	}
+	if inStep != nil && *inStep > 0 {
+		step = int64(*inStep)
+	}
+
+	var samples []infoSample
+	for _, sample := range s.list(from, to) {
+		if n := len(samples); n > 0 && samples[n-1].Time.Unix()/step == sample.Time.Unix()/step {
+			samples[n-1] = sample
+			continue
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+// infoTargetResult builds the result of a target from the series of the samples, as a Prometheus matrix
+func infoTargetResult(target Target, samples []infoSample, series func(s infoSample) []infoSeriesPoint) *models.ResultTarget {
+	targetResult := &models.ResultTarget{
+		LegendFormat: target.LegendFormat,
+		ResultType:   "matrix",
+	}
+	results := map[string]*models.WidgetResult{}
+	var keys []string
+	for _, sample := range samples {
+		for _, point := range series(sample) {
+			key := fmt.Sprint(point.labels)
+			result, ok := results[key]
+			if !ok {
+				result = &models.WidgetResult{Metric: point.labels}
+				results[key] = result
+				keys = append(keys, key)
+			}
+			result.Values = append(result.Values, []interface{}{sample.Time.Unix(), strconv.FormatInt(point.value, 10)})
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		targetResult.Result = append(targetResult.Result, results[key])
+	}
+	return targetResult
+}
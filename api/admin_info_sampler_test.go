@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openstor/madmin-go/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var infoSamplerTestTime = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestInfoSampler(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	var objects uint64
+	MinioServerInfoMock = func(_ context.Context) (madmin.InfoMessage, error) {
+		objects += 10
+		return madmin.InfoMessage{
+			Buckets: madmin.Buckets{Count: 2},
+			Objects: madmin.Objects{Count: objects},
+			Usage:   madmin.Usage{Size: objects * 100},
+			Servers: []madmin.ServerProperties{
+				{
+					State:    string(madmin.ItemOnline),
+					Endpoint: "node1:9000",
+					Uptime:   3600,
+					Disks: []madmin.Disk{
+						{DrivePath: "/data1", State: madmin.DriveStateOk, TotalSpace: 1000, UsedSpace: objects, AvailableSpace: 1000 - objects, DiskIndex: 0},
+						{DrivePath: "/data2", State: madmin.DriveStateOk, TotalSpace: 1000, UsedSpace: 2 * objects, AvailableSpace: 1000 - 2*objects, DiskIndex: 1},
+					},
+				},
+				{State: string(madmin.ItemOffline), Endpoint: "node2:9000"},
+			},
+			Backend: madmin.ErasureBackend{Type: "Erasure", OnlineDisks: 2, OfflineDisks: 4, StandardSCParity: 1, TotalSets: []int{1}, DrivesPerSet: []int{2}},
+		}, nil
+	}
+	dir := t.TempDir()
+	sampler, err := newInfoSampler(adminClient, time.Minute, 3*time.Minute, dir)
+	funcAssert.NoError(err)
+	now := infoSamplerTestTime
+	sampler.now = func() time.Time { return now }
+
+	// Test-1: the ring buffer only keeps the samples of the retention period
+	for i := 0; i < 5; i++ {
+		funcAssert.NoError(sampler.sample(context.Background()))
+		now = now.Add(time.Minute)
+	}
+	samples := sampler.list(time.Time{}, now)
+	if funcAssert.Len(samples, 3) {
+		funcAssert.Equal(int64(30), samples[0].Objects)
+		funcAssert.Equal(int64(50), samples[2].Objects)
+		funcAssert.Equal(int64(1), samples[2].OnlineServers)
+		funcAssert.Equal(int64(1), samples[2].OfflineServers)
+		funcAssert.Equal(int64(4), samples[2].OfflineDrives)
+		funcAssert.Equal(int64(2000), samples[2].TotalSpace)
+		funcAssert.Equal(int64(150), samples[2].UsedSpace)
+		// the parity drive isn't usable
+		funcAssert.Equal(int64(1000), samples[2].UsableSpace)
+		funcAssert.Equal(int64(950), samples[2].UsableFree)
+	}
+
+	// the samples are appended to the sampler file
+	raw, err := os.ReadFile(filepath.Join(dir, infoSamplerFile))
+	funcAssert.NoError(err)
+	funcAssert.Equal(5, strings.Count(string(raw), "\n"))
+
+	// Test-2: the samples survive a restart
+	reloaded, err := newInfoSampler(adminClient, time.Minute, 2*time.Minute, dir)
+	funcAssert.NoError(err)
+	reloaded.now = sampler.now
+	samples = reloaded.list(time.Time{}, now)
+	if funcAssert.Len(samples, 2) {
+		funcAssert.Equal(int64(40), samples[0].Objects)
+	}
+
+	// a sample partially written by a crash is skipped
+	f, err := os.OpenFile(filepath.Join(dir, infoSamplerFile), os.O_WRONLY|os.O_APPEND, 0o600)
+	funcAssert.NoError(err)
+	_, err = f.WriteString(`{"time":"2025-03`)
+	funcAssert.NoError(err)
+	funcAssert.NoError(f.Close())
+	reloaded, err = newInfoSampler(adminClient, time.Minute, 2*time.Minute, dir)
+	funcAssert.NoError(err)
+	funcAssert.Len(reloaded.list(time.Time{}, now), 2)
+
+	// Test-3: the widgets are served in the shape of the Prometheus range queries
+	start, end := infoSamplerTestTime.Unix(), now.Unix()
+	details, apiErr := sampler.widgetDetails(44, nil, &start, &end)
+	funcAssert.Nil(apiErr)
+	if funcAssert.Len(details.Targets, 1) && funcAssert.Len(details.Targets[0].Result, 1) {
+		funcAssert.Equal("matrix", details.Targets[0].ResultType)
+		funcAssert.Equal(map[string]string{"instance": "cluster"}, details.Targets[0].Result[0].Metric)
+		funcAssert.Equal([]interface{}{
+			[]interface{}{infoSamplerTestTime.Add(2 * time.Minute).Unix(), "30"},
+			[]interface{}{infoSamplerTestTime.Add(3 * time.Minute).Unix(), "40"},
+			[]interface{}{infoSamplerTestTime.Add(4 * time.Minute).Unix(), "50"},
+		}, details.Targets[0].Result[0].Values)
+	}
+
+	// a step larger than the interval keeps the last sample of every step
+	var step int32 = 120
+	details, apiErr = sampler.widgetDetails(44, &step, &start, &end)
+	funcAssert.Nil(apiErr)
+	funcAssert.Len(details.Targets[0].Result[0].Values, 2)
+
+	// a series per drive
+	details, apiErr = sampler.widgetDetails(74, nil, &start, &end)
+	funcAssert.Nil(apiErr)
+	if funcAssert.Len(details.Targets[0].Result, 2) {
+		funcAssert.Equal(map[string]string{"server": "node1:9000", "drive": "/data1"}, details.Targets[0].Result[0].Metric)
+		funcAssert.Equal([]interface{}{infoSamplerTestTime.Add(4 * time.Minute).Unix(), "100"}, details.Targets[0].Result[1].Values[2])
+	}
+
+	// the capacity widget has a series per target, of the usable capacity
+	details, apiErr = sampler.widgetDetails(50, nil, &start, &end)
+	funcAssert.Nil(apiErr)
+	if funcAssert.Len(details.Targets, 3) {
+		funcAssert.Equal("1000", details.Targets[0].Result[0].Values[0].([]interface{})[1])
+		funcAssert.Equal("950", details.Targets[1].Result[0].Values[0].([]interface{})[1])
+		funcAssert.Equal("50", details.Targets[2].Result[0].Values[0].([]interface{})[1])
+	}
+
+	// the widgets that can't be sampled have no targets
+	details, apiErr = sampler.widgetDetails(60, nil, &start, &end)
+	funcAssert.Nil(apiErr)
+	funcAssert.Empty(details.Targets)
+
+	_, apiErr = sampler.widgetDetails(1000, nil, &start, &end)
+	funcAssert.Equal(404, apiErr.Code)
+
+	// Test-4: the sampler file is compacted once it holds twice the capacity
+	funcAssert.NoError(sampler.sample(context.Background()))
+	funcAssert.NoError(sampler.sample(context.Background()))
+	raw, err = os.ReadFile(filepath.Join(dir, infoSamplerFile))
+	funcAssert.NoError(err)
+	funcAssert.Equal(3, strings.Count(string(raw), "\n"))
+}
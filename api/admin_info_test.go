@@ -79,11 +79,17 @@ func (suite *AdminInfoTestSuite) TestRegisterAdminInfoHandlers() {
 func (suite *AdminInfoTestSuite) assertHandlersAreNil(api *operations.ConsoleAPI) {
 	suite.assert.Nil(api.SystemAdminInfoHandler)
 	suite.assert.Nil(api.SystemDashboardWidgetDetailsHandler)
+	suite.assert.Nil(api.SystemListCustomWidgetsHandler)
+	suite.assert.Nil(api.SystemCustomWidgetDetailsHandler)
+	suite.assert.Nil(api.SystemTestCustomWidgetHandler)
 }
 
 func (suite *AdminInfoTestSuite) assertHandlersAreNotNil(api *operations.ConsoleAPI) {
 	suite.assert.NotNil(api.SystemAdminInfoHandler)
 	suite.assert.NotNil(api.SystemDashboardWidgetDetailsHandler)
+	suite.assert.NotNil(api.SystemListCustomWidgetsHandler)
+	suite.assert.NotNil(api.SystemCustomWidgetDetailsHandler)
+	suite.assert.NotNil(api.SystemTestCustomWidgetHandler)
 }
 
 func (suite *AdminInfoTestSuite) TestSystemAdminInfoHandlerWithError() {
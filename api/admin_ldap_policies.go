@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openstor/console/api/operations/idp"
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+)
+
+const (
+	ldapEntityUser  = "user"
+	ldapEntityGroup = "group"
+
+	ldapAssociationStatusSuccess = "success"
+	ldapAssociationStatusFailed  = "failed"
+)
+
+// getAttachLDAPPoliciesResponse performs updateLDAPPolicyAssociations() attaching the policies
+func getAttachLDAPPoliciesResponse(session *models.Principal, params idp.AttachLDAPPoliciesParams) (*models.LdapPolicyAssociationResponse, *CodedAPIError) {
+	return getLDAPPolicyAssociationResponse(params.HTTPRequest.Context(), session, params.Body, true)
+}
+
+// getDetachLDAPPoliciesResponse performs updateLDAPPolicyAssociations() detaching the policies
+func getDetachLDAPPoliciesResponse(session *models.Principal, params idp.DetachLDAPPoliciesParams) (*models.LdapPolicyAssociationResponse, *CodedAPIError) {
+	return getLDAPPolicyAssociationResponse(params.HTTPRequest.Context(), session, params.Body, false)
+}
+
+func getLDAPPolicyAssociationResponse(requestCtx context.Context, session *models.Principal, req *models.LdapPolicyAssociationRequest, attach bool) (*models.LdapPolicyAssociationResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(requestCtx)
+	defer cancel()
+	if req == nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("a list of policies and DNs is required"))
+	}
+	if err := validateLDAPPolicyAssociation(req); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	mAdmin, err := NewMinioAdminClient(requestCtx, session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	return updateLDAPPolicyAssociations(ctx, adminClient, req, attach), nil
+}
+
+func validateLDAPPolicyAssociation(req *models.LdapPolicyAssociationRequest) error {
+	if len(req.Policies) == 0 {
+		return errors.New("at least one policy is required")
+	}
+	for _, policy := range req.Policies {
+		if strings.TrimSpace(policy) == "" {
+			return errors.New("policy names can't be empty")
+		}
+	}
+	if len(req.Users) == 0 && len(req.Groups) == 0 {
+		return errors.New("at least one user or group DN is required")
+	}
+	for _, dn := range append(append([]string{}, req.Users...), req.Groups...) {
+		if strings.TrimSpace(dn) == "" {
+			return errors.New("DNs can't be empty")
+		}
+	}
+	return nil
+}
+
+// updateLDAPPolicyAssociations attaches or detaches the policies to every user and group DN. A failure doesn't
+// stop the update of the remaining DNs, the result of each one is reported instead.
+func updateLDAPPolicyAssociations(ctx context.Context, client MinioAdmin, req *models.LdapPolicyAssociationRequest, attach bool) *models.LdapPolicyAssociationResponse {
+	response := &models.LdapPolicyAssociationResponse{Results: []*models.LdapPolicyAssociationResult{}}
+	update := func(entityType, dn string) {
+		association := madmin.PolicyAssociationReq{Policies: req.Policies}
+		if entityType == ldapEntityUser {
+			association.User = dn
+		} else {
+			association.Group = dn
+		}
+		result := &models.LdapPolicyAssociationResult{EntityType: entityType, Dn: dn, Status: ldapAssociationStatusSuccess}
+		var resp madmin.PolicyAssociationResp
+		var err error
+		if attach {
+			resp, err = client.attachPolicyLDAP(ctx, association)
+			result.Policies = resp.PoliciesAttached
+		} else {
+			resp, err = client.detachPolicyLDAP(ctx, association)
+			result.Policies = resp.PoliciesDetached
+		}
+		if err != nil {
+			result.Status = ldapAssociationStatusFailed
+			result.Error = err.Error()
+		}
+		response.Results = append(response.Results, result)
+	}
+	for _, dn := range req.Users {
+		update(ldapEntityUser, strings.TrimSpace(dn))
+	}
+	for _, dn := range req.Groups {
+		update(ldapEntityGroup, strings.TrimSpace(dn))
+	}
+	return response
+}
+
+// getSearchLDAPEntitiesResponse performs searchLDAPEntities() and serializes it to the handler's output
+func getSearchLDAPEntitiesResponse(session *models.Principal, params idp.SearchLDAPEntitiesParams) (*models.LdapEntitiesSearchResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	var query, entityType string
+	if params.Query != nil {
+		query = *params.Query
+	}
+	if params.Type != nil {
+		entityType = *params.Type
+	}
+	if entityType != "" && entityType != ldapEntityUser && entityType != ldapEntityGroup {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("invalid type %s, must be %s or %s", entityType, ldapEntityUser, ldapEntityGroup))
+	}
+	limit := 0
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	result, err := searchLDAPEntities(ctx, adminClient, newLDAPDirectory(), query, entityType, limit)
+	if errors.Is(err, errLDAPDirectoryNotConfigured) {
+		return nil, &CodedAPIError{
+			Code: 400,
+			APIError: &models.APIError{
+				Message:         ErrBadRequest.Error(),
+				DetailedMessage: err.Error(),
+			},
+		}
+	}
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
+
+// searchLDAPEntities searches the directory for the user and group DNs matching the query, groups first, along
+// with the policies mapped to them
+func searchLDAPEntities(ctx context.Context, client MinioAdmin, directory ldapDirectory, query, entityType string, limit int) (*models.LdapEntitiesSearchResponse, error) {
+	if directory == nil {
+		return nil, errLDAPDirectoryNotConfigured
+	}
+	entities, err := client.getLDAPPolicyEntities(ctx, madmin.PolicyEntitiesQuery{})
+	if err != nil {
+		return nil, err
+	}
+	// the server and the directory may not spell the DNs with the same case
+	policies := map[string][]string{}
+	for _, mapping := range entities.UserMappings {
+		key := ldapEntityUser + "/" + strings.ToLower(mapping.User)
+		policies[key] = append(policies[key], mapping.Policies...)
+	}
+	for _, mapping := range entities.GroupMappings {
+		key := ldapEntityGroup + "/" + strings.ToLower(mapping.Group)
+		policies[key] = append(policies[key], mapping.Policies...)
+	}
+
+	query = strings.TrimSpace(query)
+	response := &models.LdapEntitiesSearchResponse{Results: []*models.LdapEntitySearchResult{}}
+	for _, t := range []string{ldapEntityGroup, ldapEntityUser} {
+		if entityType != "" && t != entityType {
+			continue
+		}
+		remaining := 0
+		if limit > 0 {
+			remaining = limit - len(response.Results)
+			if remaining <= 0 {
+				break
+			}
+		}
+		dns, err := directory.search(ctx, t, query, remaining)
+		if err != nil {
+			return nil, fmt.Errorf("unable to search the LDAP %ss: %w", t, err)
+		}
+		sort.Strings(dns)
+		for _, dn := range dns {
+			result := &models.LdapEntitySearchResult{Dn: dn, EntityType: t, Policies: append([]string{}, policies[t+"/"+strings.ToLower(dn)]...)}
+			sort.Strings(result.Policies)
+			response.Results = append(response.Results, result)
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateLDAPPolicyAssociations(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+
+	var requests []madmin.PolicyAssociationReq
+	minioAttachPolicyLDAPMock = func(_ context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+		requests = append(requests, req)
+		if req.User == "uid=missing,dc=example,dc=com" {
+			return madmin.PolicyAssociationResp{}, errors.New("user DN not found")
+		}
+		return madmin.PolicyAssociationResp{PoliciesAttached: req.Policies}, nil
+	}
+	minioDetachPolicyLDAPMock = func(_ context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+		requests = append(requests, req)
+		return madmin.PolicyAssociationResp{PoliciesDetached: req.Policies}, nil
+	}
+	req := &models.LdapPolicyAssociationRequest{
+		Policies: []string{"readonly"},
+		Users:    []string{"uid=alice,dc=example,dc=com", " uid=missing,dc=example,dc=com"},
+		Groups:   []string{"cn=auditors,dc=example,dc=com"},
+	}
+
+	// Test-1: every DN is attached and failures are reported per DN
+	res := updateLDAPPolicyAssociations(ctx, adminClient, req, true)
+	funcAssert.Equal([]*models.LdapPolicyAssociationResult{
+		{EntityType: "user", Dn: "uid=alice,dc=example,dc=com", Status: "success", Policies: []string{"readonly"}},
+		{EntityType: "user", Dn: "uid=missing,dc=example,dc=com", Status: "failed", Error: "user DN not found"},
+		{EntityType: "group", Dn: "cn=auditors,dc=example,dc=com", Status: "success", Policies: []string{"readonly"}},
+	}, res.Results)
+	funcAssert.Equal("cn=auditors,dc=example,dc=com", requests[2].Group)
+	funcAssert.Empty(requests[2].User)
+
+	// Test-2: detaching reports the detached policies
+	res = updateLDAPPolicyAssociations(ctx, adminClient, &models.LdapPolicyAssociationRequest{Policies: []string{"readonly"}, Groups: []string{"cn=auditors,dc=example,dc=com"}}, false)
+	funcAssert.Equal([]string{"readonly"}, res.Results[0].Policies)
+
+	// Test-3: requests without policies or DNs are rejected
+	funcAssert.Error(validateLDAPPolicyAssociation(&models.LdapPolicyAssociationRequest{Users: []string{"uid=alice,dc=example,dc=com"}}))
+	funcAssert.Error(validateLDAPPolicyAssociation(&models.LdapPolicyAssociationRequest{Policies: []string{"readonly"}}))
+	funcAssert.Error(validateLDAPPolicyAssociation(&models.LdapPolicyAssociationRequest{Policies: []string{"readonly"}, Groups: []string{" "}}))
+	funcAssert.NoError(validateLDAPPolicyAssociation(req))
+}
+
+// ldapDirectoryMock searches the DNs by entity type containing the query
+type ldapDirectoryMock struct {
+	dns map[string][]string
+	err error
+}
+
+func (d *ldapDirectoryMock) search(_ context.Context, entityType, query string, limit int) ([]string, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	var dns []string
+	for _, dn := range d.dns[entityType] {
+		if !strings.Contains(strings.ToLower(dn), strings.ToLower(query)) {
+			continue
+		}
+		dns = append(dns, dn)
+		if limit > 0 && len(dns) == limit {
+			break
+		}
+	}
+	return dns, nil
+}
+
+func TestSearchLDAPEntities(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+
+	minioGetLDAPPolicyEntitiesMock = func(_ context.Context, _ madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
+		return madmin.PolicyEntitiesResult{
+			UserMappings: []madmin.UserPolicyEntities{
+				{User: "uid=alice,ou=people,dc=example,dc=com", Policies: []string{"readwrite", "diagnostics"}},
+			},
+			GroupMappings: []madmin.GroupPolicyEntities{
+				{Group: "cn=auditors,ou=groups,dc=example,dc=com", Policies: []string{"readonly"}},
+			},
+		}, nil
+	}
+	directory := &ldapDirectoryMock{dns: map[string][]string{
+		"user":  {"uid=bob,ou=people,dc=example,dc=com", "uid=Alice,ou=People,dc=example,dc=com"},
+		"group": {"cn=auditors,ou=groups,dc=example,dc=com"},
+	}}
+
+	// Test-1: the DNs of the directory are returned, groups first, with the policies mapped to them whatever their case
+	res, err := searchLDAPEntities(ctx, adminClient, directory, "", "", 0)
+	funcAssert.NoError(err)
+	funcAssert.Equal([]*models.LdapEntitySearchResult{
+		{EntityType: "group", Dn: "cn=auditors,ou=groups,dc=example,dc=com", Policies: []string{"readonly"}},
+		{EntityType: "user", Dn: "uid=Alice,ou=People,dc=example,dc=com", Policies: []string{"diagnostics", "readwrite"}},
+		{EntityType: "user", Dn: "uid=bob,ou=people,dc=example,dc=com", Policies: []string{}},
+	}, res.Results)
+
+	// Test-2: results are filtered by query, type and limit
+	res, err = searchLDAPEntities(ctx, adminClient, directory, "OU=People", "", 0)
+	funcAssert.NoError(err)
+	funcAssert.Len(res.Results, 2)
+	res, err = searchLDAPEntities(ctx, adminClient, directory, "", "group", 0)
+	funcAssert.NoError(err)
+	funcAssert.Len(res.Results, 1)
+	res, err = searchLDAPEntities(ctx, adminClient, directory, "example", "user", 1)
+	funcAssert.NoError(err)
+	funcAssert.Len(res.Results, 1)
+	res, err = searchLDAPEntities(ctx, adminClient, directory, "example", "", 2)
+	funcAssert.NoError(err)
+	funcAssert.Len(res.Results, 2)
+	funcAssert.Equal("group", res.Results[0].EntityType)
+
+	// Test-3: the directory must be configured
+	_, err = searchLDAPEntities(ctx, adminClient, nil, "", "", 0)
+	funcAssert.ErrorIs(err, errLDAPDirectoryNotConfigured)
+
+	// Test-4: errors searching the directory are returned
+	directory.err = errors.New("error")
+	_, err = searchLDAPEntities(ctx, adminClient, directory, "", "", 0)
+	funcAssert.Error(err)
+}
+
+func TestLDAPSearchFilter(t *testing.T) {
+	funcAssert := assert.New(t)
+
+	// Test-1: the query is escaped and an empty one matches everything
+	funcAssert.Equal("(cn=*a\\2a\\28b*)", ldapSearchFilter("(cn=*%s*)", "a*(b"))
+	funcAssert.Equal("(&(cn=*)(uid=*))", ldapSearchFilter("(&(cn=*%s*)(uid=*%s*))", ""))
+
+	// Test-2: base DNs are separated by ;
+	funcAssert.Equal([]string{"ou=people,dc=example,dc=com", "ou=staff,dc=example,dc=com"}, splitLDAPBaseDNs(" ou=people,dc=example,dc=com; ;ou=staff,dc=example,dc=com"))
+	funcAssert.Empty(splitLDAPBaseDNs(""))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openstor/console/api/operations"
 	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
 	iampolicy "github.com/openstor/pkg/v3/policy"
 
 	policies "github.com/openstor/console/api/policy"
@@ -112,6 +113,38 @@ func registersPoliciesHandler(api *operations.ConsoleAPI) {
 		}
 		return policyApi.NewListGroupsForPolicyOK().WithPayload(policyGroupsResponse)
 	})
+	// Simulate the policy evaluation of a user, group or service account
+	api.PolicySimulatePolicyHandler = policyApi.SimulatePolicyHandlerFunc(func(params policyApi.SimulatePolicyParams, session *models.Principal) middleware.Responder {
+		simulation, err := getSimulatePolicyResponse(session, params)
+		if err != nil {
+			return policyApi.NewSimulatePolicyDefault(err.Code).WithPayload(err.APIError)
+		}
+		return policyApi.NewSimulatePolicyOK().WithPayload(simulation)
+	})
+	// List the changes of a policy
+	api.PolicyListPolicyHistoryHandler = policyApi.ListPolicyHistoryHandlerFunc(func(params policyApi.ListPolicyHistoryParams, session *models.Principal) middleware.Responder {
+		history, err := getListPolicyHistoryResponse(session, params)
+		if err != nil {
+			return policyApi.NewListPolicyHistoryDefault(err.Code).WithPayload(err.APIError)
+		}
+		return policyApi.NewListPolicyHistoryOK().WithPayload(history)
+	})
+	// Revert a change of a policy
+	api.PolicyRollbackPolicyHandler = policyApi.RollbackPolicyHandlerFunc(func(params policyApi.RollbackPolicyParams, session *models.Principal) middleware.Responder {
+		policy, err := getRollbackPolicyResponse(session, params)
+		if err != nil {
+			return policyApi.NewRollbackPolicyDefault(err.Code).WithPayload(err.APIError)
+		}
+		return policyApi.NewRollbackPolicyOK().WithPayload(policy)
+	})
+	// Lint policy
+	api.PolicyLintPolicyHandler = policyApi.LintPolicyHandlerFunc(func(params policyApi.LintPolicyParams, session *models.Principal) middleware.Responder {
+		lint, err := getLintPolicyResponse(session, params)
+		if err != nil {
+			return policyApi.NewLintPolicyDefault(err.Code).WithPayload(err.APIError)
+		}
+		return policyApi.NewLintPolicyOK().WithPayload(lint)
+	})
 	// Gets policies for currently logged in user
 	api.PolicyGetUserPolicyHandler = policyApi.GetUserPolicyHandlerFunc(func(params policyApi.GetUserPolicyParams, session *models.Principal) middleware.Responder {
 		userPolicyResponse, err := getUserPolicyResponse(params.HTTPRequest.Context(), session)
@@ -545,7 +578,14 @@ func getAddPolicyResponse(session *models.Principal, params policyApi.AddPolicyP
 	// create a MinIO Admin Client interface implementation
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
-	policy, err := addPolicy(ctx, adminClient, *params.Body.Name, *params.Body.Policy)
+	if err := checkPolicyLintGate(ctx, adminClient, *params.Body.Policy, getPolicyLintGate()); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	store, err := newPolicyHistoryStore(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	policy, err := addPolicyWithHistory(ctx, adminClient, store, *params.Body.Name, *params.Body.Policy, getSessionIdentity(session), params.Body.Comment, policyHistoryActionUpdate)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
@@ -568,6 +608,11 @@ func policyInfo(ctx context.Context, client MinioAdmin, name string) (*models.Po
 	return policy, nil
 }
 
+// isNoSuchPolicyError returns whether the error is the one MinIO returns for a policy that doesn't exist
+func isNoSuchPolicyError(err error) bool {
+	return madmin.ToErrorResponse(err).Code == "XMinioAdminNoSuchPolicy"
+}
+
 // getPolicy Statements calls MinIO server to retrieve information of a canned policy.
 // and returns the associated Statements
 func getPolicyStatements(ctx context.Context, client MinioAdmin, name string) ([]iampolicy.Statement, error) {
@@ -0,0 +1,390 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	policyApi "github.com/openstor/console/api/operations/policy"
+	"github.com/openstor/console/models"
+	"github.com/openstor/openstor-go/v7"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+)
+
+// Changes recorded in the history of a policy
+const (
+	policyHistoryActionCreate   = "create"
+	policyHistoryActionUpdate   = "update"
+	policyHistoryActionRollback = "rollback"
+)
+
+// Operations of the differences between two versions of a policy
+const (
+	policyDiffAdd     = "add"
+	policyDiffRemove  = "remove"
+	policyDiffReplace = "replace"
+)
+
+// policyHistoryPrefix is the prefix of the policy history objects in the system bucket
+const policyHistoryPrefix = "policy-history/"
+
+// policyHistoryStore keeps the changes of the canned policies
+type policyHistoryStore interface {
+	// list returns the changes of a policy sorted by version, the changes that can't be read are left out and
+	// reported instead
+	list(ctx context.Context, policy string) ([]*models.PolicyHistoryEntry, []string, error)
+	// get returns a change of a policy, errSystemObjectSignature when it wasn't recorded by a console
+	get(ctx context.Context, policy string, version int64) (*models.PolicyHistoryEntry, error)
+	save(ctx context.Context, entry *models.PolicyHistoryEntry) error
+}
+
+// bucketPolicyHistoryStore keeps every change of a policy as a signed JSON object in the system bucket, named after
+// the time of the change and a random suffix so the changes saved concurrently don't overwrite each other, e.g.
+// `policy-history/<policy>/01740830400000000000-<uuid>.json`. The versions number the changes in the order of
+// their names.
+type bucketPolicyHistoryStore struct {
+	client *openstor.Client
+	bucket string
+}
+
+func newPolicyHistoryStore(session *models.Principal, clientIP string) (policyHistoryStore, error) {
+	mClient, err := newMinioClient(session, clientIP)
+	if err != nil {
+		return nil, err
+	}
+	return &bucketPolicyHistoryStore{client: mClient, bucket: getPolicyHistoryBucket()}, nil
+}
+
+func policyHistoryObjectPrefix(policy string) string {
+	return policyHistoryPrefix + url.PathEscape(policy) + SlashSeparator
+}
+
+// keys returns the names of the changes of a policy, the version of a change is its position
+func (s *bucketPolicyHistoryStore) keys(ctx context.Context, policy string) ([]string, error) {
+	var keys []string
+	for obj := range s.client.ListObjects(ctx, s.bucket, openstor.ListObjectsOptions{Prefix: policyHistoryObjectPrefix(policy), Recursive: true}) {
+		if obj.Err != nil {
+			// there is no history until the first change is saved
+			if openstor.ToErrorResponse(obj.Err).Code == "NoSuchBucket" {
+				return nil, nil
+			}
+			return nil, obj.Err
+		}
+		keys = append(keys, obj.Key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *bucketPolicyHistoryStore) read(ctx context.Context, key string, version int64) (*models.PolicyHistoryEntry, error) {
+	data, _, err := getSignedSystemObject(ctx, s.client, s.bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, ErrNotFound
+	}
+	entry := &models.PolicyHistoryEntry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, err
+	}
+	entry.Version = version
+	return entry, nil
+}
+
+func (s *bucketPolicyHistoryStore) list(ctx context.Context, policy string) ([]*models.PolicyHistoryEntry, []string, error) {
+	if !systemObjectKeyConfigured() {
+		return nil, nil, errSystemObjectKeyNotSet
+	}
+	keys, err := s.keys(ctx, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		entries []*models.PolicyHistoryEntry
+		errs    []string
+	)
+	for idx, key := range keys {
+		entry, err := s.read(ctx, key, int64(idx+1))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("version %d: %v", idx+1, err))
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, errs, nil
+}
+
+func (s *bucketPolicyHistoryStore) get(ctx context.Context, policy string, version int64) (*models.PolicyHistoryEntry, error) {
+	keys, err := s.keys(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	if version < 1 || version > int64(len(keys)) {
+		return nil, ErrNotFound
+	}
+	return s.read(ctx, keys[version-1], version)
+}
+
+func (s *bucketPolicyHistoryStore) save(ctx context.Context, entry *models.PolicyHistoryEntry) error {
+	rawEntry, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	objectName := fmt.Sprintf("%s%020d-%s.json", policyHistoryObjectPrefix(entry.Policy), time.Now().UnixNano(), uuid.NewString())
+	_, err = putSignedSystemObject(ctx, s.client, s.bucket, objectName, rawEntry, "")
+	return err
+}
+
+// addPolicyWithHistory creates or updates a canned policy and records the document it replaced, the policy is
+// created when it doesn't exist yet. Failing to record the change doesn't revert it, the error is reported in the
+// policy returned instead.
+func addPolicyWithHistory(ctx context.Context, client MinioAdmin, store policyHistoryStore, name, policy, editor, comment, action string) (*models.Policy, error) {
+	var previousDocument string
+	previous, err := client.getPolicy(ctx, name)
+	switch {
+	case err == nil:
+		rawPrevious, err := canonicalPolicyJSON(previous)
+		if err != nil {
+			return nil, err
+		}
+		previousDocument = string(rawPrevious)
+	case !isNoSuchPolicyError(err):
+		return nil, fmt.Errorf("unable to get the current version of policy %s: %w", name, err)
+	}
+	policyObject, err := addPolicy(ctx, client, name, policy)
+	if err != nil {
+		return nil, err
+	}
+	iamp, err := iampolicy.ParseConfig(strings.NewReader(policy))
+	if err != nil {
+		return nil, err
+	}
+	rawDocument, err := canonicalPolicyJSON(iamp)
+	if err != nil {
+		return nil, err
+	}
+	if string(rawDocument) == previousDocument {
+		return policyObject, nil
+	}
+	if action == policyHistoryActionUpdate && previousDocument == "" {
+		action = policyHistoryActionCreate
+	}
+	if err := store.save(ctx, &models.PolicyHistoryEntry{
+		Policy:           name,
+		Action:           action,
+		Editor:           editor,
+		Timestamp:        time.Now().UTC().Format(time.RFC3339),
+		Comment:          comment,
+		PreviousDocument: previousDocument,
+		Document:         string(rawDocument),
+	}); err != nil {
+		LogError("unable to record the change of policy %s: %v", name, err)
+		policyObject.HistoryError = fmt.Sprintf("the change was applied but couldn't be recorded in the history: %v", err)
+	}
+	return policyObject, nil
+}
+
+// getListPolicyHistoryResponse performs listPolicyHistory() and serializes it to the handler's output
+func getListPolicyHistoryResponse(session *models.Principal, params policyApi.ListPolicyHistoryParams) (*models.PolicyHistoryResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	store, err := newPolicyHistoryStore(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	history, err := listPolicyHistory(ctx, store, params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return history, nil
+}
+
+// listPolicyHistory returns the changes of a policy, newest first, with the differences each of them introduced.
+// The changes that can't be read, e.g. not recorded by a console, are reported instead.
+func listPolicyHistory(ctx context.Context, store policyHistoryStore, name string) (*models.PolicyHistoryResponse, error) {
+	entries, errs, err := store.list(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	response := &models.PolicyHistoryResponse{Entries: []*models.PolicyHistoryEntry{}, Errors: errs}
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		diff, err := diffPolicyDocuments(entry.PreviousDocument, entry.Document)
+		if err != nil {
+			return nil, err
+		}
+		entry.Diff = diff
+		response.Entries = append(response.Entries, entry)
+	}
+	return response, nil
+}
+
+// getRollbackPolicyResponse performs rollbackPolicy() and serializes it to the handler's output
+func getRollbackPolicyResponse(session *models.Principal, params policyApi.RollbackPolicyParams) (*models.Policy, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	store, err := newPolicyHistoryStore(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	comment := ""
+	if params.Body != nil {
+		comment = params.Body.Comment
+	}
+	policy, err := rollbackPolicy(ctx, adminClient, store, params.Name, params.Version, getSessionIdentity(session), comment)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return policy, nil
+}
+
+// rollbackPolicy reverts a change of a policy by restoring the document it replaced, the rollback is recorded
+// in the history as well. Only the changes recorded by a console can be rolled back.
+func rollbackPolicy(ctx context.Context, client MinioAdmin, store policyHistoryStore, name string, version int64, editor, comment string) (*models.Policy, error) {
+	entry, err := store.get(ctx, name, version)
+	if errors.Is(err, errSystemObjectSignature) {
+		return nil, fmt.Errorf("%w: version %d of policy %s", ErrPolicyHistoryUnverified, version, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if entry.PreviousDocument == "" {
+		return nil, fmt.Errorf("%w: policy %s was created by version %d", ErrPolicyNoPreviousVersion, name, version)
+	}
+	if err := checkPolicyLintGate(ctx, client, entry.PreviousDocument, getPolicyLintGate()); err != nil {
+		return nil, err
+	}
+	if comment == "" {
+		comment = fmt.Sprintf("rollback of version %d", version)
+	}
+	return addPolicyWithHistory(ctx, client, store, name, entry.PreviousDocument, editor, comment, policyHistoryActionRollback)
+}
+
+// diffPolicyDocuments returns the structured differences between two policy documents as JSON pointers
+// to the added, removed and replaced values
+func diffPolicyDocuments(from, to string) ([]*models.PolicyDiffEntry, error) {
+	var fromValue, toValue interface{}
+	if from != "" {
+		if err := json.Unmarshal([]byte(from), &fromValue); err != nil {
+			return nil, err
+		}
+	}
+	if to != "" {
+		if err := json.Unmarshal([]byte(to), &toValue); err != nil {
+			return nil, err
+		}
+	}
+	diff := []*models.PolicyDiffEntry{}
+	if err := diffJSONValues("", fromValue, toValue, &diff); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+func diffJSONValues(path string, from, to interface{}, diff *[]*models.PolicyDiffEntry) error {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if fromIsMap && toIsMap {
+		keys := map[string]bool{}
+		for k := range fromMap {
+			keys[k] = true
+		}
+		for k := range toMap {
+			keys[k] = true
+		}
+		for _, k := range sortedKeys(keys) {
+			fromValue, inFrom := fromMap[k]
+			toValue, inTo := toMap[k]
+			if err := diffJSONMember(path+"/"+escapeJSONPointer(k), fromValue, inFrom, toValue, inTo, diff); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	fromSlice, fromIsSlice := from.([]interface{})
+	toSlice, toIsSlice := to.([]interface{})
+	if fromIsSlice && toIsSlice {
+		for i := 0; i < len(fromSlice) || i < len(toSlice); i++ {
+			var fromValue, toValue interface{}
+			if i < len(fromSlice) {
+				fromValue = fromSlice[i]
+			}
+			if i < len(toSlice) {
+				toValue = toSlice[i]
+			}
+			if err := diffJSONMember(fmt.Sprintf("%s/%d", path, i), fromValue, i < len(fromSlice), toValue, i < len(toSlice), diff); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return diffJSONMember(path, from, from != nil, to, to != nil, diff)
+}
+
+func diffJSONMember(path string, from interface{}, inFrom bool, to interface{}, inTo bool, diff *[]*models.PolicyDiffEntry) error {
+	switch {
+	case inFrom && inTo && reflect.DeepEqual(from, to):
+		return nil
+	case inFrom && inTo && isJSONContainer(from) && reflect.TypeOf(from) == reflect.TypeOf(to):
+		return diffJSONValues(path, from, to, diff)
+	}
+	entry := &models.PolicyDiffEntry{Path: path}
+	switch {
+	case inFrom && inTo:
+		entry.Op = policyDiffReplace
+	case inTo:
+		entry.Op = policyDiffAdd
+	case inFrom:
+		entry.Op = policyDiffRemove
+	default:
+		return nil
+	}
+	if inFrom {
+		rawFrom, err := json.Marshal(from)
+		if err != nil {
+			return err
+		}
+		entry.From = string(rawFrom)
+	}
+	if inTo {
+		rawTo, err := json.Marshal(to)
+		if err != nil {
+			return err
+		}
+		entry.To = string(rawTo)
+	}
+	*diff = append(*diff, entry)
+	return nil
+}
+
+func isJSONContainer(value interface{}) bool {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return true
+	}
+	return false
+}
+
+// escapeJSONPointer escapes a key as a JSON pointer reference token, see RFC 6901
+func escapeJSONPointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/console/pkg/auth/token"
+	"github.com/openstor/madmin-go/v4"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryPolicyHistoryStore keeps the policy history in memory for tests, the versions number the changes in the
+// order they were saved
+type memoryPolicyHistoryStore struct {
+	entries map[string][]*models.PolicyHistoryEntry
+	// forged are the changes not recorded by a console, by policy then version
+	forged  map[string]map[int64]bool
+	saveErr error
+}
+
+func (s *memoryPolicyHistoryStore) list(ctx context.Context, policy string) ([]*models.PolicyHistoryEntry, []string, error) {
+	var (
+		entries []*models.PolicyHistoryEntry
+		errs    []string
+	)
+	for idx := range s.entries[policy] {
+		entry, err := s.get(ctx, policy, int64(idx+1))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("version %d: %v", idx+1, err))
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, errs, nil
+}
+
+func (s *memoryPolicyHistoryStore) get(_ context.Context, policy string, version int64) (*models.PolicyHistoryEntry, error) {
+	if version < 1 || version > int64(len(s.entries[policy])) {
+		return nil, ErrNotFound
+	}
+	if s.forged[policy][version] {
+		return nil, errSystemObjectSignature
+	}
+	entry := *s.entries[policy][version-1]
+	entry.Version = version
+	return &entry, nil
+}
+
+func (s *memoryPolicyHistoryStore) save(_ context.Context, entry *models.PolicyHistoryEntry) error {
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	s.entries[entry.Policy] = append(s.entries[entry.Policy], entry)
+	return nil
+}
+
+func TestPolicyHistory(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+	store := &memoryPolicyHistoryStore{entries: map[string][]*models.PolicyHistoryEntry{}}
+
+	policies := map[string]*iampolicy.Policy{}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		if p, ok := policies[name]; ok {
+			return p, nil
+		}
+		return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy", Message: "The canned policy does not exist"}
+	}
+	minioAddPolicyMock = func(name string, policy *iampolicy.Policy) error {
+		policies[name] = policy
+		return nil
+	}
+
+	readPolicy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]}]}`
+	readWritePolicy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]}]}`
+
+	// Test-1: creating and updating a policy records both changes
+	_, err := addPolicyWithHistory(ctx, adminClient, store, "data", readPolicy, "alice", "", policyHistoryActionUpdate)
+	funcAssert.NoError(err)
+	_, err = addPolicyWithHistory(ctx, adminClient, store, "data", readWritePolicy, "bob", "allow uploads", policyHistoryActionUpdate)
+	funcAssert.NoError(err)
+	// saving the same document again isn't a change
+	_, err = addPolicyWithHistory(ctx, adminClient, store, "data", readWritePolicy, "bob", "", policyHistoryActionUpdate)
+	funcAssert.NoError(err)
+
+	history, err := listPolicyHistory(ctx, store, "data")
+	funcAssert.NoError(err)
+	funcAssert.Len(history.Entries, 2)
+	latest := history.Entries[0]
+	funcAssert.Equal(int64(2), latest.Version)
+	funcAssert.Equal("update", latest.Action)
+	funcAssert.Equal("bob", latest.Editor)
+	funcAssert.Equal("allow uploads", latest.Comment)
+	funcAssert.Equal([]*models.PolicyDiffEntry{
+		{Op: "add", Path: "/Statement/0/Action/1", To: `"s3:PutObject"`},
+	}, latest.Diff)
+	created := history.Entries[1]
+	funcAssert.Equal(int64(1), created.Version)
+	funcAssert.Equal("create", created.Action)
+	funcAssert.Empty(created.PreviousDocument)
+	funcAssert.Len(created.Diff, 1)
+	funcAssert.Equal("add", created.Diff[0].Op)
+
+	// Test-2: rolling back a change restores the document it replaced and is recorded as well
+	_, err = rollbackPolicy(ctx, adminClient, store, "data", 2, "carol", "")
+	funcAssert.NoError(err)
+	equal, err := policiesEqual(policies["data"], mustParsePolicy(t, readPolicy))
+	funcAssert.NoError(err)
+	funcAssert.True(equal)
+	history, err = listPolicyHistory(ctx, store, "data")
+	funcAssert.NoError(err)
+	funcAssert.Len(history.Entries, 3)
+	funcAssert.Equal("rollback", history.Entries[0].Action)
+	funcAssert.Equal("rollback of version 2", history.Entries[0].Comment)
+	funcAssert.Equal("carol", history.Entries[0].Editor)
+
+	// Test-3: the creation of a policy can't be rolled back
+	_, err = rollbackPolicy(ctx, adminClient, store, "data", 1, "carol", "")
+	funcAssert.ErrorIs(err, ErrPolicyNoPreviousVersion)
+
+	// Test-4: unknown versions aren't found
+	_, err = rollbackPolicy(ctx, adminClient, store, "data", 10, "carol", "")
+	funcAssert.ErrorIs(err, ErrNotFound)
+
+	// Test-5: failing to record the change doesn't fail the policy update, it is reported
+	store.saveErr = errors.New("unable to save")
+	policy, err := addPolicyWithHistory(ctx, adminClient, store, "data", readWritePolicy, "bob", "", policyHistoryActionUpdate)
+	funcAssert.NoError(err)
+	funcAssert.Contains(policy.HistoryError, "unable to save")
+	funcAssert.Len(store.entries["data"], 3)
+	store.saveErr = nil
+
+	// Test-6: a change not recorded by a console is reported and can't be rolled back
+	store.entries["data"] = append(store.entries["data"], &models.PolicyHistoryEntry{
+		Policy:           "data",
+		Action:           policyHistoryActionUpdate,
+		PreviousDocument: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`,
+		Document:         readWritePolicy,
+	})
+	store.forged = map[string]map[int64]bool{"data": {4: true}}
+	history, err = listPolicyHistory(ctx, store, "data")
+	funcAssert.NoError(err)
+	funcAssert.Len(history.Entries, 3)
+	funcAssert.Len(history.Errors, 1)
+	_, err = rollbackPolicy(ctx, adminClient, store, "data", 4, "mallory", "")
+	funcAssert.ErrorIs(err, ErrPolicyHistoryUnverified)
+	equal, err = policiesEqual(policies["data"], mustParsePolicy(t, readWritePolicy))
+	funcAssert.NoError(err)
+	funcAssert.True(equal)
+
+	// Test-7: a document failing the lint gate isn't restored
+	t.Setenv(ConsolePolicyLintGate, policyLintGateError)
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{{Name: "data"}}}, nil
+	}
+	store.forged = nil
+	store.entries["data"][3].PreviousDocument = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObjects"],"Resource":["arn:aws:s3:::data/*"]}]}`
+	_, err = rollbackPolicy(ctx, adminClient, store, "data", 4, "carol", "")
+	funcAssert.ErrorIs(err, ErrPolicyLintFailed)
+	_, err = rollbackPolicy(ctx, adminClient, store, "data", 2, "carol", "")
+	funcAssert.NoError(err)
+
+	// Test-8: the policy isn't changed when its current version can't be read
+	minioGetPolicyMock = func(_ string) (*iampolicy.Policy, error) {
+		return nil, errors.New("connection refused")
+	}
+	minioAddPolicyMock = func(_ string, _ *iampolicy.Policy) error {
+		funcAssert.Fail("the policy must not be changed")
+		return nil
+	}
+	recorded := len(store.entries["data"])
+	_, err = addPolicyWithHistory(ctx, adminClient, store, "data", readPolicy, "bob", "", policyHistoryActionUpdate)
+	funcAssert.Error(err)
+	funcAssert.Len(store.entries["data"], recorded)
+}
+
+func TestSystemObjectKeyConfigured(t *testing.T) {
+	funcAssert := assert.New(t)
+	t.Setenv(token.ConsolePBKDFPassphrase, "")
+	t.Setenv(token.ConsolePBKDFSalt, "")
+
+	// Test-1: the objects aren't signed with the random key of a console
+	funcAssert.False(systemObjectKeyConfigured())
+	_, _, err := getSignedSystemObject(context.Background(), nil, "console", "object.json")
+	funcAssert.ErrorIs(err, errSystemObjectKeyNotSet)
+	_, err = putSignedSystemObject(context.Background(), nil, "console", "object.json", []byte("{}"), "")
+	funcAssert.ErrorIs(err, errSystemObjectKeyNotSet)
+	store := &bucketPolicyHistoryStore{bucket: "console"}
+	_, _, err = store.list(context.Background(), "data")
+	funcAssert.ErrorIs(err, errSystemObjectKeyNotSet)
+
+	// Test-2: both the passphrase and the salt are required
+	t.Setenv(token.ConsolePBKDFPassphrase, "passphrase")
+	funcAssert.False(systemObjectKeyConfigured())
+	t.Setenv(token.ConsolePBKDFSalt, "salt")
+	funcAssert.True(systemObjectKeyConfigured())
+}
+
+func TestDiffPolicyDocuments(t *testing.T) {
+	diff, err := diffPolicyDocuments(
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::a/b"]}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::a/b"]},{"Effect":"Allow","Action":["s3:ListBucket"]}]}`,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.PolicyDiffEntry{
+		{Op: "replace", Path: "/Statement/0/Effect", From: `"Allow"`, To: `"Deny"`},
+		{Op: "add", Path: "/Statement/1", To: `{"Action":["s3:ListBucket"],"Effect":"Allow"}`},
+	}, diff)
+
+	diff, err = diffPolicyDocuments(`{"a/b":1,"c":2}`, `{"a/b":2}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.PolicyDiffEntry{
+		{Op: "replace", Path: "/a~1b", From: "1", To: "2"},
+		{Op: "remove", Path: "/c", From: "2"},
+	}, diff)
+
+	_, err = diffPolicyDocuments("{", "{}")
+	assert.Error(t, err)
+}
+
+func mustParsePolicy(t *testing.T, policy string) *iampolicy.Policy {
+	p, err := iampolicy.ParseConfig(strings.NewReader(policy))
+	assert.NoError(t, err)
+	return p
+}
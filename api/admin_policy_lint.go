@@ -0,0 +1,567 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	policyApi "github.com/openstor/console/api/operations/policy"
+	"github.com/openstor/console/models"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"github.com/openstor/pkg/v3/wildcard"
+)
+
+// Codes of the policy lint findings
+const (
+	policyLintInvalidDocument       = "invalid-document"
+	policyLintUnknownAction         = "unknown-action"
+	policyLintFullAccess            = "full-access"
+	policyLintAdminBroadResource    = "admin-broad-resource"
+	policyLintUnknownBucket         = "unknown-bucket"
+	policyLintConditionNeverMatches = "condition-never-matches"
+	policyLintShadowedByDeny        = "shadowed-by-deny"
+)
+
+// Values of CONSOLE_POLICY_LINT_GATE
+const (
+	policyLintGateOff     = "off"
+	policyLintGateError   = "error"
+	policyLintGateWarning = "warning"
+)
+
+// policyLintMaxSuggestionDistance is the maximum edit distance between an unknown action and the
+// known action suggested in its place
+const policyLintMaxSuggestionDistance = 3
+
+// lintValues is a policy element accepting either a single value or a list of values, e.g. `Action`
+type lintValues []string
+
+func (v *lintValues) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	items, ok := raw.([]interface{})
+	if !ok {
+		items = []interface{}{raw}
+	}
+	values := lintValues{}
+	for _, item := range items {
+		switch value := item.(type) {
+		case string:
+			values = append(values, value)
+		case bool, float64:
+			values = append(values, fmt.Sprint(value))
+		default:
+			return fmt.Errorf("unexpected value %v", item)
+		}
+	}
+	*v = values
+	return nil
+}
+
+// lintStatement is the statement of a policy as written in the document. Policies are decoded without
+// validation since iampolicy.ParseConfig() stops at the first unknown action.
+type lintStatement struct {
+	Sid         string
+	Effect      string
+	Action      lintValues
+	NotAction   lintValues
+	Resource    lintValues
+	NotResource lintValues
+	Condition   map[string]map[string]lintValues
+}
+
+type lintDocument struct {
+	Version   string
+	Statement []lintStatement
+}
+
+// getLintPolicyResponse performs lintPolicy() and serializes it to the handler's output
+func getLintPolicyResponse(session *models.Principal, params policyApi.LintPolicyParams) (*models.LintPolicyResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body == nil {
+		return nil, ErrorWithContext(ctx, ErrPolicyBodyNotInRequest)
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	lint, err := lintPolicy(ctx, adminClient, *params.Body.Policy)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return lint, nil
+}
+
+// lintPolicy checks the policy document against the existing buckets, the policy is valid unless one
+// of the findings is an error
+func lintPolicy(ctx context.Context, client MinioAdmin, policy string) (*models.LintPolicyResponse, error) {
+	accountInfo, err := getAccountInfo(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	var buckets []string
+	for _, bucket := range accountInfo.Buckets {
+		buckets = append(buckets, bucket.Name)
+	}
+	response := &models.LintPolicyResponse{Valid: true, Findings: lintPolicyDocument(policy, buckets)}
+	for _, finding := range response.Findings {
+		if finding.Severity == models.PolicyLintFindingSeverityError {
+			response.Valid = false
+		}
+	}
+	return response, nil
+}
+
+// checkPolicyLintGate returns ErrPolicyLintFailed when the policy has findings at or above the severity
+// of the gate
+func checkPolicyLintGate(ctx context.Context, client MinioAdmin, policy, gate string) error {
+	if gate != policyLintGateError && gate != policyLintGateWarning {
+		return nil
+	}
+	lint, err := lintPolicy(ctx, client, policy)
+	if err != nil {
+		return err
+	}
+	var messages []string
+	for _, finding := range lint.Findings {
+		if finding.Severity == models.PolicyLintFindingSeverityError ||
+			(gate == policyLintGateWarning && finding.Severity == models.PolicyLintFindingSeverityWarning) {
+			messages = append(messages, finding.Message)
+		}
+	}
+	if len(messages) > 0 {
+		return fmt.Errorf("%w: %s", ErrPolicyLintFailed, strings.Join(messages, "; "))
+	}
+	return nil
+}
+
+// lintPolicyDocument returns the findings of every statement of the policy
+func lintPolicyDocument(policy string, buckets []string) []*models.PolicyLintFinding {
+	var document lintDocument
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return []*models.PolicyLintFinding{{
+			Severity:   models.PolicyLintFindingSeverityError,
+			Code:       policyLintInvalidDocument,
+			Statement:  -1,
+			Message:    fmt.Sprintf("the policy is not a valid JSON document: %v", err),
+			Suggestion: "Fix the syntax of the document",
+		}}
+	}
+
+	findings := []*models.PolicyLintFinding{}
+	add := func(severity, code string, statement int, message, suggestion string) {
+		finding := &models.PolicyLintFinding{
+			Severity:   severity,
+			Code:       code,
+			Statement:  int32(statement),
+			Message:    message,
+			Suggestion: suggestion,
+		}
+		if statement >= 0 {
+			finding.Sid = document.Statement[statement].Sid
+		}
+		findings = append(findings, finding)
+	}
+
+	broadAllow := false
+	for _, s := range document.Statement {
+		if s.Effect == string(iampolicy.Allow) && hasBroadResource(s.Resource) {
+			broadAllow = true
+		}
+	}
+	for i, s := range document.Statement {
+		for _, action := range append(append(lintValues{}, s.Action...), s.NotAction...) {
+			if isKnownPolicyAction(action) {
+				continue
+			}
+			suggestion := "Use one of the supported s3:, admin:, kms: or sts: actions"
+			if closest := closestPolicyAction(action); closest != "" {
+				suggestion = fmt.Sprintf("Did you mean %s?", closest)
+			}
+			add(models.PolicyLintFindingSeverityError, policyLintUnknownAction, i, fmt.Sprintf("unknown action %s", action), suggestion)
+		}
+
+		if s.Effect == string(iampolicy.Allow) {
+			if hasAllS3Actions(s.Action) && hasBroadResource(s.Resource) {
+				add(models.PolicyLintFindingSeverityWarning, policyLintFullAccess, i,
+					"the statement grants every S3 action on every bucket",
+					"Restrict the actions and the resources to the buckets the policy is meant for")
+			}
+			if hasAdminActions(s.Action) && broadAllow {
+				add(models.PolicyLintFindingSeverityWarning, policyLintAdminBroadResource, i,
+					"admin actions are granted alongside access to every bucket",
+					"Move the admin actions to a dedicated policy attached only to operators")
+			}
+		}
+
+		for _, resource := range append(append(lintValues{}, s.Resource...), s.NotResource...) {
+			if !strings.HasPrefix(resource, iampolicy.ResourceARNPrefix) {
+				continue
+			}
+			bucket, _ := parseResourceARN(resource)
+			if bucket == "" || strings.Contains(bucket, "${") || matchesAnyBucket(bucket, buckets) {
+				continue
+			}
+			add(models.PolicyLintFindingSeverityWarning, policyLintUnknownBucket, i,
+				fmt.Sprintf("resource %s matches no existing bucket", resource),
+				"Check the bucket name or create the bucket before granting access to it")
+		}
+
+		for _, problem := range lintConditions(s.Condition) {
+			add(problem.severity, policyLintConditionNeverMatches, i, problem.message, problem.suggestion)
+		}
+
+		if j := shadowingDenyStatement(document.Statement, i); j >= 0 {
+			add(models.PolicyLintFindingSeverityWarning, policyLintShadowedByDeny, i,
+				fmt.Sprintf("the statement is shadowed by the Deny statement %d and never allows anything", j),
+				fmt.Sprintf("Remove the statement or narrow the Deny statement %d", j))
+		}
+	}
+
+	// the specific findings above already explain why the policy can't be saved
+	hasErrors := false
+	for _, finding := range findings {
+		if finding.Severity == models.PolicyLintFindingSeverityError {
+			hasErrors = true
+		}
+	}
+	if _, err := iampolicy.ParseConfig(bytes.NewReader([]byte(policy))); err != nil && !hasErrors {
+		add(models.PolicyLintFindingSeverityError, policyLintInvalidDocument, -1,
+			fmt.Sprintf("the policy is invalid: %v", err), "Fix the document so it can be saved")
+	}
+	return findings
+}
+
+func isKnownPolicyAction(action string) bool {
+	return iampolicy.Action(action).IsValid() ||
+		iampolicy.AdminAction(action).IsValid() ||
+		iampolicy.STSAction(action).IsValid() ||
+		iampolicy.KMSAction(action).IsValid()
+}
+
+// closestPolicyAction returns the supported action the closest to the unknown one, ignoring the case
+func closestPolicyAction(action string) string {
+	var candidates []string
+	for a := range iampolicy.SupportedActions {
+		candidates = append(candidates, string(a))
+	}
+	for a := range iampolicy.SupportedAdminActions {
+		candidates = append(candidates, string(a))
+	}
+	sort.Strings(candidates)
+	closest, distance := "", policyLintMaxSuggestionDistance+1
+	for _, candidate := range candidates {
+		if d := levenshteinDistance(strings.ToLower(action), strings.ToLower(candidate)); d < distance {
+			closest, distance = candidate, d
+		}
+	}
+	return closest
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+func hasAllS3Actions(actions lintValues) bool {
+	for _, action := range actions {
+		if action == "*" || action == string(iampolicy.AllActions) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAdminActions(actions lintValues) bool {
+	for _, action := range actions {
+		if action == "*" || strings.HasPrefix(action, "admin:") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasBroadResource reports whether the resources cover every bucket
+func hasBroadResource(resources lintValues) bool {
+	for _, resource := range resources {
+		bucket, _ := parseResourceARN(resource)
+		if resource == "*" || (strings.HasPrefix(resource, iampolicy.ResourceARNPrefix) && bucket == "*") {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAnyBucket(pattern string, buckets []string) bool {
+	for _, bucket := range buckets {
+		if wildcard.Match(pattern, bucket) {
+			return true
+		}
+	}
+	return false
+}
+
+// shadowingDenyStatement returns the index of an unconditional Deny statement covering every action and
+// resource of the Allow statement i, -1 if there is none
+func shadowingDenyStatement(statements []lintStatement, i int) int {
+	allow := statements[i]
+	if allow.Effect != string(iampolicy.Allow) || len(allow.Action) == 0 || len(allow.NotAction) > 0 || len(allow.NotResource) > 0 {
+		return -1
+	}
+	for j, deny := range statements {
+		if deny.Effect != string(iampolicy.Deny) || len(deny.Condition) > 0 || len(deny.NotAction) > 0 || len(deny.NotResource) > 0 {
+			continue
+		}
+		if patternsCover(deny.Action, allow.Action) && patternsCover(deny.Resource, allow.Resource) {
+			return j
+		}
+	}
+	return -1
+}
+
+// patternsCover reports whether every value is matched by one of the patterns
+func patternsCover(patterns, values lintValues) bool {
+	for _, value := range values {
+		covered := false
+		for _, pattern := range patterns {
+			if wildcard.Match(pattern, value) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+type lintConditionProblem struct {
+	severity   string
+	message    string
+	suggestion string
+}
+
+// conditionBounds are the bounds set by the numeric or date operators of a condition key
+type conditionBounds struct {
+	lower, upper                   float64
+	lowerInclusive, upperInclusive bool
+}
+
+// lintConditions returns the conditions of a statement that can never match: operators without values,
+// values the operator can't compare against and operators contradicting each other on the same key
+func lintConditions(conditions map[string]map[string]lintValues) []lintConditionProblem {
+	var problems []lintConditionProblem
+	// keys required to be absent by a Null operator, and keys used by the other operators
+	absentKeys := map[string]bool{}
+	presentKeys := map[string]bool{}
+	stringEquals := map[string]lintValues{}
+	stringNotEquals := map[string]lintValues{}
+	bounds := map[string]*conditionBounds{}
+
+	for _, operator := range sortedKeys(conditions) {
+		qualifier, name, qualified := strings.Cut(operator, ":")
+		if !qualified {
+			name = qualifier
+		}
+		ifExists := strings.HasSuffix(name, "IfExists")
+		name = strings.TrimSuffix(name, "IfExists")
+		for _, key := range sortedKeys(conditions[operator]) {
+			values := conditions[operator][key]
+			if len(values) == 0 {
+				problems = append(problems, lintConditionProblem{
+					severity:   models.PolicyLintFindingSeverityWarning,
+					message:    fmt.Sprintf("condition %s on %s has no values and never matches", operator, key),
+					suggestion: "Add the values the key must be compared against or remove the condition",
+				})
+				continue
+			}
+			for _, value := range values {
+				if err := checkConditionValue(name, value); err != nil {
+					problems = append(problems, lintConditionProblem{
+						severity:   models.PolicyLintFindingSeverityError,
+						message:    fmt.Sprintf("condition %s on %s can't match %s: %v", operator, key, value, err),
+						suggestion: fmt.Sprintf("Use a value %s can compare, e.g. %s", operator, conditionValueExample(name)),
+					})
+				}
+			}
+			if qualified {
+				continue
+			}
+			if name == "Null" {
+				if len(values) == 1 && strings.EqualFold(values[0], "true") {
+					absentKeys[key] = true
+				}
+				continue
+			}
+			if !ifExists {
+				presentKeys[key] = true
+			}
+			switch name {
+			case "StringEquals":
+				stringEquals[key] = values
+			case "StringNotEquals":
+				stringNotEquals[key] = values
+			}
+			if len(values) == 1 {
+				if value, ok := conditionBoundValue(name, values[0]); ok {
+					if bounds[key] == nil {
+						bounds[key] = &conditionBounds{lower: math.Inf(-1), upper: math.Inf(1)}
+					}
+					bounds[key].restrict(name, value)
+				}
+			}
+		}
+	}
+
+	for _, key := range sortedKeys(presentKeys) {
+		if absentKeys[key] {
+			problems = append(problems, lintConditionProblem{
+				severity:   models.PolicyLintFindingSeverityWarning,
+				message:    fmt.Sprintf("%s is required to be absent by Null while other conditions compare its value", key),
+				suggestion: "Remove the Null condition or use the IfExists variant of the other operators",
+			})
+		}
+	}
+	for _, key := range sortedKeys(stringEquals) {
+		if notEquals, ok := stringNotEquals[key]; ok && containsAll(notEquals, stringEquals[key]) {
+			problems = append(problems, lintConditionProblem{
+				severity:   models.PolicyLintFindingSeverityWarning,
+				message:    fmt.Sprintf("every value StringEquals allows for %s is excluded by StringNotEquals", key),
+				suggestion: "Remove the values excluded by StringNotEquals from StringEquals",
+			})
+		}
+	}
+	for _, key := range sortedKeys(bounds) {
+		if bounds[key].empty() {
+			problems = append(problems, lintConditionProblem{
+				severity:   models.PolicyLintFindingSeverityWarning,
+				message:    fmt.Sprintf("the range allowed for %s is empty", key),
+				suggestion: "Check the lower and upper bounds compared against the key",
+			})
+		}
+	}
+	return problems
+}
+
+// containsAll reports whether every value is in the list
+func containsAll(list, values lintValues) bool {
+	for _, value := range values {
+		found := false
+		for _, item := range list {
+			if item == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// checkConditionValue returns an error when the operator can't compare the value
+func checkConditionValue(operator, value string) error {
+	switch {
+	case operator == "Bool" || operator == "Null":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("not a boolean")
+		}
+	case operator == "IpAddress" || operator == "NotIpAddress":
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			return fmt.Errorf("not a CIDR")
+		}
+	case strings.HasPrefix(operator, "Numeric"):
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("not an integer")
+		}
+	case strings.HasPrefix(operator, "Date"):
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return fmt.Errorf("not an RFC 3339 date")
+		}
+	}
+	return nil
+}
+
+func conditionValueExample(operator string) string {
+	switch {
+	case operator == "Bool" || operator == "Null":
+		return "true"
+	case operator == "IpAddress" || operator == "NotIpAddress":
+		return "10.0.0.0/8"
+	case strings.HasPrefix(operator, "Numeric"):
+		return "100"
+	case strings.HasPrefix(operator, "Date"):
+		return "2025-01-01T00:00:00Z"
+	}
+	return "a string"
+}
+
+// conditionBoundValue returns the value of a numeric or date comparison as a number
+func conditionBoundValue(operator, value string) (float64, bool) {
+	switch {
+	case strings.HasPrefix(operator, "Numeric"):
+		n, err := strconv.ParseInt(value, 10, 64)
+		return float64(n), err == nil
+	case strings.HasPrefix(operator, "Date"):
+		t, err := time.Parse(time.RFC3339, value)
+		return float64(t.Unix()), err == nil
+	}
+	return 0, false
+}
+
+func (b *conditionBounds) restrict(operator string, value float64) {
+	comparison := strings.TrimPrefix(strings.TrimPrefix(operator, "Numeric"), "Date")
+	switch comparison {
+	case "Equals":
+		b.restrict("GreaterThanEquals", value)
+		b.restrict("LessThanEquals", value)
+	case "GreaterThan", "GreaterThanEquals":
+		inclusive := comparison == "GreaterThanEquals"
+		if value > b.lower || (value == b.lower && !inclusive) {
+			b.lower, b.lowerInclusive = value, inclusive
+		}
+	case "LessThan", "LessThanEquals":
+		inclusive := comparison == "LessThanEquals"
+		if value < b.upper || (value == b.upper && !inclusive) {
+			b.upper, b.upperInclusive = value, inclusive
+		}
+	}
+}
+
+func (b *conditionBounds) empty() bool {
+	return b.lower > b.upper || (b.lower == b.upper && !(b.lowerInclusive && b.upperInclusive))
+}
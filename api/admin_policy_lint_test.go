@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintPolicyDocument(t *testing.T) {
+	buckets := []string{"data", "logs"}
+	tests := []struct {
+		name   string
+		policy string
+		// want are the codes of the findings along with their severity and statement
+		want []string
+	}{
+		{
+			name:   "clean policy",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*","arn:aws:s3:::${aws:username}/*"]}]}`,
+		},
+		{
+			name:   "invalid JSON",
+			policy: `{"Version":`,
+			want:   []string{"error invalid-document -1"},
+		},
+		{
+			name:   "unknown action",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObjects","Resource":"arn:aws:s3:::data/*"}]}`,
+			want:   []string{"error unknown-action 0"},
+		},
+		{
+			name:   "s3:* on every bucket",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`,
+			want:   []string{"warning full-access 0"},
+		},
+		{
+			name:   "admin actions alongside every bucket",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["admin:ServerInfo"]},{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::*"]}]}`,
+			want:   []string{"warning admin-broad-resource 0"},
+		},
+		{
+			name:   "resource matching no bucket",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::dta/*","arn:aws:s3:::log*/*"]}]}`,
+			want:   []string{"warning unknown-bucket 0"},
+		},
+		{
+			name:   "contradicting conditions",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::data"],"Condition":{"NumericGreaterThan":{"s3:max-keys":"100"},"NumericLessThan":{"s3:max-keys":"10"},"StringEquals":{"s3:prefix":["a"]},"StringNotEquals":{"s3:prefix":["a","b"]}}}]}`,
+			want:   []string{"warning condition-never-matches 0", "warning condition-never-matches 0"},
+		},
+		{
+			name:   "invalid condition value",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"],"Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.300/8"}}}]}`,
+			want:   []string{"error condition-never-matches 0"},
+		},
+		{
+			name:   "statement shadowed by deny",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/reports/*"]},{"Effect":"Deny","Action":["s3:Get*"],"Resource":["arn:aws:s3:::data/*"]}]}`,
+			want:   []string{"warning shadowed-by-deny 0"},
+		},
+		{
+			name:   "conditional deny doesn't shadow",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]},{"Effect":"Deny","Action":["s3:*"],"Resource":["arn:aws:s3:::data/*"],"Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+		},
+		{
+			name:   "invalid effect",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Permit","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]}]}`,
+			want:   []string{"error invalid-document -1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(_ *testing.T) {
+			var got []string
+			for _, finding := range lintPolicyDocument(tt.policy, buckets) {
+				assert.NotEmpty(t, finding.Message)
+				assert.NotEmpty(t, finding.Suggestion)
+				got = append(got, fmt.Sprintf("%s %s %d", finding.Severity, finding.Code, finding.Statement))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClosestPolicyAction(t *testing.T) {
+	assert.Equal(t, "s3:GetObject", closestPolicyAction("s3:getobject"))
+	assert.Equal(t, "s3:PutObject", closestPolicyAction("s3:PutObjet"))
+	assert.Equal(t, "", closestPolicyAction("storage:Everything"))
+}
+
+func TestCheckPolicyLintGate(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{{Name: "data"}}}, nil
+	}
+	fullAccess := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`
+	unknownAction := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObjects"],"Resource":["arn:aws:s3:::data/*"]}]}`
+
+	// Test-1: warnings don't make the policy invalid
+	lint, err := lintPolicy(ctx, adminClient, fullAccess)
+	funcAssert.NoError(err)
+	funcAssert.True(lint.Valid)
+	funcAssert.Len(lint.Findings, 1)
+	lint, err = lintPolicy(ctx, adminClient, unknownAction)
+	funcAssert.NoError(err)
+	funcAssert.False(lint.Valid)
+	funcAssert.Equal(models.PolicyLintFindingSeverityError, lint.Findings[0].Severity)
+
+	// Test-2: the gate only blocks the findings at or above its severity
+	funcAssert.NoError(checkPolicyLintGate(ctx, adminClient, unknownAction, policyLintGateOff))
+	funcAssert.NoError(checkPolicyLintGate(ctx, adminClient, fullAccess, policyLintGateError))
+	funcAssert.ErrorIs(checkPolicyLintGate(ctx, adminClient, unknownAction, policyLintGateError), ErrPolicyLintFailed)
+	funcAssert.ErrorIs(checkPolicyLintGate(ctx, adminClient, fullAccess, policyLintGateWarning), ErrPolicyLintFailed)
+
+	// Test-3: errors getting the buckets are returned
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{}, errors.New("error")
+	}
+	_, err = lintPolicy(ctx, adminClient, fullAccess)
+	funcAssert.Error(err)
+}
@@ -0,0 +1,298 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	policyApi "github.com/openstor/console/api/operations/policy"
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"github.com/openstor/pkg/v3/policy/condition"
+)
+
+const (
+	policySourceUser           = "user"
+	policySourceGroup          = "group"
+	policySourceServiceAccount = "serviceAccount"
+	serviceAccountInlinePolicy = "inline"
+)
+
+// entityPolicy is a policy applying to an IAM entity along with where it comes from,
+// e.g. attached to the user or inherited from one of its groups
+type entityPolicy struct {
+	name   string
+	source string
+	policy *iampolicy.Policy
+}
+
+// entityPolicies are the policies evaluated for the requests of an IAM entity
+type entityPolicies struct {
+	accountName string
+	groups      []string
+	// identity are the policies attached to the entity or inherited from its groups
+	identity []entityPolicy
+	// session is the embedded policy of a service account, further restricting the identity policies
+	session *entityPolicy
+	// disabled is why the requests of the entity are denied whatever its policies, e.g. its account is disabled
+	disabled string
+	// findings are the issues found getting the policies, e.g. a mapped policy missing on the server
+	findings []string
+}
+
+// getSimulatePolicyResponse performs simulatePolicy() and serializes it to the handler's output
+func getSimulatePolicyResponse(session *models.Principal, params policyApi.SimulatePolicyParams) (*models.SimulatePolicyResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body == nil {
+		return nil, ErrorWithContext(ctx, ErrPolicyBodyNotInRequest)
+	}
+	for _, action := range params.Body.Actions {
+		if !iampolicy.Action(action).IsValid() {
+			return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("invalid action %s", action))
+		}
+	}
+	if len(params.Body.Actions) == 0 {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("at least one action is required"))
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	simulation, err := simulatePolicy(ctx, adminClient, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return simulation, nil
+}
+
+// simulatePolicy evaluates the policies of a user, group or service account for each of the requested actions
+func simulatePolicy(ctx context.Context, client MinioAdmin, req *models.SimulatePolicyRequest) (*models.SimulatePolicyResponse, error) {
+	policies, err := getEntityPolicies(ctx, client, *req.EntityType, *req.EntityName)
+	if err != nil {
+		return nil, err
+	}
+	bucket, object := parseResourceARN(*req.Resource)
+	conditionValues := getSimulationConditionValues(policies.accountName, req.Conditions)
+
+	response := &models.SimulatePolicyResponse{Findings: policies.findings}
+	for _, p := range policies.all() {
+		response.Policies = append(response.Policies, &models.SimulatedPolicy{Name: p.name, Source: p.source})
+	}
+	for _, action := range req.Actions {
+		args := iampolicy.Args{
+			AccountName:     policies.accountName,
+			Groups:          policies.groups,
+			Action:          iampolicy.Action(action),
+			BucketName:      bucket,
+			ObjectName:      object,
+			ConditionValues: conditionValues,
+		}
+		allowed, matched, err := policies.evaluate(args)
+		if err != nil {
+			return nil, err
+		}
+		response.Results = append(response.Results, &models.SimulatedActionResult{
+			Action:            action,
+			Allowed:           allowed && policies.disabled == "",
+			Reason:            policies.disabled,
+			MatchedStatements: matched,
+		})
+	}
+	return response, nil
+}
+
+// getEntityPolicies returns the policies attached to a user, group or service account, including the
+// ones inherited from the groups the user belongs to
+func getEntityPolicies(ctx context.Context, client MinioAdmin, entityType models.SimulationEntity, name string) (*entityPolicies, error) {
+	cache := map[string]*iampolicy.Policy{}
+	policies := &entityPolicies{}
+	switch entityType {
+	case models.SimulationEntityUser:
+		policies.accountName = name
+		if err := addUserPolicies(ctx, client, name, policies, cache); err != nil {
+			return nil, err
+		}
+	case models.SimulationEntityGroup:
+		policies.groups = []string{name}
+		if err := addGroupPolicies(ctx, client, name, policies, cache); err != nil {
+			return nil, err
+		}
+	case models.SimulationEntityServiceAccount:
+		info, err := client.infoServiceAccount(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		policies.accountName = info.ParentUser
+		if info.AccountStatus == "off" {
+			policies.disabled = fmt.Sprintf("the service account %s is disabled", name)
+		}
+		if err := addUserPolicies(ctx, client, info.ParentUser, policies, cache); err != nil {
+			return nil, fmt.Errorf("unable to get the policies of the parent user %s: %w", info.ParentUser, err)
+		}
+		if !info.ImpliedPolicy && info.Policy != "" {
+			inline, err := iampolicy.ParseConfig(strings.NewReader(info.Policy))
+			if err != nil {
+				return nil, err
+			}
+			policies.session = &entityPolicy{name: serviceAccountInlinePolicy, source: policySourceServiceAccount, policy: inline}
+		}
+	default:
+		return nil, fmt.Errorf("%w: invalid entity type %s", ErrBadRequest, entityType)
+	}
+	return policies, nil
+}
+
+func addUserPolicies(ctx context.Context, client MinioAdmin, user string, policies *entityPolicies, cache map[string]*iampolicy.Policy) error {
+	userInfo, err := getUserInfo(ctx, client, user)
+	if err != nil {
+		return err
+	}
+	// disabled users can't authenticate, so none of their requests is allowed
+	if userInfo.Status == madmin.AccountDisabled && policies.disabled == "" {
+		policies.disabled = fmt.Sprintf("the user %s is disabled", user)
+	}
+	if err := addNamedPolicies(ctx, client, userInfo.PolicyName, policySourceUser, policies, cache); err != nil {
+		return err
+	}
+	for _, group := range userInfo.MemberOf {
+		policies.groups = append(policies.groups, group)
+		if err := addGroupPolicies(ctx, client, group, policies, cache); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addGroupPolicies(ctx context.Context, client MinioAdmin, group string, policies *entityPolicies, cache map[string]*iampolicy.Policy) error {
+	groupDesc, err := groupInfo(ctx, client, group)
+	if err != nil {
+		return err
+	}
+	// disabled groups don't grant their policies to their members
+	if groupDesc.Status == string(madmin.GroupDisabled) {
+		return nil
+	}
+	return addNamedPolicies(ctx, client, groupDesc.Policy, policySourceGroup+":"+group, policies, cache)
+}
+
+// addNamedPolicies adds the canned policies of a comma separated list of policy names, the policies missing on the
+// server are reported as findings
+func addNamedPolicies(ctx context.Context, client MinioAdmin, names, source string, policies *entityPolicies, cache map[string]*iampolicy.Policy) error {
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		policy, ok := cache[name]
+		if !ok {
+			var err error
+			policy, err = client.getPolicy(ctx, name)
+			if err != nil && !isNoSuchPolicyError(err) {
+				return err
+			}
+			cache[name] = policy
+		}
+		if policy == nil {
+			policies.findings = append(policies.findings, fmt.Sprintf("the policy %s mapped to the %s doesn't exist", name, source))
+			continue
+		}
+		policies.identity = append(policies.identity, entityPolicy{name: name, source: source, policy: policy})
+	}
+	return nil
+}
+
+func (e *entityPolicies) all() []entityPolicy {
+	if e.session == nil {
+		return e.identity
+	}
+	return append(append([]entityPolicy{}, e.identity...), *e.session)
+}
+
+// evaluate returns whether the request is allowed and the statements deciding it. A request is allowed when at least
+// one statement allows it and none denies it, the embedded policy of service accounts must also allow it.
+func (e *entityPolicies) evaluate(args iampolicy.Args) (bool, []*models.MatchedStatement, error) {
+	allowed, matched, err := evaluatePolicies(e.identity, args)
+	if err != nil || e.session == nil {
+		return allowed, matched, err
+	}
+	sessionAllowed, sessionMatched, err := evaluatePolicies([]entityPolicy{*e.session}, args)
+	return allowed && sessionAllowed, append(matched, sessionMatched...), err
+}
+
+func evaluatePolicies(policies []entityPolicy, args iampolicy.Args) (bool, []*models.MatchedStatement, error) {
+	var allowed, denied bool
+	matched := []*models.MatchedStatement{}
+	for _, p := range policies {
+		for _, statement := range p.policy.Statements {
+			if !statementMatches(statement, args) {
+				continue
+			}
+			if statement.Effect == iampolicy.Deny {
+				denied = true
+			} else {
+				allowed = true
+			}
+			rawStatement, err := json.Marshal(statement)
+			if err != nil {
+				return false, nil, err
+			}
+			matched = append(matched, &models.MatchedStatement{
+				Policy:    p.name,
+				Source:    p.source,
+				Sid:       string(statement.SID),
+				Effect:    string(statement.Effect),
+				Statement: string(rawStatement),
+			})
+		}
+	}
+	return allowed && !denied, matched, nil
+}
+
+// statementMatches returns true if the statement applies to the request, regardless of its effect
+func statementMatches(statement iampolicy.Statement, args iampolicy.Args) bool {
+	// IsAllowed negates the result of the match for Deny statements
+	return statement.Effect.IsAllowed(statement.IsAllowed(args))
+}
+
+// parseResourceARN returns the bucket and object of a resource ARN, e.g. `arn:aws:s3:::bucket/prefix/object`
+func parseResourceARN(resource string) (bucket, object string) {
+	resource = strings.TrimPrefix(strings.TrimSpace(resource), iampolicy.ResourceARNPrefix)
+	bucket, object, _ = strings.Cut(resource, "/")
+	return bucket, object
+}
+
+// getSimulationConditionValues returns the condition values of a request sent by the account, the
+// provided conditions override the default ones
+func getSimulationConditionValues(accountName string, conditions []*models.SimulationCondition) map[string][]string {
+	currTime := time.Now().UTC()
+	conditionValues := map[string][]string{
+		condition.AWSUsername.Name():          {accountName},
+		condition.AWSPrincipalType.Name():     {"User"},
+		condition.AWSSecureTransport.Name():   {strconv.FormatBool(getMinIOEndpointIsSecure())},
+		condition.AWSCurrentTime.Name():       {currTime.Format(time.RFC3339)},
+		condition.AWSEpochTime.Name():         {strconv.FormatInt(currTime.Unix(), 10)},
+		condition.S3SignatureVersion.Name():   {"AWS4-HMAC-SHA256"},
+		condition.S3AuthType.Name():           {"REST-HEADER"},
+		condition.S3LocationConstraint.Name(): {GetMinIORegion()},
+	}
+	for _, c := range conditions {
+		if c == nil || c.Key == nil {
+			continue
+		}
+		// keys are accepted as written in policies, e.g. `aws:SourceIp`
+		conditionValues[condition.KeyName(*c.Key).Name()] = c.Values
+	}
+	return conditionValues
+}
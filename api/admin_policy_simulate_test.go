@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	simulateReadPolicy  = `{"Version":"2012-10-17","Statement":[{"Sid":"read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":["arn:aws:s3:::data/*","arn:aws:s3:::data"]}]}`
+	simulateDenyPolicy  = `{"Version":"2012-10-17","Statement":[{"Sid":"noSecrets","Effect":"Deny","Action":["s3:*"],"Resource":["arn:aws:s3:::data/secrets/*"]}]}`
+	simulateWritePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::data/uploads/*"]}]}`
+)
+
+func setSimulatePolicyMocks() {
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		policies := map[string]string{
+			"read":  simulateReadPolicy,
+			"deny":  simulateDenyPolicy,
+			"write": simulateWritePolicy,
+		}
+		raw, ok := policies[name]
+		if !ok {
+			return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy", Message: "The canned policy does not exist"}
+		}
+		return iampolicy.ParseConfig(strings.NewReader(raw))
+	}
+	minioGetUserInfoMock = func(_ string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "read", MemberOf: []string{"auditors", "disabled"}}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		if group == "disabled" {
+			return &madmin.GroupDesc{Name: group, Status: string(madmin.GroupDisabled), Policy: "write"}, nil
+		}
+		return &madmin.GroupDesc{Name: group, Status: string(madmin.GroupEnabled), Policy: "deny"}, nil
+	}
+}
+
+func TestSimulatePolicy(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+	setSimulatePolicyMocks()
+
+	simulate := func(entityType models.SimulationEntity, name, resource string, actions ...string) (*models.SimulatePolicyResponse, error) {
+		return simulatePolicy(ctx, adminClient, &models.SimulatePolicyRequest{
+			EntityType: entityType.Pointer(),
+			EntityName: &name,
+			Actions:    actions,
+			Resource:   &resource,
+		})
+	}
+
+	// Test-1: user policies and the policies inherited from enabled groups are evaluated
+	res, err := simulate(models.SimulationEntityUser, "alice", "arn:aws:s3:::data/reports/q1.csv", "s3:GetObject", "s3:PutObject")
+	funcAssert.NoError(err)
+	funcAssert.Equal([]*models.SimulatedPolicy{{Name: "read", Source: "user"}, {Name: "deny", Source: "group:auditors"}}, res.Policies)
+	funcAssert.Len(res.Results, 2)
+	funcAssert.True(res.Results[0].Allowed)
+	funcAssert.Len(res.Results[0].MatchedStatements, 1)
+	funcAssert.Equal("read", res.Results[0].MatchedStatements[0].Sid)
+	funcAssert.Equal("user", res.Results[0].MatchedStatements[0].Source)
+	// the write policy of the disabled group doesn't apply
+	funcAssert.False(res.Results[1].Allowed)
+	funcAssert.Empty(res.Results[1].MatchedStatements)
+
+	// Test-2: explicit deny inherited from a group wins over the user allow
+	res, err = simulate(models.SimulationEntityUser, "alice", "arn:aws:s3:::data/secrets/keys", "s3:GetObject")
+	funcAssert.NoError(err)
+	funcAssert.False(res.Results[0].Allowed)
+	funcAssert.Len(res.Results[0].MatchedStatements, 2)
+	funcAssert.Equal("Deny", res.Results[0].MatchedStatements[1].Effect)
+	funcAssert.Equal("group:auditors", res.Results[0].MatchedStatements[1].Source)
+
+	// Test-3: groups are evaluated with their own policies only
+	res, err = simulate(models.SimulationEntityGroup, "auditors", "data/secrets/keys", "s3:DeleteObject")
+	funcAssert.NoError(err)
+	funcAssert.False(res.Results[0].Allowed)
+	funcAssert.Len(res.Results[0].MatchedStatements, 1)
+
+	// Test-4: service accounts with an embedded policy are restricted by it
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{
+			ParentUser: "alice",
+			Policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::data"]}]}`,
+		}, nil
+	}
+	res, err = simulate(models.SimulationEntityServiceAccount, "svcacct", "arn:aws:s3:::data/reports/q1.csv", "s3:GetObject")
+	funcAssert.NoError(err)
+	funcAssert.Contains(res.Policies, &models.SimulatedPolicy{Name: "inline", Source: "serviceAccount"})
+	funcAssert.False(res.Results[0].Allowed)
+	res, err = simulate(models.SimulationEntityServiceAccount, "svcacct", "arn:aws:s3:::data", "s3:ListBucket")
+	funcAssert.NoError(err)
+	funcAssert.True(res.Results[0].Allowed)
+
+	// Test-5: service accounts with implied policy get the parent policies
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{ParentUser: "alice", ImpliedPolicy: true}, nil
+	}
+	res, err = simulate(models.SimulationEntityServiceAccount, "svcacct", "arn:aws:s3:::data/reports/q1.csv", "s3:GetObject")
+	funcAssert.NoError(err)
+	funcAssert.True(res.Results[0].Allowed)
+
+	// Test-6: errors getting the policies are returned
+	minioGetUserInfoMock = func(_ string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{}, errors.New("the specified user does not exist")
+	}
+	_, err = simulate(models.SimulationEntityUser, "bob", "arn:aws:s3:::data", "s3:ListBucket")
+	funcAssert.Error(err)
+
+	// Test-7: the requests of a disabled user are denied whatever its policies
+	minioGetUserInfoMock = func(_ string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "read", Status: madmin.AccountDisabled}, nil
+	}
+	res, err = simulate(models.SimulationEntityUser, "carol", "arn:aws:s3:::data/reports/q1.csv", "s3:GetObject")
+	funcAssert.NoError(err)
+	funcAssert.False(res.Results[0].Allowed)
+	funcAssert.Equal("the user carol is disabled", res.Results[0].Reason)
+	funcAssert.Len(res.Results[0].MatchedStatements, 1)
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{ParentUser: "alice", ImpliedPolicy: true, AccountStatus: "off"}, nil
+	}
+	minioGetUserInfoMock = func(_ string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "read"}, nil
+	}
+	res, err = simulate(models.SimulationEntityServiceAccount, "svcacct", "arn:aws:s3:::data/reports/q1.csv", "s3:GetObject")
+	funcAssert.NoError(err)
+	funcAssert.False(res.Results[0].Allowed)
+	funcAssert.Equal("the service account svcacct is disabled", res.Results[0].Reason)
+
+	// Test-8: a mapped policy missing on the server is reported and the other policies are still evaluated
+	minioGetUserInfoMock = func(_ string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "read,removed"}, nil
+	}
+	res, err = simulate(models.SimulationEntityUser, "dave", "arn:aws:s3:::data/reports/q1.csv", "s3:GetObject")
+	funcAssert.NoError(err)
+	funcAssert.True(res.Results[0].Allowed)
+	funcAssert.Empty(res.Results[0].Reason)
+	funcAssert.Equal([]string{"the policy removed mapped to the user doesn't exist"}, res.Findings)
+	funcAssert.Equal([]*models.SimulatedPolicy{{Name: "read", Source: "user"}}, res.Policies)
+
+	// Test-9: the other errors getting a policy are returned
+	minioGetPolicyMock = func(_ string) (*iampolicy.Policy, error) {
+		return nil, errors.New("connection refused")
+	}
+	_, err = simulate(models.SimulationEntityUser, "dave", "arn:aws:s3:::data", "s3:ListBucket")
+	funcAssert.Error(err)
+}
+
+func TestSimulationConditionValues(t *testing.T) {
+	key := "aws:SourceIp"
+	values := getSimulationConditionValues("alice", []*models.SimulationCondition{{Key: &key, Values: []string{"10.0.0.1"}}})
+	assert.Equal(t, []string{"10.0.0.1"}, values["SourceIp"])
+	assert.Equal(t, []string{"alice"}, values["username"])
+}
@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openstor/console/api/operations"
+	userApi "github.com/openstor/console/api/operations/user"
+	policies "github.com/openstor/console/api/policy"
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"github.com/openstor/pkg/v3/wildcard"
+)
+
+// Effective permission levels of a user on a bucket
+const (
+	// PermissionFull means the actions are allowed on the whole bucket
+	PermissionFull = "full"
+	// PermissionPartial means the actions are only allowed on some prefixes of the bucket
+	PermissionPartial = "partial"
+	// PermissionNone means the actions are not allowed on the bucket
+	PermissionNone = "none"
+)
+
+type permissionAction struct {
+	action iampolicy.Action
+	// objectLevel actions are evaluated against every object of the bucket instead of the bucket itself
+	objectLevel bool
+}
+
+var (
+	readPermissionActions = []permissionAction{
+		{action: iampolicy.ListBucketAction},
+		{action: iampolicy.GetObjectAction, objectLevel: true},
+	}
+	writePermissionActions = []permissionAction{
+		{action: iampolicy.PutObjectAction, objectLevel: true},
+	}
+	deletePermissionActions = []permissionAction{
+		{action: iampolicy.DeleteObjectAction, objectLevel: true},
+	}
+	adminPermissionActions = []permissionAction{
+		{action: iampolicy.PutBucketPolicyAction},
+		{action: iampolicy.PutBucketVersioningAction},
+		{action: iampolicy.PutBucketLifecycleAction},
+		{action: iampolicy.PutBucketNotificationAction},
+		{action: iampolicy.DeleteBucketAction},
+	}
+)
+
+func registerUserPermissionsHandlers(api *operations.ConsoleAPI) {
+	// Get the effective permissions of a user on every bucket
+	api.UserGetUserEffectivePermissionsHandler = userApi.GetUserEffectivePermissionsHandlerFunc(func(params userApi.GetUserEffectivePermissionsParams, session *models.Principal) middleware.Responder {
+		permissionsResponse, err := getUserEffectivePermissionsResponse(session, params)
+		if err != nil {
+			return userApi.NewGetUserEffectivePermissionsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return userApi.NewGetUserEffectivePermissionsOK().WithPayload(permissionsResponse)
+	})
+	// Export the effective permissions of a user on every bucket as CSV
+	api.UserExportUserEffectivePermissionsHandler = userApi.ExportUserEffectivePermissionsHandlerFunc(func(params userApi.ExportUserEffectivePermissionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getExportUserEffectivePermissionsResponse(session, params)
+		if err != nil {
+			return userApi.NewExportUserEffectivePermissionsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return resp
+	})
+}
+
+// getUserEffectivePermissionsResponse performs getUserEffectivePermissions() and serializes it to the handler's output
+func getUserEffectivePermissionsResponse(session *models.Principal, params userApi.GetUserEffectivePermissionsParams) (*models.EffectivePermissionsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	permissions, err := getUserEffectivePermissions(ctx, adminClient, params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return permissions, nil
+}
+
+// getExportUserEffectivePermissionsResponse returns the effective permissions of the user as a CSV file
+func getExportUserEffectivePermissionsResponse(session *models.Principal, params userApi.ExportUserEffectivePermissionsParams) (middleware.Responder, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	permissions, err := getUserEffectivePermissions(ctx, adminClient, params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	report, err := effectivePermissionsToCSV(permissions)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set("Content-Type", "text/csv")
+		rw.Header().Set("Content-Disposition", effectivePermissionsContentDisposition(params.Name))
+		rw.WriteHeader(http.StatusOK)
+		if _, err := rw.Write(report); err != nil {
+			LogError("unable to write effective permissions report: %v", err)
+		}
+	}), nil
+}
+
+// effectivePermissionsContentDisposition names the CSV report of the user, the quotes and non-ASCII characters of
+// the user name are escaped
+func effectivePermissionsContentDisposition(user string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": user + "-effective-permissions.csv"})
+}
+
+// getUserEffectivePermissions returns the read/write/delete/admin permissions the user holds on every bucket, taking
+// into account the policies inherited from its groups, Deny statements and policy variables.
+func getUserEffectivePermissions(ctx context.Context, client MinioAdmin, user string) (*models.EffectivePermissionsResponse, error) {
+	userPolicies, err := getEntityPolicies(ctx, client, models.SimulationEntityUser, user)
+	if err != nil {
+		return nil, err
+	}
+	for i := range userPolicies.identity {
+		resolved, err := resolvePolicyVariables(userPolicies.identity[i].policy, user)
+		if err != nil {
+			return nil, err
+		}
+		userPolicies.identity[i].policy = resolved
+	}
+	accountInfo, err := getAccountInfo(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.EffectivePermissionsResponse{
+		User:   user,
+		Groups: userPolicies.groups,
+	}
+	for _, p := range userPolicies.all() {
+		response.Policies = append(response.Policies, &models.SimulatedPolicy{Name: p.name, Source: p.source})
+	}
+	conditionValues := getSimulationConditionValues(user, nil)
+	for _, bucket := range accountInfo.Buckets {
+		bucketPermissions := &models.BucketEffectivePermissions{Bucket: bucket.Name, AllowedActions: []string{}}
+		levels := []*string{&bucketPermissions.Read, &bucketPermissions.Write, &bucketPermissions.Delete, &bucketPermissions.Admin}
+		for i, actions := range [][]permissionAction{readPermissionActions, writePermissionActions, deletePermissionActions, adminPermissionActions} {
+			level, allowed, err := userPolicies.permissionLevel(bucket.Name, actions, conditionValues)
+			if err != nil {
+				return nil, err
+			}
+			*levels[i] = level
+			bucketPermissions.AllowedActions = append(bucketPermissions.AllowedActions, allowed...)
+		}
+		response.Buckets = append(response.Buckets, bucketPermissions)
+	}
+	sort.Slice(response.Buckets, func(i, j int) bool {
+		return response.Buckets[i].Bucket < response.Buckets[j].Bucket
+	})
+	return response, nil
+}
+
+// resolvePolicyVariables replaces the policy variables with the values of the user
+func resolvePolicyVariables(policy *iampolicy.Policy, user string) (*iampolicy.Policy, error) {
+	rawPolicy, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	rawPolicy = policies.ReplacePolicyVariables(map[string]interface{}{}, &madmin.AccountInfo{
+		AccountName: user,
+		Policy:      rawPolicy,
+	})
+	return iampolicy.ParseConfig(bytes.NewReader(rawPolicy))
+}
+
+// permissionLevel returns whether the actions are allowed on the whole bucket, on some of its prefixes or not at all,
+// along with the actions allowed on the whole bucket
+func (e *entityPolicies) permissionLevel(bucket string, actions []permissionAction, conditionValues map[string][]string) (string, []string, error) {
+	var full, partial int
+	var allowedActions []string
+	for _, a := range actions {
+		args := iampolicy.Args{
+			AccountName:     e.accountName,
+			Groups:          e.groups,
+			Action:          a.action,
+			BucketName:      bucket,
+			ConditionValues: conditionValues,
+		}
+		if a.objectLevel {
+			args.ObjectName = "*"
+		}
+		allowed, matched, err := e.evaluate(args)
+		if err != nil {
+			return "", nil, err
+		}
+		deniedBucket := false
+		for _, m := range matched {
+			if m.Effect == string(iampolicy.Deny) {
+				deniedBucket = true
+			}
+		}
+		switch {
+		case allowed && e.appliesToPrefix(iampolicy.Deny, a.action, bucket):
+			partial++
+		case allowed:
+			full++
+			allowedActions = append(allowedActions, string(a.action))
+		case !deniedBucket && e.appliesToPrefix(iampolicy.Allow, a.action, bucket):
+			partial++
+		}
+	}
+	switch {
+	case full == len(actions):
+		return PermissionFull, allowedActions, nil
+	case full == 0 && partial == 0:
+		return PermissionNone, allowedActions, nil
+	default:
+		return PermissionPartial, allowedActions, nil
+	}
+}
+
+// appliesToPrefix returns true if any statement with the effect applies to the action on a prefix of the bucket
+func (e *entityPolicies) appliesToPrefix(effect iampolicy.Effect, action iampolicy.Action, bucket string) bool {
+	for _, p := range e.all() {
+		for _, statement := range p.policy.Statements {
+			if statement.Effect != effect || !statement.Actions.Match(action) {
+				continue
+			}
+			for resource := range statement.Resources {
+				bucketPattern, prefix, found := strings.Cut(resource.Pattern, "/")
+				if found && prefix != "*" && wildcard.Match(bucketPattern, bucket) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// effectivePermissionsToCSV serializes the effective permissions report as CSV, one row per bucket
+func effectivePermissionsToCSV(permissions *models.EffectivePermissionsResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"bucket", "read", "write", "delete", "admin", "allowed_actions"}); err != nil {
+		return nil, err
+	}
+	for _, b := range permissions.Buckets {
+		if err := w.Write([]string{b.Bucket, b.Read, b.Write, b.Delete, b.Admin, strings.Join(b.AllowedActions, " ")}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"strings"
+	"testing"
+
+	"github.com/openstor/madmin-go/v4"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserEffectivePermissions(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+
+	policies := map[string]string{
+		"data-rw": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::data","arn:aws:s3:::data/*"]}]}`,
+		"home":    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["arn:aws:s3:::home/${aws:username}/*"]}]}`,
+		"deny":    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:DeleteObject"],"Resource":["arn:aws:s3:::data/*"]},{"Effect":"Deny","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/secrets/*"]}]}`,
+	}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		raw, ok := policies[name]
+		if !ok {
+			return nil, errors.New("policy not found")
+		}
+		return iampolicy.ParseConfig(strings.NewReader(raw))
+	}
+	minioGetUserInfoMock = func(_ string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "data-rw,home", MemberOf: []string{"restricted"}}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Status: string(madmin.GroupEnabled), Policy: "deny"}, nil
+	}
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{{Name: "home"}, {Name: "data"}, {Name: "other"}}}, nil
+	}
+
+	permissions, err := getUserEffectivePermissions(ctx, adminClient, "alice")
+	funcAssert.NoError(err)
+	funcAssert.Equal("alice", permissions.User)
+	funcAssert.Equal([]string{"restricted"}, permissions.Groups)
+	funcAssert.Len(permissions.Policies, 3)
+	funcAssert.Len(permissions.Buckets, 3)
+
+	data := permissions.Buckets[0]
+	funcAssert.Equal("data", data.Bucket)
+	// the group denies reading a prefix and deleting objects
+	funcAssert.Equal(PermissionPartial, data.Read)
+	funcAssert.Equal(PermissionFull, data.Write)
+	funcAssert.Equal(PermissionNone, data.Delete)
+	funcAssert.Equal(PermissionFull, data.Admin)
+	funcAssert.Contains(data.AllowedActions, "s3:ListBucket")
+	funcAssert.NotContains(data.AllowedActions, "s3:GetObject")
+
+	home := permissions.Buckets[1]
+	funcAssert.Equal("home", home.Bucket)
+	// access is restricted to the prefix of the user
+	funcAssert.Equal(PermissionPartial, home.Read)
+	funcAssert.Equal(PermissionPartial, home.Write)
+	funcAssert.Equal(PermissionNone, home.Delete)
+	funcAssert.Equal(PermissionNone, home.Admin)
+
+	other := permissions.Buckets[2]
+	funcAssert.Equal(PermissionNone, other.Read)
+	funcAssert.Empty(other.AllowedActions)
+
+	// policy variables are resolved for the user
+	userPolicies, err := getEntityPolicies(ctx, adminClient, "user", "alice")
+	funcAssert.NoError(err)
+	resolved, err := resolvePolicyVariables(userPolicies.identity[1].policy, "alice")
+	funcAssert.NoError(err)
+	var resolvedHome string
+	for resource := range resolved.Statements[0].Resources {
+		resolvedHome = resource.Pattern
+	}
+	funcAssert.Equal("home/alice/*", resolvedHome)
+
+	report, err := effectivePermissionsToCSV(permissions)
+	funcAssert.NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(report)), "\n")
+	funcAssert.Len(lines, 4)
+	funcAssert.Equal("bucket,read,write,delete,admin,allowed_actions", lines[0])
+	funcAssert.Equal("other,none,none,none,none,", lines[3])
+}
+
+func TestEffectivePermissionsContentDisposition(t *testing.T) {
+	funcAssert := assert.New(t)
+	for _, user := range []string{"alice", `bob "the admin"`, "zoë", `c:\users\dave`} {
+		disposition, params, err := mime.ParseMediaType(effectivePermissionsContentDisposition(user))
+		funcAssert.NoError(err)
+		funcAssert.Equal("attachment", disposition)
+		funcAssert.Equal(user+"-effective-permissions.csv", params["filename"])
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
@@ -80,6 +81,14 @@ func registerUsersHandlers(api *operations.ConsoleAPI) {
 
 		return userApi.NewUpdateUserInfoOK().WithPayload(userUpdateResponse)
 	})
+	// Set or clear the expiry of a User
+	api.UserUpdateUserExpiryHandler = userApi.UpdateUserExpiryHandlerFunc(func(params userApi.UpdateUserExpiryParams, session *models.Principal) middleware.Responder {
+		err := getUpdateUserExpiryResponse(session, params)
+		if err != nil {
+			return userApi.NewUpdateUserExpiryDefault(err.Code).WithPayload(err.APIError)
+		}
+		return userApi.NewUpdateUserExpiryNoContent()
+	})
 	// Update User-Groups Bulk
 	api.UserBulkUpdateUsersGroupsHandler = userApi.BulkUpdateUsersGroupsHandlerFunc(func(params userApi.BulkUpdateUsersGroupsParams, session *models.Principal) middleware.Responder {
 		err := getAddUsersListToGroupsResponse(session, params)
@@ -89,6 +98,14 @@ func registerUsersHandlers(api *operations.ConsoleAPI) {
 
 		return userApi.NewBulkUpdateUsersGroupsOK()
 	})
+	// Provision a list of users
+	api.UserBulkProvisionUsersHandler = userApi.BulkProvisionUsersHandlerFunc(func(params userApi.BulkProvisionUsersParams, session *models.Principal) middleware.Responder {
+		provisionResponse, err := getBulkProvisionUsersResponse(session, params)
+		if err != nil {
+			return userApi.NewBulkProvisionUsersDefault(err.Code).WithPayload(err.APIError)
+		}
+		return userApi.NewBulkProvisionUsersOK().WithPayload(provisionResponse)
+	})
 	api.BucketListUsersWithAccessToBucketHandler = bucketApi.ListUsersWithAccessToBucketHandlerFunc(func(params bucketApi.ListUsersWithAccessToBucketParams, session *models.Principal) middleware.Responder {
 		response, err := getListUsersWithAccessToBucketResponse(session, params)
 		if err != nil {
@@ -217,6 +234,10 @@ func getUserAddResponse(session *models.Principal, params userApi.AddUserParams)
 	if userExists {
 		return nil, ErrorWithContext(ctx, ErrNonUniqueAccessKey)
 	}
+	expiry, err := parseUserExpiry(params.Body.ExpiresAt, params.Body.Retention, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
 	user, err := addUser(
 		ctx,
 		adminClient,
@@ -228,6 +249,20 @@ func getUserAddResponse(session *models.Principal, params userApi.AddUserParams)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	if expiry != nil {
+		// a temporary user whose expiry can't be saved would never expire, so it is removed
+		store, err := newUserExpiryStore(session, getClientIP(params.HTTPRequest))
+		if err == nil {
+			err = setUserExpiry(ctx, store, *params.Body.AccessKey, expiry)
+		}
+		if err != nil {
+			if errRemove := removeUser(ctx, adminClient, *params.Body.AccessKey); errRemove != nil {
+				LogError("unable to remove the user %s: %v", *params.Body.AccessKey, errRemove)
+			}
+			return nil, ErrorWithContext(ctx, err)
+		}
+		addUserExpiry(user, expiry)
+	}
 	return user, nil
 }
 
@@ -319,6 +354,18 @@ func getUserInfoResponse(session *models.Principal, params userApi.GetUserInfoPa
 		HasPolicy: hasPolicy,
 	}
 
+	// the expiry is informative, the user info is returned even if it can't be read
+	store, err := newUserExpiryStore(session, getClientIP(params.HTTPRequest))
+	if err == nil {
+		var expiry *userExpiry
+		if expiry, err = getUserExpiry(ctx, store, params.Name); err == nil {
+			addUserExpiry(userInformation, expiry)
+		}
+	}
+	if err != nil {
+		LogError("unable to get the expiry of user %s: %v", params.Name, err)
+	}
+
 	return userInformation, nil
 }
 
@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	userApi "github.com/openstor/console/api/operations/user"
+	"github.com/openstor/console/models"
+)
+
+// Status of every row of a bulk provisioning request
+const (
+	provisionStatusValid   = "valid"
+	provisionStatusInvalid = "invalid"
+	provisionStatusCreated = "created"
+	// provisionStatusPartial is a user created without some of its groups, policies or status
+	provisionStatusPartial = "partial"
+	provisionStatusFailed  = "failed"
+)
+
+const (
+	provisionMinAccessKeyLength = 3
+	provisionMinSecretKeyLength = 8
+	provisionMaxSecretKeyLength = 40
+	// provisionListSeparator separates the groups and policies of a CSV row
+	provisionListSeparator = ";"
+)
+
+// provisionRow is a user to provision along with the row it was read from
+type provisionRow struct {
+	row  int32
+	user *models.BulkProvisionUser
+}
+
+// getBulkProvisionUsersResponse parses the users of the request and performs bulkProvisionUsers()
+func getBulkProvisionUsersResponse(session *models.Principal, params userApi.BulkProvisionUsersParams) (*models.BulkProvisionUsersResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body == nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("a list of users is required"))
+	}
+	rows, err := getProvisionRows(params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	provisionResponse, err := bulkProvisionUsers(ctx, adminClient, rows, params.Body.GenerateSecrets, params.Body.DryRun)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return provisionResponse, nil
+}
+
+// getProvisionRows returns the users of the request, either from the CSV document or the JSON list
+func getProvisionRows(req *models.BulkProvisionUsersRequest) ([]provisionRow, error) {
+	if req.Csv != "" && len(req.Users) > 0 {
+		return nil, errors.New("users must be provided either as CSV or as a list, not both")
+	}
+	if req.Csv != "" {
+		return parseProvisionCSV(strings.NewReader(req.Csv))
+	}
+	if len(req.Users) == 0 {
+		return nil, errors.New("a list of users is required")
+	}
+	var rows []provisionRow
+	for i, user := range req.Users {
+		if user == nil {
+			return nil, fmt.Errorf("user %d is empty", i+1)
+		}
+		rows = append(rows, provisionRow{row: int32(i + 1), user: user})
+	}
+	return rows, nil
+}
+
+// parseProvisionCSV reads the users of a CSV document. The header names the columns, only `access_key` is
+// mandatory, e.g.
+//
+//	access_key,secret_key,groups,policies,status
+//	contractor1,,contractors;auditors,readonly,enabled
+func parseProvisionCSV(r io.Reader) ([]provisionRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("the CSV document is empty")
+		}
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		column := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), "_", "")
+		switch column {
+		case "accesskey", "secretkey", "groups", "policies", "status":
+			columns[column] = i
+		default:
+			return nil, fmt.Errorf("unknown CSV column %s", name)
+		}
+	}
+	if _, ok := columns["accesskey"]; !ok {
+		return nil, errors.New("the CSV document requires an access_key column")
+	}
+	// the number of fields is checked against the header by the reader
+	value := func(record []string, column string) string {
+		if i, ok := columns[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	var rows []provisionRow
+	for row := int32(1); ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, provisionRow{row: row, user: &models.BulkProvisionUser{
+			AccessKey: value(record, "accesskey"),
+			SecretKey: value(record, "secretkey"),
+			Groups:    splitProvisionList(value(record, "groups")),
+			Policies:  splitProvisionList(value(record, "policies")),
+			Status:    value(record, "status"),
+		}})
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("the CSV document has no users")
+	}
+	return rows, nil
+}
+
+func splitProvisionList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, provisionListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// bulkProvisionUsers validates every row first and only creates the users when all of them are valid. Users
+// are created one by one and a failure doesn't stop the creation of the remaining ones.
+func bulkProvisionUsers(ctx context.Context, client MinioAdmin, rows []provisionRow, generateSecrets, dryRun bool) (*models.BulkProvisionUsersResponse, error) {
+	existingUsers, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existingPolicies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.BulkProvisionUsersResponse{}
+	seen := map[string]int32{}
+	valid := true
+	for _, r := range rows {
+		var rowErrors []string
+		user := r.user
+		switch {
+		case user.AccessKey == "":
+			rowErrors = append(rowErrors, "access key is required")
+		case len(user.AccessKey) < provisionMinAccessKeyLength:
+			rowErrors = append(rowErrors, fmt.Sprintf("access key must be at least %d characters long", provisionMinAccessKeyLength))
+		}
+		if previous, ok := seen[user.AccessKey]; ok && user.AccessKey != "" {
+			rowErrors = append(rowErrors, fmt.Sprintf("access key is duplicated in row %d", previous))
+		} else {
+			seen[user.AccessKey] = r.row
+		}
+		if _, ok := existingUsers[user.AccessKey]; ok {
+			rowErrors = append(rowErrors, "user already exists")
+		}
+		switch {
+		case user.SecretKey == "" && !generateSecrets:
+			rowErrors = append(rowErrors, "secret key is required unless secrets are generated")
+		case user.SecretKey != "" && (len(user.SecretKey) < provisionMinSecretKeyLength || len(user.SecretKey) > provisionMaxSecretKeyLength):
+			rowErrors = append(rowErrors, fmt.Sprintf("secret key must be between %d and %d characters long", provisionMinSecretKeyLength, provisionMaxSecretKeyLength))
+		}
+		for _, policy := range user.Policies {
+			if _, ok := existingPolicies[policy]; !ok {
+				rowErrors = append(rowErrors, fmt.Sprintf("policy %s does not exist", policy))
+			}
+		}
+		if user.Status != "" && user.Status != "enabled" && user.Status != "disabled" {
+			rowErrors = append(rowErrors, fmt.Sprintf("invalid status %s", user.Status))
+		}
+
+		result := &models.BulkProvisionUserResult{Row: r.row, AccessKey: user.AccessKey, Status: provisionStatusValid}
+		if len(rowErrors) > 0 {
+			result.Status = provisionStatusInvalid
+			result.Errors = rowErrors
+			valid = false
+		}
+		response.Results = append(response.Results, result)
+	}
+	if !valid || dryRun {
+		return response, nil
+	}
+
+	response.Applied = true
+	var credentials [][]string
+	for i, r := range rows {
+		result := response.Results[i]
+		user := r.user
+		secretKey := user.SecretKey
+		if secretKey == "" {
+			secretKey = RandomCharString(provisionMaxSecretKeyLength)
+		}
+		if err := client.addUser(ctx, user.AccessKey, secretKey); err != nil {
+			result.Status = provisionStatusFailed
+			result.Errors = []string{err.Error()}
+			continue
+		}
+		// the user exists from now on, its generated secret is returned even when the next steps fail
+		result.Status = provisionStatusCreated
+		if user.SecretKey == "" {
+			credentials = append(credentials, []string{user.AccessKey, secretKey})
+		}
+		if errs := provisionUserSettings(ctx, client, user); len(errs) > 0 {
+			result.Status = provisionStatusPartial
+			result.Errors = errs
+		}
+	}
+	if len(credentials) > 0 {
+		credentialsFile, err := provisionCredentialsToCSV(credentials)
+		if err != nil {
+			return nil, err
+		}
+		response.CredentialsFile = credentialsFile
+	}
+	return response, nil
+}
+
+// provisionUserSettings sets the groups, policies and status of a created user, every step is attempted and
+// their errors are returned
+func provisionUserSettings(ctx context.Context, client MinioAdmin, user *models.BulkProvisionUser) []string {
+	var errs []string
+	if len(user.Groups) > 0 {
+		if _, err := updateUserGroups(ctx, client, user.AccessKey, user.Groups); err != nil {
+			errs = append(errs, fmt.Sprintf("unable to add the user to its groups: %v", err))
+		}
+	}
+	if len(user.Policies) > 0 {
+		if err := SetPolicy(ctx, client, strings.Join(user.Policies, ","), user.AccessKey, "user"); err != nil {
+			errs = append(errs, fmt.Sprintf("unable to set the policies of the user: %v", err))
+		}
+	}
+	if user.Status == "disabled" {
+		if err := setUserStatus(ctx, client, user.AccessKey, user.Status); err != nil {
+			errs = append(errs, fmt.Sprintf("unable to disable the user: %v", err))
+		}
+	}
+	return errs
+}
+
+// provisionCredentialsToCSV serializes the generated credentials, one row per user
+func provisionCredentialsToCSV(credentials [][]string) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"access_key", "secret_key"}); err != nil {
+		return "", err
+	}
+	if err := w.WriteAll(credentials); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProvisionCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		want    []provisionRow
+		wantErr bool
+	}{
+		{
+			name: "all columns",
+			csv:  "access_key,secret_key,groups,policies,status\ncontractor1,secret123,contractors; auditors,readonly,disabled\n",
+			want: []provisionRow{{row: 1, user: &models.BulkProvisionUser{
+				AccessKey: "contractor1",
+				SecretKey: "secret123",
+				Groups:    []string{"contractors", "auditors"},
+				Policies:  []string{"readonly"},
+				Status:    "disabled",
+			}}},
+		},
+		{
+			name: "only access keys",
+			csv:  "accessKey\ncontractor1\ncontractor2\n",
+			want: []provisionRow{
+				{row: 1, user: &models.BulkProvisionUser{AccessKey: "contractor1"}},
+				{row: 2, user: &models.BulkProvisionUser{AccessKey: "contractor2"}},
+			},
+		},
+		{
+			name:    "missing access key column",
+			csv:     "secret_key\nsecret123\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown column",
+			csv:     "access_key,email\ncontractor1,c@example.com\n",
+			wantErr: true,
+		},
+		{
+			name:    "wrong number of fields",
+			csv:     "access_key,status\ncontractor1\n",
+			wantErr: true,
+		},
+		{
+			name:    "no users",
+			csv:     "access_key\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(_ *testing.T) {
+			got, err := parseProvisionCSV(strings.NewReader(tt.csv))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBulkProvisionUsers(t *testing.T) {
+	funcAssert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx := context.Background()
+
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"existing": {Status: madmin.AccountEnabled}}, nil
+	}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{"readonly": {}}, nil
+	}
+	created := map[string]string{}
+	minioAddUserMock = func(accessKey, secretKey string) error {
+		if accessKey == "broken" {
+			return errors.New("unable to create user")
+		}
+		created[accessKey] = secretKey
+		return nil
+	}
+	var policies []string
+	minioSetPolicyMock = func(policyName, entityName string, _ bool) error {
+		policies = append(policies, entityName+":"+policyName)
+		return nil
+	}
+	var disabled []string
+	minioSetUserStatusMock = func(accessKey string, _ madmin.AccountStatus) error {
+		disabled = append(disabled, accessKey)
+		return nil
+	}
+	rows := func(users ...*models.BulkProvisionUser) []provisionRow {
+		var r []provisionRow
+		for i, u := range users {
+			r = append(r, provisionRow{row: int32(i + 1), user: u})
+		}
+		return r
+	}
+
+	// Test-1: every row is validated and nothing is created when one of them is invalid
+	res, err := bulkProvisionUsers(ctx, adminClient, rows(
+		&models.BulkProvisionUser{AccessKey: "contractor1", SecretKey: "secret123"},
+		&models.BulkProvisionUser{AccessKey: "existing", SecretKey: "secret123"},
+		&models.BulkProvisionUser{AccessKey: "contractor1", SecretKey: "short", Policies: []string{"missing"}, Status: "unknown"},
+		&models.BulkProvisionUser{AccessKey: "contractor2"},
+	), false, false)
+	funcAssert.NoError(err)
+	funcAssert.False(res.Applied)
+	funcAssert.Empty(created)
+	funcAssert.Equal("valid", res.Results[0].Status)
+	funcAssert.Equal([]string{"user already exists"}, res.Results[1].Errors)
+	funcAssert.Equal("invalid", res.Results[2].Status)
+	funcAssert.Len(res.Results[2].Errors, 4)
+	funcAssert.Equal([]string{"secret key is required unless secrets are generated"}, res.Results[3].Errors)
+
+	// Test-2: dry run only validates the rows
+	res, err = bulkProvisionUsers(ctx, adminClient, rows(&models.BulkProvisionUser{AccessKey: "contractor1"}), true, true)
+	funcAssert.NoError(err)
+	funcAssert.False(res.Applied)
+	funcAssert.Equal("valid", res.Results[0].Status)
+	funcAssert.Empty(created)
+
+	// Test-3: users are created with per row results and the generated secrets are returned as CSV
+	res, err = bulkProvisionUsers(ctx, adminClient, rows(
+		&models.BulkProvisionUser{AccessKey: "contractor1", SecretKey: "secret123", Policies: []string{"readonly"}},
+		&models.BulkProvisionUser{AccessKey: "contractor2", Status: "disabled"},
+		&models.BulkProvisionUser{AccessKey: "broken"},
+	), true, false)
+	funcAssert.NoError(err)
+	funcAssert.True(res.Applied)
+	funcAssert.Equal("created", res.Results[0].Status)
+	funcAssert.Equal("created", res.Results[1].Status)
+	funcAssert.Equal("failed", res.Results[2].Status)
+	funcAssert.Equal([]string{"unable to create user"}, res.Results[2].Errors)
+	funcAssert.Equal("secret123", created["contractor1"])
+	funcAssert.Len(created["contractor2"], provisionMaxSecretKeyLength)
+	funcAssert.Equal([]string{"contractor1:readonly"}, policies)
+	funcAssert.Equal([]string{"contractor2"}, disabled)
+	records, err := csv.NewReader(strings.NewReader(res.CredentialsFile)).ReadAll()
+	funcAssert.NoError(err)
+	funcAssert.Equal([][]string{{"access_key", "secret_key"}, {"contractor2", created["contractor2"]}}, records)
+
+	// Test-4: a user whose policies can't be set is reported as partially created and its secret is still returned
+	minioSetPolicyMock = func(_, _ string, _ bool) error {
+		return errors.New("unable to attach policy")
+	}
+	res, err = bulkProvisionUsers(ctx, adminClient, rows(
+		&models.BulkProvisionUser{AccessKey: "contractor3", Policies: []string{"readonly"}, Status: "disabled"},
+	), true, false)
+	funcAssert.NoError(err)
+	funcAssert.Equal("partial", res.Results[0].Status)
+	if funcAssert.Len(res.Results[0].Errors, 1) {
+		funcAssert.Contains(res.Results[0].Errors[0], "unable to attach policy")
+	}
+	funcAssert.Contains(disabled, "contractor3")
+	records, err = csv.NewReader(strings.NewReader(res.CredentialsFile)).ReadAll()
+	funcAssert.NoError(err)
+	funcAssert.Equal([][]string{{"access_key", "secret_key"}, {"contractor3", created["contractor3"]}}, records)
+
+	// Test-5: errors getting the existing users are returned
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return nil, errors.New("error")
+	}
+	_, err = bulkProvisionUsers(ctx, adminClient, rows(&models.BulkProvisionUser{AccessKey: "contractor1"}), true, false)
+	funcAssert.Error(err)
+}
@@ -0,0 +1,972 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openstor/console/api/operations"
+	systemApi "github.com/openstor/console/api/operations/system"
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	"github.com/openstor/openstor-go/v7"
+	iampolicy "github.com/openstor/pkg/v3/policy"
+)
+
+const (
+	// alertsStateObject is the object of the system bucket keeping the alerts
+	alertsStateObject = "alerts/state.json"
+	// alertsSilencesObject is the object of the system bucket keeping the silences shared by the consoles
+	alertsSilencesObject = "alerts/silences.json"
+	// alertsSilencesRetries bounds the attempts to change the silences changed concurrently by another console
+	alertsSilencesRetries = 3
+	// alertsMaxHistory limits the resolved alerts kept for the console
+	alertsMaxHistory = 500
+	// alertsDefaultQuotaThreshold is the quota percentage above which the bucketQuota rules fire by default
+	alertsDefaultQuotaThreshold = 90
+)
+
+func registerAlertsHandlers(api *operations.ConsoleAPI) {
+	// List the alerts, rules and silences
+	api.SystemListAlertsHandler = systemApi.ListAlertsHandlerFunc(func(params systemApi.ListAlertsParams, session *models.Principal) middleware.Responder {
+		alertsResp, err := getListAlertsResponse(session, params)
+		if err != nil {
+			return systemApi.NewListAlertsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewListAlertsOK().WithPayload(alertsResp)
+	})
+	// Silence alerts
+	api.SystemCreateAlertSilenceHandler = systemApi.CreateAlertSilenceHandlerFunc(func(params systemApi.CreateAlertSilenceParams, session *models.Principal) middleware.Responder {
+		silence, err := getCreateAlertSilenceResponse(session, params)
+		if err != nil {
+			return systemApi.NewCreateAlertSilenceDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewCreateAlertSilenceCreated().WithPayload(silence)
+	})
+	// Remove a silence
+	api.SystemDeleteAlertSilenceHandler = systemApi.DeleteAlertSilenceHandlerFunc(func(params systemApi.DeleteAlertSilenceParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteAlertSilenceResponse(session, params); err != nil {
+			return systemApi.NewDeleteAlertSilenceDefault(err.Code).WithPayload(err.APIError)
+		}
+		return systemApi.NewDeleteAlertSilenceNoContent()
+	})
+}
+
+// globalAlertEngine evaluates the alert rules for this console instance, nil when it isn't configured
+var globalAlertEngine *alertEngine
+
+// errAlertsNotConfigured is returned when the silences are changed without the alert rules being evaluated
+var errAlertsNotConfigured = &CodedAPIError{
+	Code: 400,
+	APIError: &models.APIError{
+		Message:         ErrBadRequest.Error(),
+		DetailedMessage: "the alert rules aren't evaluated by this console",
+	},
+}
+
+type alertsConfig struct {
+	// interval between two evaluations of the rules
+	interval time.Duration
+	// repeatInterval is how long after its last notification a firing alert is notified again, 0 notifies once
+	repeatInterval   time.Duration
+	webhookURL       string
+	webhookAuthToken string
+	smtpAddress      string
+	smtpUsername     string
+	smtpPassword     string
+	smtpFrom         string
+	smtpTo           []string
+}
+
+// alertRules is the format of the file defining the alert rules, in YAML or JSON:
+//
+//	rules:
+//	  - name: bucket-quota
+//	    kind: bucketQuota
+//	    severity: warning
+//	    threshold: 90
+//	  - name: high-latency
+//	    kind: prometheus
+//	    query: histogram_quantile(0.99, rate(minio_s3_requests_ttfb_seconds_distribution[5m]))
+//	    threshold: 1
+type alertRules struct {
+	Rules []*models.AlertRule `json:"rules" yaml:"rules"`
+}
+
+// defaultAlertRules are evaluated when no rules file is set
+func defaultAlertRules() []*models.AlertRule {
+	return []*models.AlertRule{
+		{Name: "drive-offline", Kind: models.AlertRuleKindDriveOffline, Severity: models.AlertRuleSeverityCritical},
+		{Name: "server-offline", Kind: models.AlertRuleKindServerOffline, Severity: models.AlertRuleSeverityCritical},
+		{Name: "bucket-quota", Kind: models.AlertRuleKindBucketQuota, Severity: models.AlertRuleSeverityWarning, Threshold: alertsDefaultQuotaThreshold},
+		{Name: "kms-unreachable", Kind: models.AlertRuleKindKmsUnreachable, Severity: models.AlertRuleSeverityCritical},
+		{Name: "tier-unreachable", Kind: models.AlertRuleKindTierUnreachable, Severity: models.AlertRuleSeverityWarning},
+	}
+}
+
+// parseAlertRules parses and validates the alert rules, filling in the default severity and quota threshold
+func parseAlertRules(data []byte) ([]*models.AlertRule, error) {
+	var rules alertRules
+	if err := decodeStrict(data, &rules); err != nil {
+		return nil, fmt.Errorf("invalid alert rules: %w", err)
+	}
+	names := map[string]bool{}
+	for idx, rule := range rules.Rules {
+		if rule == nil || rule.Name == "" {
+			return nil, fmt.Errorf("alert rule %d: the name is required", idx)
+		}
+		if names[rule.Name] {
+			return nil, fmt.Errorf("duplicate alert rule %s", rule.Name)
+		}
+		names[rule.Name] = true
+		if rule.Kind == "" {
+			return nil, fmt.Errorf("alert rule %s: the kind is required", rule.Name)
+		}
+		if rule.Severity == "" {
+			rule.Severity = models.AlertRuleSeverityWarning
+		}
+		if err := rule.Validate(strfmt.Default); err != nil {
+			return nil, fmt.Errorf("alert rule %s: %w", rule.Name, err)
+		}
+		switch rule.Kind {
+		case models.AlertRuleKindBucketQuota:
+			if rule.Threshold == 0 {
+				rule.Threshold = alertsDefaultQuotaThreshold
+			}
+			if rule.Threshold < 0 || rule.Threshold > 100 {
+				return nil, fmt.Errorf("alert rule %s: the threshold must be a percentage", rule.Name)
+			}
+		case models.AlertRuleKindPrometheus:
+			if rule.Query == "" {
+				return nil, fmt.Errorf("alert rule %s: the query is required", rule.Name)
+			}
+		}
+	}
+	return rules.Rules, nil
+}
+
+// loadAlertRules reads the rules file, the default rules are returned when there is none
+func loadAlertRules(path string) ([]*models.AlertRule, error) {
+	if path == "" {
+		return defaultAlertRules(), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseAlertRules(data)
+}
+
+// alertsState is what the engine remembers between evaluations
+type alertsState struct {
+	// Active are the firing alerts by fingerprint
+	Active map[string]*models.Alert `json:"active"`
+	// Notified keeps when a firing alert was last delivered to deliver it only once per repeat interval
+	Notified map[string]time.Time `json:"notified"`
+	History  []*models.Alert      `json:"history"`
+}
+
+func newAlertsState() *alertsState {
+	return &alertsState{Active: map[string]*models.Alert{}, Notified: map[string]time.Time{}}
+}
+
+// alertsSilences is the content of the silences object
+type alertsSilences struct {
+	Silences []*models.AlertSilence `json:"silences"`
+}
+
+// alertsStore persists the alerts state and the silences. The objects are signed with the console secret, so the
+// users allowed to write the system bucket can't silence alerts.
+type alertsStore interface {
+	load(ctx context.Context) (*alertsState, error)
+	save(ctx context.Context, state *alertsState) error
+	// loadSilences returns the silences along with the ETag to change them
+	loadSilences(ctx context.Context) ([]*models.AlertSilence, string, error)
+	// saveSilences replaces the silences unless they changed since they were loaded
+	saveSilences(ctx context.Context, silences []*models.AlertSilence, etag string) error
+}
+
+// bucketAlertsStore keeps the alerts state and the silences as signed JSON objects in the system bucket
+type bucketAlertsStore struct {
+	client *openstor.Client
+	bucket string
+}
+
+func (s *bucketAlertsStore) load(ctx context.Context) (*alertsState, error) {
+	data, _, err := getSignedSystemObject(ctx, s.client, s.bucket, alertsStateObject)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the alerts state: %w", err)
+	}
+	state := newAlertsState()
+	if data == nil {
+		return state, nil
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("unable to read the alerts state: %w", err)
+	}
+	if state.Active == nil {
+		state.Active = map[string]*models.Alert{}
+	}
+	if state.Notified == nil {
+		state.Notified = map[string]time.Time{}
+	}
+	return state, nil
+}
+
+func (s *bucketAlertsStore) save(ctx context.Context, state *alertsState) error {
+	rawState, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	_, err = putSignedSystemObject(ctx, s.client, s.bucket, alertsStateObject, rawState, systemObjectAnyVersion)
+	return err
+}
+
+func (s *bucketAlertsStore) loadSilences(ctx context.Context) ([]*models.AlertSilence, string, error) {
+	data, etag, err := getSignedSystemObject(ctx, s.client, s.bucket, alertsSilencesObject)
+	if err != nil || data == nil {
+		return nil, etag, err
+	}
+	var silences alertsSilences
+	if err := json.Unmarshal(data, &silences); err != nil {
+		return nil, etag, fmt.Errorf("unable to read the alert silences: %w", err)
+	}
+	return silences.Silences, etag, nil
+}
+
+func (s *bucketAlertsStore) saveSilences(ctx context.Context, silences []*models.AlertSilence, etag string) error {
+	data, err := json.Marshal(alertsSilences{Silences: silences})
+	if err != nil {
+		return err
+	}
+	_, err = putSignedSystemObject(ctx, s.client, s.bucket, alertsSilencesObject, data, etag)
+	return err
+}
+
+// alertNotifier delivers an alert outside the console, e.g. to a webhook or by email
+type alertNotifier func(ctx context.Context, alert *models.Alert) error
+
+// alertObservation is a subject a rule fires for
+type alertObservation struct {
+	subject string
+	message string
+}
+
+// alertEngine periodically evaluates the alert rules against the state of the cluster. An alert fires once per
+// rule and subject, it is delivered when it starts firing, again every repeat interval and when it resolves,
+// unless a silence matches it. The firing alerts are listed in the console.
+//
+// Every console evaluates the rules to list the alerts, only the leader delivers them so the replicas don't send
+// the same notification. The alerts are evaluated and delivered from the state kept in memory, the system bucket
+// only keeps a copy for the console taking over the leadership, so failing to read or write it doesn't stop the
+// alerts. The silences are shared by the consoles through the system bucket.
+type alertEngine struct {
+	client MinioAdmin
+	// bucketQuota returns the quota of a bucket, it isn't part of MinioAdmin
+	bucketQuota   func(ctx context.Context, bucket string) (madmin.BucketQuota, error)
+	store         alertsStore
+	rules         []*models.AlertRule
+	config        alertsConfig
+	notifiers     []alertNotifier
+	prometheusURL string
+	now           func() time.Time
+	// isLeader returns whether this console delivers the alerts, it always does when nil
+	isLeader func() bool
+
+	// cycle serializes the evaluations
+	cycle sync.Mutex
+	// leader is whether this console was the leader on the last evaluation
+	leader bool
+
+	mu       sync.Mutex
+	state    *alertsState
+	silences []*models.AlertSilence
+}
+
+// startAlertEngine starts evaluating the alert rules when they are enabled and the background credentials are
+// set, it returns the function stopping it
+func startAlertEngine() func() {
+	background := globalBackgroundService
+	if background == nil || !getAlertsEnabled() {
+		return func() {}
+	}
+	// the silences are signed objects, without the console secret they would be lost on restart
+	if !systemObjectKeyConfigured() {
+		LogError("unable to start the alert engine: %v", errSystemObjectKeyNotSet)
+		return func() {}
+	}
+	rules, err := loadAlertRules(getAlertsRulesFile())
+	if err != nil {
+		LogError("unable to start the alert engine: %v", err)
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	adminClient := background.admin
+	config := getAlertsConfig()
+	engine := &alertEngine{
+		client:        adminClient,
+		bucketQuota:   adminClient.getBucketQuota,
+		store:         &bucketAlertsStore{client: background.client, bucket: getSystemBucket()},
+		rules:         rules,
+		config:        config,
+		prometheusURL: getPrometheusURL(),
+		now:           time.Now,
+		isLeader:      background.isLeader,
+	}
+	if config.webhookURL != "" {
+		engine.notifiers = append(engine.notifiers, func(ctx context.Context, alert *models.Alert) error {
+			return postWebhook(ctx, config.webhookURL, config.webhookAuthToken, alert)
+		})
+	}
+	if config.smtpAddress != "" && len(config.smtpTo) > 0 {
+		engine.notifiers = append(engine.notifiers, func(ctx context.Context, alert *models.Alert) error {
+			return sendAlertEmail(ctx, config, alert)
+		})
+	}
+	globalAlertEngine = engine
+	go engine.run(ctx)
+	return cancel
+}
+
+func (e *alertEngine) run(ctx context.Context) {
+	ticker := time.NewTicker(e.config.interval)
+	defer ticker.Stop()
+	for {
+		if err := e.evaluate(ctx); err != nil && ctx.Err() == nil {
+			LogError("alert engine: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// evaluate evaluates every rule, raises the alerts starting to fire and resolves the ones no longer firing. The
+// alerts of a rule that can't be evaluated are left as they are. The state is only saved by the leader and
+// failing to save it is reported once the alerts are delivered.
+func (e *alertEngine) evaluate(ctx context.Context) error {
+	e.cycle.Lock()
+	defer e.cycle.Unlock()
+	leader := e.isLeader == nil || e.isLeader()
+	if leader && !e.leader {
+		e.restore(ctx)
+	}
+	e.leader = leader
+	e.refreshSilences(ctx)
+
+	// the server info is shared by the rules needing it
+	var (
+		info    *madmin.InfoMessage
+		infoErr error
+	)
+	serverInfo := func() (*madmin.InfoMessage, error) {
+		if info == nil && infoErr == nil {
+			var i madmin.InfoMessage
+			i, infoErr = e.client.serverInfo(ctx)
+			info = &i
+		}
+		return info, infoErr
+	}
+	observations := map[string][]alertObservation{}
+	for _, rule := range e.rules {
+		ruleObservations, err := e.observe(ctx, rule, serverInfo)
+		if err != nil {
+			LogError("alert engine: unable to evaluate rule %s: %v", rule.Name, err)
+			continue
+		}
+		observations[rule.Name] = ruleObservations
+	}
+
+	notifications := e.apply(observations, leader)
+	for _, alert := range notifications {
+		e.deliver(ctx, alert)
+	}
+	if !leader {
+		return nil
+	}
+	e.mu.Lock()
+	rawState, err := json.Marshal(e.state)
+	e.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	state := newAlertsState()
+	if err := json.Unmarshal(rawState, state); err != nil {
+		return err
+	}
+	if err := e.store.save(ctx, state); err != nil {
+		return fmt.Errorf("unable to save the alerts state: %w", err)
+	}
+	return nil
+}
+
+// apply updates the alerts with the observations of the rules evaluated and returns the alerts to deliver, the
+// alerts are only marked as delivered when this console delivers them
+func (e *alertEngine) apply(observations map[string][]alertObservation, deliver bool) []*models.Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.state == nil {
+		e.state = newAlertsState()
+	}
+	state := e.state
+	now := e.now().UTC()
+	e.silences = activeAlertSilences(e.silences, now)
+
+	var notifications []*models.Alert
+	known := map[string]bool{}
+	firing := map[string]bool{}
+	for _, rule := range e.rules {
+		known[rule.Name] = true
+		for _, o := range observations[rule.Name] {
+			fingerprint := rule.Name + "/" + o.subject
+			firing[fingerprint] = true
+			alert, ok := state.Active[fingerprint]
+			if !ok {
+				alert = &models.Alert{
+					ID:       alertID(fingerprint, now),
+					Rule:     rule.Name,
+					Kind:     rule.Kind,
+					Severity: rule.Severity,
+					Subject:  o.subject,
+					Status:   models.AlertStatusFiring,
+					StartsAt: now.Format(time.RFC3339),
+				}
+				state.Active[fingerprint] = alert
+			}
+			alert.Message = o.message
+			alert.Silenced = alertSilenced(e.silences, alert, now)
+			if alert.Silenced || !deliver {
+				continue
+			}
+			last, notified := state.Notified[fingerprint]
+			if !notified || (e.config.repeatInterval > 0 && now.Sub(last) >= e.config.repeatInterval) {
+				state.Notified[fingerprint] = now
+				a := *alert
+				notifications = append(notifications, &a)
+			}
+		}
+	}
+
+	for _, fingerprint := range sortedKeys(state.Active) {
+		alert := state.Active[fingerprint]
+		_, evaluated := observations[alert.Rule]
+		if firing[fingerprint] || (known[alert.Rule] && !evaluated) {
+			continue
+		}
+		alert.Status = models.AlertStatusResolved
+		alert.EndsAt = now.Format(time.RFC3339)
+		alert.Silenced = alertSilenced(e.silences, alert, now)
+		// the alerts of the removed rules resolve silently
+		if _, notified := state.Notified[fingerprint]; notified && deliver && known[alert.Rule] && !alert.Silenced {
+			a := *alert
+			notifications = append(notifications, &a)
+		}
+		delete(state.Active, fingerprint)
+		delete(state.Notified, fingerprint)
+		state.History = append(state.History, alert)
+	}
+	if len(state.History) > alertsMaxHistory {
+		state.History = state.History[len(state.History)-alertsMaxHistory:]
+	}
+	return notifications
+}
+
+// restore reads the state saved by the previous leader when this console takes over, so the alerts it already
+// delivered aren't delivered again. The state kept in memory is used when it can't be read.
+func (e *alertEngine) restore(ctx context.Context) {
+	saved, err := e.store.load(ctx)
+	if err != nil {
+		LogError("alert engine: %v", err)
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.state == nil {
+		e.state = newAlertsState()
+	}
+	for fingerprint, alert := range saved.Active {
+		if _, ok := e.state.Active[fingerprint]; !ok {
+			e.state.Active[fingerprint] = alert
+		}
+	}
+	for fingerprint, notified := range saved.Notified {
+		if last, ok := e.state.Notified[fingerprint]; !ok || notified.After(last) {
+			e.state.Notified[fingerprint] = notified
+		}
+	}
+	if len(e.state.History) == 0 {
+		e.state.History = saved.History
+	}
+}
+
+// refreshSilences reads the silences shared by the consoles, the silences known are kept when they can't be read
+func (e *alertEngine) refreshSilences(ctx context.Context) {
+	silences, _, err := e.store.loadSilences(ctx)
+	if err != nil {
+		LogError("alert engine: unable to read the silences: %v", err)
+		return
+	}
+	e.mu.Lock()
+	e.silences = activeAlertSilences(silences, e.now().UTC())
+	e.mu.Unlock()
+}
+
+// observe returns the subjects a rule fires for
+func (e *alertEngine) observe(ctx context.Context, rule *models.AlertRule, serverInfo func() (*madmin.InfoMessage, error)) ([]alertObservation, error) {
+	var observations []alertObservation
+	switch rule.Kind {
+	case models.AlertRuleKindServerOffline:
+		info, err := serverInfo()
+		if err != nil {
+			return nil, err
+		}
+		for _, server := range info.Servers {
+			if server.State != string(madmin.ItemOnline) {
+				observations = append(observations, alertObservation{
+					subject: server.Endpoint,
+					message: fmt.Sprintf("server %s is %s", server.Endpoint, server.State),
+				})
+			}
+		}
+	case models.AlertRuleKindDriveOffline:
+		info, err := serverInfo()
+		if err != nil {
+			return nil, err
+		}
+		for _, server := range info.Servers {
+			for _, disk := range server.Disks {
+				if disk.State != madmin.DriveStateOk && disk.State != madmin.DriveStateUnformatted {
+					subject := server.Endpoint + disk.DrivePath
+					observations = append(observations, alertObservation{
+						subject: subject,
+						message: fmt.Sprintf("drive %s is %s", subject, disk.State),
+					})
+				}
+			}
+		}
+	case models.AlertRuleKindBucketQuota:
+		accountInfo, err := e.client.AccountInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, bucket := range accountInfo.Buckets {
+			quota, err := e.bucketQuota(ctx, bucket.Name)
+			if err != nil {
+				// the buckets without a quota have no quota configuration, a bucket failing doesn't stop the others
+				if madmin.ToErrorResponse(err).Code != "XMinioAdminBucketQuotaConfigNotFound" {
+					LogError("alert engine: unable to get the quota of bucket %s: %v", bucket.Name, err)
+				}
+				continue
+			}
+			if quota.Size == 0 {
+				continue
+			}
+			usage := float64(bucket.Size) * 100 / float64(quota.Size)
+			if usage >= rule.Threshold {
+				observations = append(observations, alertObservation{
+					subject: bucket.Name,
+					message: fmt.Sprintf("bucket %s uses %.1f%% of its quota", bucket.Name, usage),
+				})
+			}
+		}
+	case models.AlertRuleKindKmsUnreachable:
+		status, err := e.client.kmsStatus(ctx)
+		if err != nil {
+			// the deployment doesn't use a KMS
+			if madmin.ToErrorResponse(err).Code == "NotImplemented" {
+				return nil, nil
+			}
+			return []alertObservation{{subject: "kms", message: fmt.Sprintf("KMS is unreachable: %v", err)}}, nil
+		}
+		for _, endpoint := range sortedKeys(status.Endpoints) {
+			if status.Endpoints[endpoint] != madmin.ItemOnline {
+				observations = append(observations, alertObservation{
+					subject: endpoint,
+					message: fmt.Sprintf("KMS endpoint %s is %s", endpoint, status.Endpoints[endpoint]),
+				})
+			}
+		}
+	case models.AlertRuleKindTierUnreachable:
+		tiers, err := e.client.tierStats(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, tier := range tiers {
+			// the hot tier is the deployment itself
+			if tier.Name == "STANDARD" {
+				continue
+			}
+			if err := e.client.verifyTierStatus(ctx, tier.Name); err != nil {
+				observations = append(observations, alertObservation{
+					subject: tier.Name,
+					message: fmt.Sprintf("tier %s is unreachable: %v", tier.Name, err),
+				})
+			}
+		}
+	case models.AlertRuleKindPrometheus:
+		return e.observePrometheus(ctx, rule)
+	}
+	return observations, nil
+}
+
+// promVectorResp is the response of the Prometheus instant queries
+type promVectorResp struct {
+	Data struct {
+		Result []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// observePrometheus fires for every series of the query whose value is above the threshold
+func (e *alertEngine) observePrometheus(ctx context.Context, rule *models.AlertRule) ([]alertObservation, error) {
+	if e.prometheusURL == "" {
+		return nil, errors.New("prometheus isn't configured")
+	}
+	endpoint := fmt.Sprintf("%s/api/v1/query?query=%s", e.prometheusURL, url.QueryEscape(rule.Query))
+	var response promVectorResp
+	if err := fetchPrometheus(ctx, GetConsoleHTTPClient(""), endpoint, &response); err != nil {
+		return nil, err
+	}
+	var observations []alertObservation
+	for _, series := range response.Data.Result {
+		if len(series.Value) != 2 {
+			continue
+		}
+		raw, _ := series.Value[1].(string)
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil || value <= rule.Threshold {
+			continue
+		}
+		labels := make([]string, 0, len(series.Metric))
+		for _, name := range sortedKeys(series.Metric) {
+			labels = append(labels, fmt.Sprintf("%s=%q", name, series.Metric[name]))
+		}
+		subject := "{" + strings.Join(labels, ",") + "}"
+		observations = append(observations, alertObservation{
+			subject: subject,
+			message: fmt.Sprintf("%s is %s, above %s", subject, raw, strconv.FormatFloat(rule.Threshold, 'f', -1, 64)),
+		})
+	}
+	return observations, nil
+}
+
+// deliver sends the alert to every notifier, failing to deliver it is logged
+func (e *alertEngine) deliver(ctx context.Context, alert *models.Alert) {
+	for _, notify := range e.notifiers {
+		if err := notify(ctx, alert); err != nil {
+			LogError("alert engine: unable to deliver alert %s: %v", alert.ID, err)
+		}
+	}
+}
+
+// alertID identifies an occurrence of an alert
+func alertID(fingerprint string, startsAt time.Time) string {
+	sum := sha256.Sum256([]byte(fingerprint + "@" + startsAt.Format(time.RFC3339Nano)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// alertSilenced tells whether an active silence matches the alert
+func alertSilenced(silences []*models.AlertSilence, alert *models.Alert, now time.Time) bool {
+	for _, silence := range silences {
+		if silence.Rule != "" && silence.Rule != alert.Rule {
+			continue
+		}
+		startsAt, _ := time.Parse(time.RFC3339, silence.StartsAt)
+		endsAt, _ := time.Parse(time.RFC3339, silence.EndsAt)
+		if now.Before(startsAt) || !now.Before(endsAt) {
+			continue
+		}
+		pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(silence.Subject), `\*`, ".*") + "$"
+		if matched, _ := regexp.MatchString(pattern, alert.Subject); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// activeAlertSilences returns the silences not expired yet
+func activeAlertSilences(silences []*models.AlertSilence, now time.Time) []*models.AlertSilence {
+	var active []*models.AlertSilence
+	for _, silence := range silences {
+		endsAt, _ := time.Parse(time.RFC3339, silence.EndsAt)
+		if now.Before(endsAt) {
+			active = append(active, silence)
+		}
+	}
+	return active
+}
+
+// updateSilences applies a change to the silences shared by the consoles and marks the firing alerts they match.
+// Silences not written by a console are dropped, the change is retried when another console changed the
+// silences concurrently.
+func (e *alertEngine) updateSilences(ctx context.Context, change func(silences []*models.AlertSilence) ([]*models.AlertSilence, error)) error {
+	for attempt := 0; ; attempt++ {
+		silences, etag, err := e.store.loadSilences(ctx)
+		if errors.Is(err, errSystemObjectSignature) {
+			LogError("alert engine: dropping the silences: %v", err)
+			silences, err = nil, nil
+		}
+		if err != nil {
+			return err
+		}
+		now := e.now().UTC()
+		silences, err = change(activeAlertSilences(silences, now))
+		if err != nil {
+			return err
+		}
+		err = e.store.saveSilences(ctx, silences, etag)
+		if errors.Is(err, errSystemObjectChanged) && attempt < alertsSilencesRetries {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		e.mu.Lock()
+		e.silences = silences
+		if e.state != nil {
+			for _, alert := range e.state.Active {
+				alert.Silenced = alertSilenced(silences, alert, now)
+			}
+		}
+		e.mu.Unlock()
+		return nil
+	}
+}
+
+// list returns the firing alerts by severity then newest first, the resolved alerts newest first and the
+// active silences
+func (e *alertEngine) list() *models.AlertsResponse {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	response := &models.AlertsResponse{
+		Enabled:  true,
+		Alerts:   []*models.Alert{},
+		History:  []*models.Alert{},
+		Rules:    e.rules,
+		Silences: []*models.AlertSilence{},
+	}
+	if e.state == nil {
+		return response
+	}
+	severities := map[string]int{models.AlertRuleSeverityCritical: 0, models.AlertRuleSeverityWarning: 1, models.AlertRuleSeverityInfo: 2}
+	for _, alert := range e.state.Active {
+		a := *alert
+		response.Alerts = append(response.Alerts, &a)
+	}
+	sort.Slice(response.Alerts, func(i, j int) bool {
+		if response.Alerts[i].Severity != response.Alerts[j].Severity {
+			return severities[response.Alerts[i].Severity] < severities[response.Alerts[j].Severity]
+		}
+		if response.Alerts[i].StartsAt != response.Alerts[j].StartsAt {
+			return response.Alerts[i].StartsAt > response.Alerts[j].StartsAt
+		}
+		return response.Alerts[i].Subject < response.Alerts[j].Subject
+	})
+	for i := len(e.state.History) - 1; i >= 0; i-- {
+		a := *e.state.History[i]
+		response.History = append(response.History, &a)
+	}
+	for _, silence := range activeAlertSilences(e.silences, e.now().UTC()) {
+		s := *silence
+		response.Silences = append(response.Silences, &s)
+	}
+	return response
+}
+
+// sendAlertEmail mails the alert to the configured recipients, upgrading the connection to TLS when the server
+// supports it
+func sendAlertEmail(ctx context.Context, config alertsConfig, alert *models.Alert) error {
+	host, _, err := net.SplitHostPort(config.smtpAddress)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", config.smtpAddress)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if config.smtpUsername != "" {
+		if err := client.Auth(smtp.PlainAuth("", config.smtpUsername, config.smtpPassword, host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(config.smtpFrom); err != nil {
+		return err
+	}
+	for _, to := range config.smtpTo {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(alertEmailMessage(config, alert)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// alertEmailMessage formats the alert as a plain text email
+func alertEmailMessage(config alertsConfig, alert *models.Alert) []byte {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", config.smtpFrom)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(config.smtpTo, ", "))
+	fmt.Fprintf(&msg, "Subject: [%s] %s: %s %s\r\n", strings.ToUpper(alert.Status), alert.Severity, alert.Rule, alert.Subject)
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s\r\n\r\nRule: %s\r\nSeverity: %s\r\nStarted: %s\r\n", alert.Message, alert.Rule, alert.Severity, alert.StartsAt)
+	if alert.EndsAt != "" {
+		fmt.Fprintf(&msg, "Resolved: %s\r\n", alert.EndsAt)
+	}
+	return msg.Bytes()
+}
+
+func getListAlertsResponse(session *models.Principal, params systemApi.ListAlertsParams) (*models.AlertsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if apiErr := checkConsolePermission(ctx, session, iampolicy.ServerInfoAdminAction); apiErr != nil {
+		return nil, apiErr
+	}
+	if globalAlertEngine == nil {
+		return &models.AlertsResponse{Alerts: []*models.Alert{}, History: []*models.Alert{}, Rules: []*models.AlertRule{}, Silences: []*models.AlertSilence{}}, nil
+	}
+	return globalAlertEngine.list(), nil
+}
+
+func getCreateAlertSilenceResponse(session *models.Principal, params systemApi.CreateAlertSilenceParams) (*models.AlertSilence, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if apiErr := checkConsolePermission(ctx, session, iampolicy.ConfigUpdateAdminAction); apiErr != nil {
+		return nil, apiErr
+	}
+	if globalAlertEngine == nil {
+		return nil, errAlertsNotConfigured
+	}
+	silence, err := globalAlertEngine.newSilence(params.Body, getSessionIdentity(session))
+	if err != nil {
+		return nil, &CodedAPIError{
+			Code: 400,
+			APIError: &models.APIError{
+				Message:         ErrBadRequest.Error(),
+				DetailedMessage: err.Error(),
+			},
+		}
+	}
+	if err := globalAlertEngine.updateSilences(ctx, func(silences []*models.AlertSilence) ([]*models.AlertSilence, error) {
+		return append(silences, silence), nil
+	}); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return silence, nil
+}
+
+// newSilence validates a silence request, the silence starts now
+func (e *alertEngine) newSilence(req *models.AlertSilenceRequest, createdBy string) (*models.AlertSilence, error) {
+	duration, err := time.ParseDuration(swag.StringValue(req.Duration))
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration: %w", err)
+	}
+	if duration <= 0 {
+		return nil, errors.New("the duration must be positive")
+	}
+	if req.Rule != "" {
+		var known bool
+		for _, rule := range e.rules {
+			if rule.Name == req.Rule {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown alert rule %s", req.Rule)
+		}
+	}
+	subject := req.Subject
+	if subject == "" {
+		subject = "*"
+	}
+	now := e.now().UTC()
+	return &models.AlertSilence{
+		ID:        alertID(req.Rule+"/"+subject+"/"+createdBy, now),
+		Rule:      req.Rule,
+		Subject:   subject,
+		Comment:   req.Comment,
+		CreatedBy: createdBy,
+		StartsAt:  now.Format(time.RFC3339),
+		EndsAt:    now.Add(duration).Format(time.RFC3339),
+	}, nil
+}
+
+func getDeleteAlertSilenceResponse(session *models.Principal, params systemApi.DeleteAlertSilenceParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if apiErr := checkConsolePermission(ctx, session, iampolicy.ConfigUpdateAdminAction); apiErr != nil {
+		return apiErr
+	}
+	if globalAlertEngine == nil {
+		return errAlertsNotConfigured
+	}
+	errSilenceNotFound := errors.New("silence not found")
+	err := globalAlertEngine.updateSilences(ctx, func(silences []*models.AlertSilence) ([]*models.AlertSilence, error) {
+		for idx, silence := range silences {
+			if silence.ID == params.ID {
+				return append(silences[:idx], silences[idx+1:]...), nil
+			}
+		}
+		return nil, errSilenceNotFound
+	})
+	if errors.Is(err, errSilenceNotFound) {
+		return &CodedAPIError{Code: 404, APIError: &models.APIError{Message: "Silence not found"}}
+	}
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
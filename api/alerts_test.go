@@ -0,0 +1,336 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAlertRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []*models.AlertRule
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			data: "rules:\n  - name: quota\n    kind: bucketQuota\n",
+			want: []*models.AlertRule{{Name: "quota", Kind: models.AlertRuleKindBucketQuota, Severity: models.AlertRuleSeverityWarning, Threshold: 90}},
+		},
+		{
+			name: "prometheus",
+			data: `{"rules":[{"name":"latency","kind":"prometheus","severity":"critical","query":"up == 0"}]}`,
+			want: []*models.AlertRule{{Name: "latency", Kind: models.AlertRuleKindPrometheus, Severity: models.AlertRuleSeverityCritical, Query: "up == 0"}},
+		},
+		{name: "unknown field", data: "rules:\n  - name: quota\n    kind: bucketQuota\n    bucket: photos\n", wantErr: "field bucket not found"},
+		{name: "missing name", data: "rules:\n  - kind: bucketQuota\n", wantErr: "the name is required"},
+		{name: "duplicate name", data: "rules:\n  - {name: a, kind: driveOffline}\n  - {name: a, kind: serverOffline}\n", wantErr: "duplicate alert rule a"},
+		{name: "invalid kind", data: "rules:\n  - {name: a, kind: cpu}\n", wantErr: "kind"},
+		{name: "invalid severity", data: "rules:\n  - {name: a, kind: driveOffline, severity: page}\n", wantErr: "severity"},
+		{name: "invalid threshold", data: "rules:\n  - {name: a, kind: bucketQuota, threshold: 120}\n", wantErr: "percentage"},
+		{name: "missing query", data: "rules:\n  - {name: a, kind: prometheus}\n", wantErr: "the query is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := parseAlertRules([]byte(tt.data))
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, rules)
+		})
+	}
+}
+
+// alertsStoreMock keeps the state serialized and changes the silences conditionally on their ETag, as the system
+// bucket does
+type alertsStoreMock struct {
+	raw      []byte
+	silences []byte
+	etag     string
+	version  int
+	// changed is called before the silences are saved, to change them concurrently
+	changed func()
+	err     error
+}
+
+func (s *alertsStoreMock) load(_ context.Context) (*alertsState, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	state := newAlertsState()
+	if s.raw == nil {
+		return state, nil
+	}
+	err := json.Unmarshal(s.raw, state)
+	return state, err
+}
+
+func (s *alertsStoreMock) save(_ context.Context, state *alertsState) error {
+	if s.err != nil {
+		return s.err
+	}
+	raw, err := json.Marshal(state)
+	s.raw = raw
+	return err
+}
+
+func (s *alertsStoreMock) loadSilences(_ context.Context) ([]*models.AlertSilence, string, error) {
+	if s.err != nil {
+		return nil, "", s.err
+	}
+	if s.silences == nil {
+		return nil, s.etag, nil
+	}
+	var silences alertsSilences
+	err := json.Unmarshal(s.silences, &silences)
+	return silences.Silences, s.etag, err
+}
+
+func (s *alertsStoreMock) saveSilences(_ context.Context, silences []*models.AlertSilence, etag string) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.changed != nil {
+		changed := s.changed
+		s.changed = nil
+		changed()
+	}
+	if etag != s.etag {
+		return errSystemObjectChanged
+	}
+	raw, err := json.Marshal(alertsSilences{Silences: silences})
+	if err != nil {
+		return err
+	}
+	s.version++
+	s.silences = raw
+	s.etag = fmt.Sprintf("etag-%d", s.version)
+	return nil
+}
+
+func TestAlertEngine(t *testing.T) {
+	funcAssert := assert.New(t)
+	driveState := madmin.DriveStateOffline
+	MinioServerInfoMock = func(_ context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{{
+			State:    string(madmin.ItemOnline),
+			Endpoint: "node1:9000",
+			Disks:    []madmin.Disk{{DrivePath: "/data1", State: driveState}, {DrivePath: "/data2", State: madmin.DriveStateOk}},
+		}}}, nil
+	}
+	minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{
+			{Name: "broken", Size: 1000}, {Name: "photos", Size: 95}, {Name: "docs", Size: 10}, {Name: "logs", Size: 1000},
+		}}, nil
+	}
+	minioTierStatsMock = func(_ context.Context) ([]madmin.TierInfo, error) {
+		return []madmin.TierInfo{{Name: "STANDARD"}, {Name: "COLD"}}, nil
+	}
+	tierErr := errors.New("connection refused")
+	minioVerifyTierStatusMock = func(_ context.Context, _ string) error {
+		return tierErr
+	}
+	quotas := map[string]uint64{"photos": 100, "docs": 100}
+
+	var delivered []string
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	store := &alertsStoreMock{}
+	engine := &alertEngine{
+		client: AdminClientMock{},
+		bucketQuota: func(_ context.Context, bucket string) (madmin.BucketQuota, error) {
+			// MinIO fails for the buckets without a quota
+			quota, ok := quotas[bucket]
+			switch {
+			case bucket == "broken":
+				return madmin.BucketQuota{}, errors.New("connection refused")
+			case !ok:
+				return madmin.BucketQuota{}, madmin.ErrorResponse{Code: "XMinioAdminBucketQuotaConfigNotFound", Message: "No quota config found"}
+			}
+			return madmin.BucketQuota{Size: quota}, nil
+		},
+		store:  store,
+		rules:  defaultAlertRules(),
+		config: alertsConfig{repeatInterval: time.Hour},
+		notifiers: []alertNotifier{func(_ context.Context, alert *models.Alert) error {
+			delivered = append(delivered, alert.Status+" "+alert.Rule+" "+alert.Subject)
+			return nil
+		}},
+		now: func() time.Time { return now },
+	}
+
+	// Test-1: the offline drive, the bucket above its quota and the unreachable tier fire
+	funcAssert.NoError(engine.evaluate(context.Background()))
+	funcAssert.ElementsMatch([]string{
+		"firing drive-offline node1:9000/data1",
+		"firing bucket-quota photos",
+		"firing tier-unreachable COLD",
+	}, delivered)
+	response := engine.list()
+	if funcAssert.Len(response.Alerts, 3) {
+		funcAssert.Equal("drive-offline", response.Alerts[0].Rule)
+		funcAssert.Equal(models.AlertStatusFiring, response.Alerts[0].Status)
+		funcAssert.Equal("bucket photos uses 95.0% of its quota", response.Alerts[2].Message)
+	}
+
+	// Test-2: the firing alerts are delivered once per repeat interval
+	delivered = nil
+	now = now.Add(time.Minute)
+	funcAssert.NoError(engine.evaluate(context.Background()))
+	funcAssert.Empty(delivered)
+
+	// Test-3: a silenced alert isn't delivered, the silences can be scoped to a subject
+	silence, err := engine.newSilence(&models.AlertSilenceRequest{Rule: "tier-unreachable", Subject: "CO*", Duration: swag.String("2h")}, "admin")
+	funcAssert.NoError(err)
+	funcAssert.NoError(engine.updateSilences(context.Background(), func(silences []*models.AlertSilence) ([]*models.AlertSilence, error) {
+		return append(silences, silence), nil
+	}))
+	now = now.Add(time.Hour)
+	funcAssert.NoError(engine.evaluate(context.Background()))
+	funcAssert.ElementsMatch([]string{
+		"firing drive-offline node1:9000/data1",
+		"firing bucket-quota photos",
+	}, delivered)
+	response = engine.list()
+	funcAssert.Len(response.Silences, 1)
+	for _, alert := range response.Alerts {
+		funcAssert.Equal(alert.Rule == "tier-unreachable", alert.Silenced)
+	}
+	_, err = engine.newSilence(&models.AlertSilenceRequest{Rule: "cpu", Duration: swag.String("2h")}, "admin")
+	funcAssert.Error(err)
+	_, err = engine.newSilence(&models.AlertSilenceRequest{Duration: swag.String("-1h")}, "admin")
+	funcAssert.Error(err)
+
+	// Test-4: the alerts no longer firing resolve, the rules that can't be evaluated keep theirs
+	delivered = nil
+	driveState = madmin.DriveStateOk
+	minioTierStatsMock = func(_ context.Context) ([]madmin.TierInfo, error) {
+		return nil, errors.New("tiering unavailable")
+	}
+	now = now.Add(time.Minute)
+	funcAssert.NoError(engine.evaluate(context.Background()))
+	funcAssert.Equal([]string{"resolved drive-offline node1:9000/data1"}, delivered)
+	response = engine.list()
+	funcAssert.Len(response.Alerts, 2)
+	if funcAssert.Len(response.History, 1) {
+		funcAssert.Equal(models.AlertStatusResolved, response.History[0].Status)
+		funcAssert.NotEmpty(response.History[0].EndsAt)
+	}
+
+	// Test-5: the silences expire
+	now = now.Add(2 * time.Hour)
+	funcAssert.NoError(engine.evaluate(context.Background()))
+	funcAssert.Empty(engine.list().Silences)
+}
+
+func TestAlertEngineReplicas(t *testing.T) {
+	funcAssert := assert.New(t)
+	MinioServerInfoMock = func(_ context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{{State: string(madmin.ItemOffline), Endpoint: "node1:9000"}}}, nil
+	}
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	rules := []*models.AlertRule{{Name: "server-offline", Kind: models.AlertRuleKindServerOffline, Severity: models.AlertRuleSeverityCritical}}
+	newEngine := func(store alertsStore, leader *bool, delivered *[]string) *alertEngine {
+		return &alertEngine{
+			client: AdminClientMock{},
+			store:  store,
+			rules:  rules,
+			config: alertsConfig{repeatInterval: time.Hour},
+			notifiers: []alertNotifier{func(_ context.Context, alert *models.Alert) error {
+				*delivered = append(*delivered, alert.Status+" "+alert.Rule+" "+alert.Subject)
+				return nil
+			}},
+			isLeader: func() bool { return *leader },
+			now:      func() time.Time { return now },
+		}
+	}
+
+	// Test-1: the alerts are delivered when the store is unavailable, and aren't delivered again on the next cycle
+	var delivered []string
+	leader := true
+	store := &alertsStoreMock{err: errors.New("bucket unavailable")}
+	engine := newEngine(store, &leader, &delivered)
+	funcAssert.ErrorContains(engine.evaluate(context.Background()), "bucket unavailable")
+	funcAssert.Equal([]string{"firing server-offline node1:9000"}, delivered)
+	now = now.Add(time.Minute)
+	funcAssert.Error(engine.evaluate(context.Background()))
+	funcAssert.Equal([]string{"firing server-offline node1:9000"}, delivered)
+	funcAssert.Len(engine.list().Alerts, 1)
+
+	// Test-2: a console that isn't the leader lists the alerts without delivering them
+	var followerDelivered []string
+	follower := false
+	store = &alertsStoreMock{}
+	followerEngine := newEngine(store, &follower, &followerDelivered)
+	funcAssert.NoError(followerEngine.evaluate(context.Background()))
+	funcAssert.Empty(followerDelivered)
+	funcAssert.Len(followerEngine.list().Alerts, 1)
+	funcAssert.Nil(store.raw)
+
+	// Test-3: the leader saves what it delivered, so the console taking over doesn't deliver it again
+	delivered = nil
+	engine = newEngine(store, &leader, &delivered)
+	funcAssert.NoError(engine.evaluate(context.Background()))
+	funcAssert.Equal([]string{"firing server-offline node1:9000"}, delivered)
+	follower = true
+	now = now.Add(time.Minute)
+	funcAssert.NoError(followerEngine.evaluate(context.Background()))
+	funcAssert.Empty(followerDelivered)
+
+	// Test-4: a silence created while another console changed the silences is retried and keeps both
+	first, err := engine.newSilence(&models.AlertSilenceRequest{Rule: "server-offline", Duration: swag.String("1h")}, "admin")
+	funcAssert.NoError(err)
+	second, err := followerEngine.newSilence(&models.AlertSilenceRequest{Subject: "node2*", Duration: swag.String("1h")}, "admin")
+	funcAssert.NoError(err)
+	store.changed = func() {
+		funcAssert.NoError(followerEngine.updateSilences(context.Background(), func(silences []*models.AlertSilence) ([]*models.AlertSilence, error) {
+			return append(silences, second), nil
+		}))
+	}
+	funcAssert.NoError(engine.updateSilences(context.Background(), func(silences []*models.AlertSilence) ([]*models.AlertSilence, error) {
+		return append(silences, first), nil
+	}))
+	funcAssert.Len(engine.list().Silences, 2)
+	now = now.Add(time.Minute)
+	funcAssert.NoError(followerEngine.evaluate(context.Background()))
+	funcAssert.Len(followerEngine.list().Silences, 2)
+	funcAssert.True(followerEngine.list().Alerts[0].Silenced)
+}
+
+func TestAlertEnginePrometheus(t *testing.T) {
+	funcAssert := assert.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		funcAssert.Equal("/api/v1/query", r.URL.Path)
+		w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"server":"node1:9000"},"value":[1740830400,"2.5"]},
+			{"metric":{"server":"node2:9000"},"value":[1740830400,"0.2"]}]}}`))
+	}))
+	defer server.Close()
+
+	engine := &alertEngine{prometheusURL: server.URL}
+	observations, err := engine.observe(context.Background(), &models.AlertRule{Name: "latency", Kind: models.AlertRuleKindPrometheus, Query: "latency", Threshold: 1}, nil)
+	funcAssert.NoError(err)
+	funcAssert.Equal([]alertObservation{{subject: `{server="node1:9000"}`, message: `{server="node1:9000"} is 2.5, above 1`}}, observations)
+
+	engine.prometheusURL = ""
+	_, err = engine.observe(context.Background(), &models.AlertRule{Name: "latency", Kind: models.AlertRuleKindPrometheus, Query: "latency"}, nil)
+	funcAssert.Error(err)
+}
@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/openstor-go/v7"
+)
+
+// backgroundLeaseObject is the object of the system bucket electing the console running the background jobs
+const backgroundLeaseObject = "leader/lease.json"
+
+// globalBackgroundService is the identity the background jobs run with, nil when its credentials aren't set
+var globalBackgroundService *backgroundService
+
+// backgroundService holds the clients of the background jobs of the console: the service account scheduler,
+// the expired users reaper, the server info sampler and the alert engine. They share one set of credentials
+// and one leader lease, so the jobs acting on the deployment only run on one console when it has replicas.
+type backgroundService struct {
+	admin  AdminClient
+	client *openstor.Client
+	leader *leaderLease
+}
+
+// startBackgroundService creates the clients of the background jobs and starts the election of the leader when
+// the background credentials are set, it returns the function stopping it
+func startBackgroundService() func() {
+	accessKey, secretKey := getBackgroundCredentials()
+	if accessKey == "" || secretKey == "" {
+		return func() {}
+	}
+	session := &models.Principal{STSAccessKeyID: accessKey, STSSecretAccessKey: secretKey}
+	ctx, cancel := context.WithCancel(context.Background())
+	mAdmin, err := NewMinioAdminClient(ctx, session)
+	if err != nil {
+		LogError("unable to start the background jobs: %v", err)
+		cancel()
+		return func() {}
+	}
+	mClient, err := newMinioClient(session, "")
+	if err != nil {
+		LogError("unable to start the background jobs: %v", err)
+		cancel()
+		return func() {}
+	}
+	leader := newLeaderLease(&bucketLeaderLeaseStore{client: mClient, bucket: getSystemBucket()}, getBackgroundLeaseDuration())
+	globalBackgroundService = &backgroundService{
+		admin:  AdminClient{Client: mAdmin},
+		client: mClient,
+		leader: leader,
+	}
+	// the lease is a signed object, without the console secret no console is elected and the jobs acting on the
+	// deployment don't run
+	if !systemObjectKeyConfigured() {
+		LogError("leader lease: %v", errSystemObjectKeyNotSet)
+		return cancel
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		leader.run(ctx)
+	}()
+	return func() {
+		cancel()
+		<-done
+		releaseCtx, releaseCancel := context.WithTimeout(context.Background(), webhookTimeout)
+		defer releaseCancel()
+		leader.release(releaseCtx)
+	}
+}
+
+// isLeader returns whether this console runs the background jobs acting on the deployment
+func (b *backgroundService) isLeader() bool {
+	return b != nil && b.leader.isLeader()
+}
+
+// leaderLeaseRecord is the content of the lease object
+type leaderLeaseRecord struct {
+	Owner     string    `json:"owner"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// leaderLeaseStore reads and conditionally writes the lease, see getSignedSystemObject and putSignedSystemObject
+type leaderLeaseStore interface {
+	get(ctx context.Context) (*leaderLeaseRecord, string, error)
+	put(ctx context.Context, record *leaderLeaseRecord, etag string) (string, error)
+}
+
+// bucketLeaderLeaseStore keeps the lease as a signed object of the system bucket
+type bucketLeaderLeaseStore struct {
+	client *openstor.Client
+	bucket string
+}
+
+func (s *bucketLeaderLeaseStore) get(ctx context.Context) (*leaderLeaseRecord, string, error) {
+	data, etag, err := getSignedSystemObject(ctx, s.client, s.bucket, backgroundLeaseObject)
+	if errors.Is(err, errSystemObjectSignature) {
+		// a lease not written by a console is ignored and replaced
+		LogError("leader lease: %v", err)
+		return nil, etag, nil
+	}
+	if err != nil || data == nil {
+		return nil, etag, err
+	}
+	record := &leaderLeaseRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, etag, nil
+	}
+	return record, etag, nil
+}
+
+func (s *bucketLeaderLeaseStore) put(ctx context.Context, record *leaderLeaseRecord, etag string) (string, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+	return putSignedSystemObject(ctx, s.client, s.bucket, backgroundLeaseObject, data, etag)
+}
+
+// leaderLease elects one console among the replicas. The leader writes the lease object with its expiry and renews
+// it every third of the lease duration, the other consoles take it over once it expired. Writes are conditional on
+// the ETag read, so only one console wins a race. A console stops considering itself the leader a third of the
+// duration before the lease expires, which leaves room for the clock skew between the replicas.
+type leaderLease struct {
+	store    leaderLeaseStore
+	owner    string
+	duration time.Duration
+	now      func() time.Time
+
+	mu         sync.Mutex
+	etag       string
+	validUntil time.Time
+}
+
+func newLeaderLease(store leaderLeaseStore, duration time.Duration) *leaderLease {
+	if duration <= 0 {
+		duration = 30 * time.Second
+	}
+	hostname, _ := os.Hostname()
+	return &leaderLease{
+		store:    store,
+		owner:    fmt.Sprintf("%s-%s", hostname, RandomCharString(8)),
+		duration: duration,
+		now:      time.Now,
+	}
+}
+
+func (l *leaderLease) run(ctx context.Context) {
+	ticker := time.NewTicker(l.duration / 3)
+	defer ticker.Stop()
+	for {
+		if err := l.renew(ctx); err != nil && ctx.Err() == nil {
+			LogError("leader lease: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// renew takes the lease when it is free or expired and extends it when this console holds it
+func (l *leaderLease) renew(ctx context.Context) error {
+	record, etag, err := l.store.get(ctx)
+	if err != nil {
+		return err
+	}
+	now := l.now()
+	if record != nil && record.Owner != l.owner && now.Before(record.ExpiresAt) {
+		l.set("", time.Time{})
+		return nil
+	}
+	etag, err = l.store.put(ctx, &leaderLeaseRecord{Owner: l.owner, ExpiresAt: now.Add(l.duration)}, etag)
+	if errors.Is(err, errSystemObjectChanged) {
+		// another console renewed or took the lease since it was read
+		l.set("", time.Time{})
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	l.set(etag, now.Add(l.duration*2/3))
+	return nil
+}
+
+func (l *leaderLease) set(etag string, validUntil time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.etag = etag
+	l.validUntil = validUntil
+}
+
+// isLeader returns whether this console holds the lease
+func (l *leaderLease) isLeader() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.now().Before(l.validUntil)
+}
+
+// release expires the lease held by this console, so another console takes it over without waiting
+func (l *leaderLease) release(ctx context.Context) {
+	l.mu.Lock()
+	etag, leader := l.etag, l.now().Before(l.validUntil)
+	l.etag, l.validUntil = "", time.Time{}
+	l.mu.Unlock()
+	if !leader {
+		return
+	}
+	if _, err := l.store.put(ctx, &leaderLeaseRecord{Owner: l.owner}, etag); err != nil && !errors.Is(err, errSystemObjectChanged) {
+		LogError("leader lease: unable to release the lease: %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// leaderLeaseStoreMock writes the lease conditionally on its ETag, as the system bucket does
+type leaderLeaseStoreMock struct {
+	record  *leaderLeaseRecord
+	etag    string
+	version int
+}
+
+func (s *leaderLeaseStoreMock) get(_ context.Context) (*leaderLeaseRecord, string, error) {
+	if s.record == nil {
+		return nil, "", nil
+	}
+	record := *s.record
+	return &record, s.etag, nil
+}
+
+func (s *leaderLeaseStoreMock) put(_ context.Context, record *leaderLeaseRecord, etag string) (string, error) {
+	if etag != s.etag {
+		return "", errSystemObjectChanged
+	}
+	s.version++
+	s.record = record
+	s.etag = fmt.Sprintf("etag-%d", s.version)
+	return s.etag, nil
+}
+
+func TestLeaderLease(t *testing.T) {
+	funcAssert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	store := &leaderLeaseStoreMock{}
+	first := newLeaderLease(store, 30*time.Second)
+	first.now = clock
+	second := newLeaderLease(store, 30*time.Second)
+	second.now = clock
+
+	// Test-1: the first console to renew takes the lease, the other one doesn't while it is held
+	funcAssert.False(first.isLeader())
+	funcAssert.NoError(first.renew(ctx))
+	funcAssert.NoError(second.renew(ctx))
+	funcAssert.True(first.isLeader())
+	funcAssert.False(second.isLeader())
+
+	// Test-2: the leader keeps the lease by renewing it
+	now = now.Add(10 * time.Second)
+	funcAssert.NoError(first.renew(ctx))
+	funcAssert.NoError(second.renew(ctx))
+	funcAssert.True(first.isLeader())
+	funcAssert.False(second.isLeader())
+
+	// Test-3: a leader failing to renew steps down before the lease expires, then another console takes it over
+	now = now.Add(25 * time.Second)
+	funcAssert.False(first.isLeader())
+	funcAssert.NoError(second.renew(ctx))
+	funcAssert.False(second.isLeader())
+	now = now.Add(5 * time.Second)
+	funcAssert.NoError(second.renew(ctx))
+	funcAssert.True(second.isLeader())
+	funcAssert.NoError(first.renew(ctx))
+	funcAssert.False(first.isLeader())
+
+	// Test-4: a console losing the race to write the lease isn't the leader
+	store.record.ExpiresAt = now
+	staleETag := store.etag
+	funcAssert.NoError(first.renew(ctx))
+	funcAssert.True(first.isLeader())
+	_, err := second.store.put(ctx, &leaderLeaseRecord{Owner: second.owner}, staleETag)
+	funcAssert.ErrorIs(err, errSystemObjectChanged)
+
+	// Test-5: releasing the lease lets another console take it over right away
+	first.release(ctx)
+	funcAssert.False(first.isLeader())
+	funcAssert.NoError(second.renew(ctx))
+	funcAssert.True(second.isLeader())
+}
+
+func TestSignSystemObject(t *testing.T) {
+	funcAssert := assert.New(t)
+	data := []byte(`{"owner":"console"}`)
+	signature := signSystemObject(data)
+	funcAssert.True(verifySystemObject(data, signature))
+	funcAssert.False(verifySystemObject([]byte(`{"owner":"user"}`), signature))
+	funcAssert.False(verifySystemObject(data, ""))
+}
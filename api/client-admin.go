@@ -46,6 +46,7 @@ type MinioAdmin interface {
 	removePolicy(ctx context.Context, name string) error
 	addPolicy(ctx context.Context, name string, policy *iampolicy.Policy) error
 	setPolicy(ctx context.Context, policyName, entityName string, isGroup bool) error
+	detachPolicy(ctx context.Context, policyName, entityName string, isGroup bool) error
 	getConfigKV(ctx context.Context, key string) ([]byte, error)
 	helpConfigKV(ctx context.Context, subSys, key string, envOnly bool) (madmin.Help, error)
 	helpConfigKVGlobal(ctx context.Context, envOnly bool) (madmin.Help, error)
@@ -113,6 +114,8 @@ type MinioAdmin interface {
 
 	// LDAP
 	getLDAPPolicyEntities(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error)
+	attachPolicyLDAP(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error)
+	detachPolicyLDAP(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error)
 }
 
 // Interface implementation
@@ -231,6 +234,18 @@ func (ac AdminClient) setPolicy(ctx context.Context, policyName, entityName stri
 	return err
 }
 
+// implements madmin.DetachPolicy()
+func (ac AdminClient) detachPolicy(ctx context.Context, policyName, entityName string, isGroup bool) error {
+	policyAssociationReq := madmin.PolicyAssociationReq{Policies: []string{policyName}}
+	if isGroup {
+		policyAssociationReq.Group = entityName
+	} else {
+		policyAssociationReq.User = entityName
+	}
+	_, err := ac.Client.DetachPolicy(ctx, policyAssociationReq)
+	return err
+}
+
 // implements madmin.GetConfigKV()
 func (ac AdminClient) getConfigKV(ctx context.Context, key string) ([]byte, error) {
 	return ac.Client.GetConfigKV(ctx, key)
@@ -679,3 +694,11 @@ func (ac AdminClient) getIDPConfig(ctx context.Context, idpType, cfgName string)
 func (ac AdminClient) getLDAPPolicyEntities(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
 	return ac.Client.GetLDAPPolicyEntities(ctx, query)
 }
+
+func (ac AdminClient) attachPolicyLDAP(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+	return ac.Client.AttachPolicyLDAP(ctx, req)
+}
+
+func (ac AdminClient) detachPolicyLDAP(ctx context.Context, req madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+	return ac.Client.DetachPolicyLDAP(ctx, req)
+}
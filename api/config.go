@@ -7,6 +7,7 @@ package api
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"fmt"
 	"net"
 	"net/http"
 	"strconv"
@@ -246,6 +247,75 @@ func getLogSearchURL() string {
 	return env.Get(ConsoleLogQueryURL, "")
 }
 
+// getLogSearchEmbedded returns whether the console indexes the audit entries of the MinIO servers itself, to
+// answer the log search queries without a Log Search API
+func getLogSearchEmbedded() bool {
+	return strings.ToLower(env.Get(ConsoleLogSearchEmbedded, "off")) == "on"
+}
+
+// getLogSearchIngestToken returns the token the audit webhook of the MinIO servers has to send to the embedded
+// log search, the entries are rejected when it isn't set
+func getLogSearchIngestToken() string {
+	return env.Get(ConsoleLogSearchIngestToken, "")
+}
+
+// getLogSearchRetention returns how long the embedded log search keeps the audit entries
+func getLogSearchRetention() time.Duration {
+	return getDurationEnv(ConsoleLogSearchRetention, 24*time.Hour)
+}
+
+// getLogSearchMaxEntries returns the maximum number of audit entries the embedded log search keeps
+func getLogSearchMaxEntries() int {
+	entries, err := strconv.Atoi(env.Get(ConsoleLogSearchMaxEntries, "100000"))
+	if err != nil || entries <= 0 {
+		return 100000
+	}
+	return entries
+}
+
+// getLogSearchDir returns the directory where the embedded log search keeps its entries across restarts, they
+// are only kept in memory when it isn't set
+func getLogSearchDir() string {
+	return env.Get(ConsoleLogSearchDir, "")
+}
+
+// getInfoSamplerEnabled returns whether the server info is sampled, the sampler only runs with the background
+// credentials and when Prometheus isn't configured
+func getInfoSamplerEnabled() bool {
+	return strings.ToLower(env.Get(ConsoleInfoSampler, "on")) == "on"
+}
+
+// getInfoSamplerInterval returns the interval between two samples of the server info
+func getInfoSamplerInterval() time.Duration {
+	return getDurationEnv(ConsoleInfoSamplerInterval, time.Minute)
+}
+
+// getInfoSamplerRetention returns how long the server info samples are kept
+func getInfoSamplerRetention() time.Duration {
+	return getDurationEnv(ConsoleInfoSamplerRetention, 24*time.Hour)
+}
+
+// getInfoSamplerDir returns the directory where the server info samples are kept across restarts, they are only
+// kept in memory when it isn't set
+func getInfoSamplerDir() string {
+	return env.Get(ConsoleInfoSamplerDir, "")
+}
+
+// getDashboardWidgetsFile returns the YAML or JSON file defining additional dashboard widgets
+func getDashboardWidgetsFile() string {
+	return env.Get(ConsoleDashboardWidgetsFile, "")
+}
+
+// getDashboardWidgetsObject returns the object of the system bucket defining additional dashboard widgets
+func getDashboardWidgetsObject() string {
+	return env.Get(ConsoleDashboardWidgetsObject, "dashboard-widgets.yaml")
+}
+
+// getDashboardWidgetsReloadInterval returns how often the additional dashboard widgets are checked for changes
+func getDashboardWidgetsReloadInterval() time.Duration {
+	return getDurationEnv(ConsoleDashboardWidgetsReloadInterval, 30*time.Second)
+}
+
 func getPrometheusURL() string {
 	return env.Get(PrometheusURL, "")
 }
@@ -291,3 +361,225 @@ func getConsoleAnimatedLogin() bool {
 func getConsoleBrowserRedirectURL() string {
 	return env.Get(ConsoleBrowserRedirectURL, "")
 }
+
+// getSessionRenewWindow returns how long before the sts credentials expire a session
+// created with an IDP gets renewed using the IDP refresh token
+func getSessionRenewWindow() time.Duration {
+	window, err := time.ParseDuration(env.Get(ConsoleSessionRenewWindow, "5m"))
+	if err != nil || window < 0 {
+		return 5 * time.Minute
+	}
+	return window
+}
+
+// getSessionIdleTimeout returns how long a session can be idle before it is rejected, 0 disables the idle timeout
+func getSessionIdleTimeout() time.Duration {
+	timeout, err := time.ParseDuration(env.Get(ConsoleSessionIdleTimeout, "0"))
+	if err != nil || timeout < 0 {
+		return 0
+	}
+	return timeout
+}
+
+// getSessionIdleWSPolicy returns whether open WebSocket connections keep the session active (`active`),
+// or are closed once the session becomes idle (`idle`)
+func getSessionIdleWSPolicy() string {
+	if strings.ToLower(env.Get(ConsoleSessionIdleWSPolicy, sessionIdleWSPolicyIdle)) == sessionIdleWSPolicyActive {
+		return sessionIdleWSPolicyActive
+	}
+	return sessionIdleWSPolicyIdle
+}
+
+// getCSRFProtection returns whether state changing API requests are validated against cross-site request forgery
+func getCSRFProtection() bool {
+	return strings.ToLower(env.Get(ConsoleCSRFProtection, "on")) == "on"
+}
+
+// getCSRFTrustedOrigins returns the comma separated list of origins, besides the console one,
+// allowed to send state changing API requests, e.g. `https://portal.example.com`
+func getCSRFTrustedOrigins() []string {
+	var origins []string
+	for _, origin := range strings.Split(env.Get(ConsoleCSRFTrustedOrigins, ""), ",") {
+		if origin = strings.TrimSuffix(strings.TrimSpace(origin), SlashSeparator); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// getSystemBucket returns the bucket where the console keeps its own state
+func getSystemBucket() string {
+	return env.Get(ConsoleSystemBucket, "console-system")
+}
+
+// getPolicyHistoryBucket returns the system bucket keeping the history of the canned policies
+func getPolicyHistoryBucket() string {
+	return env.Get(ConsolePolicyHistoryBucket, getSystemBucket())
+}
+
+// getPolicyLintGate returns the lowest severity of the lint findings preventing a policy from being saved,
+// `error` or `warning`. Policies aren't linted before being saved by default (`off`).
+func getPolicyLintGate() string {
+	return strings.ToLower(strings.TrimSpace(env.Get(ConsolePolicyLintGate, policyLintGateOff)))
+}
+
+// validatePolicyLintGate returns an error when the policy lint gate isn't one of its values, an unknown value
+// would silently disable it
+func validatePolicyLintGate(gate string) error {
+	switch gate {
+	case policyLintGateOff, policyLintGateError, policyLintGateWarning:
+		return nil
+	}
+	return fmt.Errorf("invalid %s %s - only accepts '%s', '%s' or '%s'", ConsolePolicyLintGate, gate, policyLintGateOff, policyLintGateError, policyLintGateWarning)
+}
+
+// ValidateConfig returns an error when the console configuration set in the environment is invalid, it's checked
+// when the server starts
+func ValidateConfig() error {
+	return validatePolicyLintGate(getPolicyLintGate())
+}
+
+// getStaleDisabledDays returns the number of days a user has to be disabled to be reported as stale
+func getStaleDisabledDays() int {
+	days, err := strconv.Atoi(env.Get(ConsoleStaleDisabledDays, "90"))
+	if err != nil || days < 0 {
+		return 90
+	}
+	return days
+}
+
+// getDurationEnv returns the duration set in the environment variable, or the default one when it is
+// unset or invalid
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(env.Get(key, defaultValue.String()))
+	if err != nil || value < 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// getBackgroundCredentials returns the credentials the background jobs of the console run with, none of them runs
+// when they aren't set
+func getBackgroundCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleBackgroundAccessKey, ""), env.Get(ConsoleBackgroundSecretKey, "")
+}
+
+// getBackgroundLeaseDuration returns how long the console elected to run the background jobs holds the lease
+// without renewing it
+func getBackgroundLeaseDuration() time.Duration {
+	return getDurationEnv(ConsoleBackgroundLeaseDuration, 30*time.Second)
+}
+
+// getServiceAccountSchedulerEnabled returns whether the service account scheduler runs with the background
+// credentials
+func getServiceAccountSchedulerEnabled() bool {
+	return strings.ToLower(env.Get(ConsoleSAScheduler, "off")) == "on"
+}
+
+// getServiceAccountSchedulerConfig returns how the service accounts are checked for expiry and age
+func getServiceAccountSchedulerConfig() serviceAccountSchedulerConfig {
+	return serviceAccountSchedulerConfig{
+		interval:         getDurationEnv(ConsoleSASchedulerInterval, time.Hour),
+		expiryWarning:    getDurationEnv(ConsoleSAExpiryWarning, 7*24*time.Hour),
+		maxAge:           getDurationEnv(ConsoleSAMaxAge, 0),
+		autoRotate:       strings.ToLower(env.Get(ConsoleSAAutoRotate, "off")) == "on",
+		rotationGrace:    getDurationEnv(ConsoleSARotationGrace, 24*time.Hour),
+		rotationLifetime: getDurationEnv(ConsoleSARotationLifetime, 90*24*time.Hour),
+		webhookURL:       env.Get(ConsoleSANotifyWebhook, ""),
+		webhookAuthToken: env.Get(ConsoleSANotifyWebhookAuthToken, ""),
+	}
+}
+
+// getAlertsEnabled returns whether the alert rules are evaluated with the background credentials
+func getAlertsEnabled() bool {
+	return strings.ToLower(env.Get(ConsoleAlerts, "off")) == "on"
+}
+
+// getAlertsRulesFile returns the YAML or JSON file defining the alert rules, the default rules are evaluated when
+// it isn't set
+func getAlertsRulesFile() string {
+	return env.Get(ConsoleAlertsRulesFile, "")
+}
+
+// getAlertsConfig returns how often the alert rules are evaluated and where the alerts are delivered
+func getAlertsConfig() alertsConfig {
+	var smtpTo []string
+	for _, to := range strings.Split(env.Get(ConsoleAlertsSMTPTo, ""), ",") {
+		if to = strings.TrimSpace(to); to != "" {
+			smtpTo = append(smtpTo, to)
+		}
+	}
+	return alertsConfig{
+		interval:         getDurationEnv(ConsoleAlertsInterval, time.Minute),
+		repeatInterval:   getDurationEnv(ConsoleAlertsRepeatInterval, 4*time.Hour),
+		webhookURL:       env.Get(ConsoleAlertsWebhook, ""),
+		webhookAuthToken: env.Get(ConsoleAlertsWebhookAuthToken, ""),
+		smtpAddress:      env.Get(ConsoleAlertsSMTPAddress, ""),
+		smtpUsername:     env.Get(ConsoleAlertsSMTPUsername, ""),
+		smtpPassword:     env.Get(ConsoleAlertsSMTPPassword, ""),
+		smtpFrom:         env.Get(ConsoleAlertsSMTPFrom, ""),
+		smtpTo:           smtpTo,
+	}
+}
+
+// getHealthCheckIDP returns whether the readiness probe checks the discovery doc of the identity providers
+func getHealthCheckIDP() bool {
+	return strings.ToLower(env.Get(ConsoleHealthCheckIDP, "off")) == "on"
+}
+
+// getMetricsAuthToken returns the bearer token required to scrape the console metrics, they are public when
+// it isn't set
+func getMetricsAuthToken() string {
+	return env.Get(ConsoleMetricsAuthToken, "")
+}
+
+// getTracingExporter returns where the spans are exported: `otlp`, `stdout` or `file`. Tracing is disabled
+// when it isn't set.
+func getTracingExporter() string {
+	return strings.ToLower(strings.TrimSpace(env.Get(ConsoleTracingExporter, "")))
+}
+
+// getTracingOTLPHeaders returns the headers sent to the OTLP endpoint, set as comma separated `key=value` pairs
+func getTracingOTLPHeaders() map[string]string {
+	return parseKeyValuePairs(env.Get(ConsoleTracingOTLPHeaders, ""))
+}
+
+// getTracingResourceAttributes returns the attributes added to the resource of the spans, set as comma separated
+// `key=value` pairs
+func getTracingResourceAttributes() map[string]string {
+	return parseKeyValuePairs(env.Get(ConsoleTracingResourceAttributes, ""))
+}
+
+// getTracingSampleRatio returns the ratio of the traces started by the console that are sampled, between 0 and 1.
+// The traces continued from a caller follow its sampling decision.
+func getTracingSampleRatio() (float64, error) {
+	value := strings.TrimSpace(env.Get(ConsoleTracingSampleRatio, "1"))
+	ratio, err := strconv.ParseFloat(value, 64)
+	if err != nil || ratio < 0 || ratio > 1 {
+		return 0, fmt.Errorf("invalid %s %q, it must be between 0 and 1", ConsoleTracingSampleRatio, value)
+	}
+	return ratio, nil
+}
+
+// parseKeyValuePairs parses comma separated `key=value` pairs, pairs without a key are ignored
+func parseKeyValuePairs(value string) map[string]string {
+	pairs := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			continue
+		}
+		pairs[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return pairs
+}
+
+// getUserReaperEnabled returns whether the expired users reaper runs with the background credentials
+func getUserReaperEnabled() bool {
+	return strings.ToLower(env.Get(ConsoleUserReaper, "on")) == "on"
+}
+
+// getUserReaperInterval returns the interval between two checks of the expired users
+func getUserReaperInterval() time.Duration {
+	return getDurationEnv(ConsoleUserReaperInterval, 5*time.Minute)
+}
@@ -379,3 +379,15 @@ func Test_getConsoleDevMode(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	// Test-1: the policy lint gate accepts its values regardless of their case
+	for _, gate := range []string{"", policyLintGateOff, policyLintGateError, "Warning"} {
+		t.Setenv(ConsolePolicyLintGate, gate)
+		assert.NoError(t, ValidateConfig(), gate)
+	}
+
+	// Test-2: an unknown policy lint gate would silently disable it
+	t.Setenv(ConsolePolicyLintGate, "strict")
+	assert.Error(t, ValidateConfig())
+}
@@ -103,12 +103,20 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerBucketsHandlers(api)
 	// Register all users handlers
 	registerUsersHandlers(api)
+	// Register user effective permissions handlers
+	registerUserPermissionsHandlers(api)
 	// Register groups handlers
 	registerGroupsHandlers(api)
 	// Register policies handlers
 	registersPoliciesHandler(api)
+	// Register bucket access grants handlers
+	registerBucketAccessGrantsHandlers(api)
 	// Register configurations handlers
 	registerConfigHandlers(api)
+	// Register IAM bundle handlers
+	registerIAMBundleHandlers(api)
+	// Register stale identity report handlers
+	registerStaleIdentityHandlers(api)
 	// Register bucket events handlers
 	registerBucketEventsHandlers(api)
 	// Register service handlers
@@ -135,6 +143,8 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerInspectHandler(api)
 	// Register nodes handlers
 	registerNodesHandler(api)
+	// Register alerts handlers
+	registerAlertsHandlers(api)
 
 	// Operator Console
 
@@ -149,9 +159,35 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 
 	registerPublicObjectsHandlers(api)
 
+	// Start exporting the spans of the requests and backend calls
+	stopTracing := startTracing()
+	// Create the clients of the background jobs and elect the console running them among the replicas
+	stopBackgroundService := startBackgroundService()
+	// Start the service account expiry and rotation scheduler
+	stopServiceAccountScheduler := startServiceAccountScheduler()
+	// Start the reaper disabling and deleting the expired users
+	stopUserExpiryReaper := startUserExpiryReaper()
+	// Start indexing the audit entries of the MinIO servers for the embedded log search
+	stopLogSearchStore := startLogSearchStore()
+	// Start sampling the server info for the dashboard when Prometheus isn't configured
+	stopInfoSampler := startInfoSampler()
+	// Start reloading the user-defined dashboard widgets when their file changes
+	stopCustomWidgets := startCustomWidgets()
+	// Start evaluating the alert rules against the cluster state
+	stopAlertEngine := startAlertEngine()
+
 	api.PreServerShutdown = func() {}
 
-	api.ServerShutdown = func() {}
+	api.ServerShutdown = func() {
+		stopServiceAccountScheduler()
+		stopUserExpiryReaper()
+		stopLogSearchStore()
+		stopInfoSampler()
+		stopCustomWidgets()
+		stopAlertEngine()
+		stopBackgroundService()
+		stopTracing()
+	}
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
@@ -165,7 +201,10 @@ func configureTLS(tlsConfig *tls.Config) {
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation
 func setupMiddlewares(handler http.Handler) http.Handler {
-	return handler
+	// name the request span after its operation
+	next := TraceOperationMiddleware(handler)
+	// count and time the requests by operation
+	return MetricsMiddleware(next)
 }
 
 func ContextMiddleware(next http.Handler) http.Handler {
@@ -183,6 +222,10 @@ func ContextMiddleware(next http.Handler) http.Handler {
 func AuditLogMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		rw := logger.NewResponseWriter(w)
+		if strings.HasPrefix(r.URL.Path, "/api") {
+			// record the request body for the audit, when enabled
+			logger.RecordRequestBody(r)
+		}
 		next.ServeHTTP(rw, r)
 		if strings.HasPrefix(r.URL.Path, "/ws") || strings.HasPrefix(r.URL.Path, "/api") {
 			logger.AuditLog(r.Context(), rw, r, map[string]interface{}{}, "Authorization", "Cookie", "Set-Cookie")
@@ -293,8 +336,12 @@ func setupGlobalMiddleware(handler http.Handler) http.Handler {
 	next = ContextMiddleware(next)
 	// handle cookie or authorization header for session
 	next = AuthenticationMiddleware(next)
+	// reject cross-site state changing requests
+	next = CSRFMiddleware(next)
 	// handle debug logging
 	next = DebugLogMiddleware(next)
+	// record a span for every request
+	next = TracingMiddleware(next)
 
 	sslHostFn := secure.SSLHostFunc(func(host string) string {
 		xhost, err := xnet.ParseHost(host)
@@ -359,6 +406,32 @@ func AuthenticationMiddleware(next http.Handler) http.Handler {
 			return
 		}
 		sessionToken, _ := auth.DecryptToken(token)
+		claims, _ := auth.ParseClaimsFromToken(string(sessionToken))
+		now := time.Now()
+		// sessions idle for too long are rejected regardless of their sts credentials expiration
+		if sessionIdleExpired(claims, now) {
+			expiredCookie := ExpireSessionCookie()
+			http.SetCookie(w, &expiredCookie)
+			if isSessionPath(r.URL.Path) {
+				errors.ServeError(w, r, errors.New(http.StatusUnauthorized, "session expired due to inactivity"))
+				return
+			}
+			sessionToken, claims = nil, nil
+		}
+		// sessions created with an IDP get new sts credentials before the current ones expire
+		if sessionNeedsRenewal(claims, now) {
+			renewedToken, renewedClaims, err := renewSession(w, r, claims)
+			switch {
+			case err == errNoRefreshToken:
+				// the session can't be renewed, it ends when its sts credentials expire
+			case err != nil:
+				LogError("unable to renew session using the idp refresh token: %v", err)
+			default:
+				globalSessionActivity.renewed(claims.STSAccessKeyID, renewedClaims.STSAccessKeyID, now)
+				sessionToken, claims = renewedToken, renewedClaims
+			}
+		}
+		touchSession(w, claims, now)
 		// All handlers handle appropriately to return errors
 		// based on their swagger rules, we do not need to
 		// additionally return error here, let the next ServeHTTPs
@@ -369,7 +442,6 @@ func AuthenticationMiddleware(next http.Handler) http.Handler {
 			r.Header.Add("Authorization", fmt.Sprintf("Bearer %s", "Anonymous"))
 		}
 		ctx := r.Context()
-		claims, _ := auth.ParseClaimsFromToken(string(sessionToken))
 		if claims != nil {
 			// save user session id context
 			ctx = context.WithValue(r.Context(), utils.ContextRequestUserID, claims.STSSessionToken)
@@ -391,6 +463,14 @@ func FileServerMiddleware(next http.Handler) http.Handler {
 		switch {
 		case strings.HasPrefix(r.URL.Path, "/ws"):
 			serveWS(w, r)
+		case r.URL.Path == "/metrics":
+			serveMetrics(w, r)
+		case r.URL.Path == "/logs/ingest":
+			serveLogSearchIngest(w, r)
+		case r.URL.Path == "/health/live":
+			serveHealthLive(w, r)
+		case r.URL.Path == "/health/ready":
+			serveHealthReady(w, r)
 		case strings.HasPrefix(r.URL.Path, "/api"):
 			next.ServeHTTP(w, r)
 		default:
@@ -45,6 +45,61 @@ const (
 	ConsoleDevMode                               = "CONSOLE_DEV_MODE"
 	ConsoleAnimatedLogin                         = "CONSOLE_ANIMATED_LOGIN"
 	ConsoleBrowserRedirectURL                    = "CONSOLE_BROWSER_REDIRECT_URL"
+	ConsoleSessionRenewWindow                    = "CONSOLE_SESSION_RENEW_WINDOW"
+	ConsoleSessionIdleTimeout                    = "CONSOLE_SESSION_IDLE_TIMEOUT"
+	ConsoleSessionIdleWSPolicy                   = "CONSOLE_SESSION_IDLE_WS_POLICY"
+	ConsoleCSRFProtection                        = "CONSOLE_CSRF_PROTECTION"
+	ConsoleCSRFTrustedOrigins                    = "CONSOLE_CSRF_TRUSTED_ORIGINS"
+	ConsoleSystemBucket                          = "CONSOLE_SYSTEM_BUCKET"
+	ConsolePolicyHistoryBucket                   = "CONSOLE_POLICY_HISTORY_BUCKET"
+	ConsolePolicyLintGate                        = "CONSOLE_POLICY_LINT_GATE"
+	ConsoleStaleDisabledDays                     = "CONSOLE_STALE_DISABLED_DAYS"
+	ConsoleBackgroundAccessKey                   = "CONSOLE_BACKGROUND_ACCESS_KEY"
+	ConsoleBackgroundSecretKey                   = "CONSOLE_BACKGROUND_SECRET_KEY"
+	ConsoleBackgroundLeaseDuration               = "CONSOLE_BACKGROUND_LEASE_DURATION"
+	ConsoleSAScheduler                           = "CONSOLE_SA_SCHEDULER"
+	ConsoleSASchedulerInterval                   = "CONSOLE_SA_SCHEDULER_INTERVAL"
+	ConsoleSAExpiryWarning                       = "CONSOLE_SA_EXPIRY_WARNING"
+	ConsoleSAMaxAge                              = "CONSOLE_SA_MAX_AGE"
+	ConsoleSAAutoRotate                          = "CONSOLE_SA_AUTO_ROTATE"
+	ConsoleSARotationGrace                       = "CONSOLE_SA_ROTATION_GRACE"
+	ConsoleSARotationLifetime                    = "CONSOLE_SA_ROTATION_LIFETIME"
+	ConsoleSANotifyWebhook                       = "CONSOLE_SA_NOTIFY_WEBHOOK"
+	ConsoleSANotifyWebhookAuthToken              = "CONSOLE_SA_NOTIFY_WEBHOOK_AUTH_TOKEN"
+	ConsoleUserReaper                            = "CONSOLE_USER_REAPER"
+	ConsoleUserReaperInterval                    = "CONSOLE_USER_REAPER_INTERVAL"
+	ConsoleMetricsAuthToken                      = "CONSOLE_METRICS_AUTH_TOKEN"
+	ConsoleTracingExporter                       = "CONSOLE_TRACING_EXPORTER"
+	ConsoleTracingOTLPEndpoint                   = "CONSOLE_TRACING_OTLP_ENDPOINT"
+	ConsoleTracingOTLPHeaders                    = "CONSOLE_TRACING_OTLP_HEADERS"
+	ConsoleTracingFile                           = "CONSOLE_TRACING_FILE"
+	ConsoleTracingServiceName                    = "CONSOLE_TRACING_SERVICE_NAME"
+	ConsoleTracingSampleRatio                    = "CONSOLE_TRACING_SAMPLE_RATIO"
+	ConsoleTracingResourceAttributes             = "CONSOLE_TRACING_RESOURCE_ATTRIBUTES"
+	ConsoleLogSearchEmbedded                     = "CONSOLE_LOG_SEARCH_EMBEDDED"
+	ConsoleLogSearchIngestToken                  = "CONSOLE_LOG_SEARCH_INGEST_TOKEN"
+	ConsoleLogSearchRetention                    = "CONSOLE_LOG_SEARCH_RETENTION"
+	ConsoleLogSearchMaxEntries                   = "CONSOLE_LOG_SEARCH_MAX_ENTRIES"
+	ConsoleLogSearchDir                          = "CONSOLE_LOG_SEARCH_DIR"
+	ConsoleInfoSampler                           = "CONSOLE_INFO_SAMPLER"
+	ConsoleInfoSamplerInterval                   = "CONSOLE_INFO_SAMPLER_INTERVAL"
+	ConsoleInfoSamplerRetention                  = "CONSOLE_INFO_SAMPLER_RETENTION"
+	ConsoleInfoSamplerDir                        = "CONSOLE_INFO_SAMPLER_DIR"
+	ConsoleDashboardWidgetsFile                  = "CONSOLE_DASHBOARD_WIDGETS_FILE"
+	ConsoleDashboardWidgetsObject                = "CONSOLE_DASHBOARD_WIDGETS_OBJECT"
+	ConsoleDashboardWidgetsReloadInterval        = "CONSOLE_DASHBOARD_WIDGETS_RELOAD_INTERVAL"
+	ConsoleAlerts                                = "CONSOLE_ALERTS"
+	ConsoleAlertsInterval                        = "CONSOLE_ALERTS_INTERVAL"
+	ConsoleAlertsRepeatInterval                  = "CONSOLE_ALERTS_REPEAT_INTERVAL"
+	ConsoleAlertsRulesFile                       = "CONSOLE_ALERTS_RULES_FILE"
+	ConsoleAlertsWebhook                         = "CONSOLE_ALERTS_WEBHOOK"
+	ConsoleAlertsWebhookAuthToken                = "CONSOLE_ALERTS_WEBHOOK_AUTH_TOKEN"
+	ConsoleAlertsSMTPAddress                     = "CONSOLE_ALERTS_SMTP_ADDRESS"
+	ConsoleAlertsSMTPUsername                    = "CONSOLE_ALERTS_SMTP_USERNAME"
+	ConsoleAlertsSMTPPassword                    = "CONSOLE_ALERTS_SMTP_PASSWORD"
+	ConsoleAlertsSMTPFrom                        = "CONSOLE_ALERTS_SMTP_FROM"
+	ConsoleAlertsSMTPTo                          = "CONSOLE_ALERTS_SMTP_TO"
+	ConsoleHealthCheckIDP                        = "CONSOLE_HEALTH_CHECK_IDP"
 	LogSearchQueryAuthToken                      = "LOGSEARCH_QUERY_AUTH_TOKEN"
 	SlashSeparator                               = "/"
 	LocalAddress                                 = "127.0.0.1"
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errorsApi "github.com/go-openapi/errors"
+)
+
+var (
+	errCrossSiteRequest   = errors.New("cross-site request rejected")
+	errRequestOutsidePath = errors.New("request not originated from the console path")
+)
+
+// CSRFMiddleware rejects state changing API requests that were not originated by the console
+// web application. Browsers sending Fetch Metadata headers are validated using `Sec-Fetch-Site`,
+// otherwise the `Origin` (or `Referer`) of the request must match the host the request was sent to.
+// Requests without any of these headers are not sent by browsers and therefore are not exposed to CSRF.
+func CSRFMiddleware(next http.Handler) http.Handler {
+	if !getCSRFProtection() {
+		return next
+	}
+	trustedOrigins := getCSRFTrustedOrigins()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isSafeMethod(r.Method) || !isAPIPath(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err := validateRequestOrigin(r, trustedOrigins); err != nil {
+			ErrorWithContext(r.Context(), err)
+			errorsApi.ServeError(w, r, errorsApi.New(http.StatusForbidden, "%v", err))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// isAPIPath returns true for console API paths, with or without the configured subpath
+func isAPIPath(p string) bool {
+	return strings.HasPrefix(trimSubPath(p), "/api")
+}
+
+// trimSubPath removes the configured subpath from the path, in case the reverse proxy didn't
+func trimSubPath(p string) string {
+	if subPath := getSubPath(); subPath != SlashSeparator && strings.HasPrefix(p, subPath) {
+		return SlashSeparator + strings.TrimPrefix(p, subPath)
+	}
+	return p
+}
+
+// validateRequestOrigin validates that a state changing request was sent by the console web application
+func validateRequestOrigin(r *http.Request, trustedOrigins []string) error {
+	origin := r.Header.Get("Origin")
+	var refererPath string
+	if referer := r.Header.Get("Referer"); referer != "" {
+		if u, err := url.Parse(referer); err == nil {
+			refererPath = u.Path
+			if origin == "" && u.Host != "" {
+				origin = u.Scheme + "://" + u.Host
+			}
+		}
+	}
+
+	switch r.Header.Get("Sec-Fetch-Site") {
+	case "same-origin", "none":
+		// the browser guarantees the request was sent from the same origin, or directly by the user
+		return validateRefererPath(refererPath)
+	case "":
+		// browsers without Fetch Metadata support, or not a browser at all
+		if origin == "" {
+			return nil
+		}
+	default:
+		// same-site or cross-site requests are only allowed from trusted origins
+		if isTrustedOrigin(origin, trustedOrigins) {
+			return nil
+		}
+		return errCrossSiteRequest
+	}
+
+	if isTrustedOrigin(origin, trustedOrigins) {
+		return nil
+	}
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" || !strings.EqualFold(u.Host, getRequestHost(r)) {
+		return errCrossSiteRequest
+	}
+	return validateRefererPath(refererPath)
+}
+
+// validateRefererPath rejects requests from other applications served on the same
+// origin when console is running behind a reverse proxy using a subpath
+func validateRefererPath(refererPath string) error {
+	subPath := getSubPath()
+	if subPath == SlashSeparator || refererPath == "" {
+		return nil
+	}
+	if !strings.HasPrefix(refererPath+SlashSeparator, subPath) {
+		return errRequestOutsidePath
+	}
+	return nil
+}
+
+func isTrustedOrigin(origin string, trustedOrigins []string) bool {
+	for _, trusted := range trustedOrigins {
+		if strings.EqualFold(origin, trusted) {
+			return true
+		}
+	}
+	return false
+}
+
+// getRequestHost returns the host the client sent the request to, taking into account
+// the host forwarded by reverse proxies.
+func getRequestHost(r *http.Request) string {
+	if forwardedHost := r.Header.Get("X-Forwarded-Host"); forwardedHost != "" {
+		// multiple proxies append their own value, the first one is the one used by the client
+		return strings.TrimSpace(strings.Split(forwardedHost, ",")[0])
+	}
+	return r.Host
+}
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSRFMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		subPath        string
+		trustedOrigins string
+		method         string
+		path           string
+		host           string
+		headers        map[string]string
+		want           int
+	}{
+		{
+			name:   "safe methods are allowed",
+			method: http.MethodGet,
+			path:   "/api/v1/buckets",
+			headers: map[string]string{
+				"Sec-Fetch-Site": "cross-site",
+				"Origin":         "https://evil.example.com",
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:   "non browser clients are allowed",
+			method: http.MethodPost,
+			path:   "/api/v1/buckets",
+			want:   http.StatusOK,
+		},
+		{
+			name:   "same origin fetch metadata is allowed",
+			method: http.MethodPost,
+			path:   "/api/v1/buckets",
+			headers: map[string]string{
+				"Sec-Fetch-Site": "same-origin",
+				"Origin":         "https://console.example.com",
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:   "cross-site fetch metadata is rejected",
+			method: http.MethodDelete,
+			path:   "/api/v1/buckets/test",
+			headers: map[string]string{
+				"Sec-Fetch-Site": "cross-site",
+				"Origin":         "https://evil.example.com",
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name:   "same-site fetch metadata is rejected",
+			method: http.MethodPut,
+			path:   "/api/v1/buckets/test/quota",
+			headers: map[string]string{
+				"Sec-Fetch-Site": "same-site",
+				"Origin":         "https://other.example.com",
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name:           "cross-site request from a trusted origin is allowed",
+			trustedOrigins: "https://portal.example.com/, https://other.example.com",
+			method:         http.MethodPost,
+			path:           "/api/v1/buckets",
+			headers: map[string]string{
+				"Sec-Fetch-Site": "cross-site",
+				"Origin":         "https://portal.example.com",
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:   "origin matching the host is allowed",
+			method: http.MethodPost,
+			path:   "/api/v1/buckets",
+			host:   "console.example.com",
+			headers: map[string]string{
+				"Origin": "https://console.example.com",
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:   "origin not matching the host is rejected",
+			method: http.MethodPost,
+			path:   "/api/v1/buckets",
+			host:   "console.example.com",
+			headers: map[string]string{
+				"Origin": "https://evil.example.com",
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name:   "null origin is rejected",
+			method: http.MethodPost,
+			path:   "/api/v1/buckets",
+			headers: map[string]string{
+				"Origin": "null",
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name:   "referer is used when origin is missing",
+			method: http.MethodPost,
+			path:   "/api/v1/buckets",
+			host:   "console.example.com",
+			headers: map[string]string{
+				"Referer": "https://evil.example.com/page",
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name:   "origin matching the forwarded host is allowed",
+			method: http.MethodPost,
+			path:   "/api/v1/buckets",
+			host:   "console:9090",
+			headers: map[string]string{
+				"Origin":           "https://console.example.com",
+				"X-Forwarded-Host": "console.example.com, proxy.internal",
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:    "subpath api requests are validated",
+			subPath: "/console/",
+			method:  http.MethodPost,
+			path:    "/console/api/v1/buckets",
+			headers: map[string]string{
+				"Sec-Fetch-Site": "cross-site",
+				"Origin":         "https://evil.example.com",
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name:    "subpath same origin request from console is allowed",
+			subPath: "/console/",
+			method:  http.MethodPost,
+			path:    "/api/v1/buckets",
+			headers: map[string]string{
+				"Sec-Fetch-Site": "same-origin",
+				"Referer":        "https://example.com/console/browser",
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:    "subpath same origin request from another application is rejected",
+			subPath: "/console/",
+			method:  http.MethodPost,
+			path:    "/api/v1/buckets",
+			headers: map[string]string{
+				"Sec-Fetch-Site": "same-origin",
+				"Referer":        "https://example.com/other-app/",
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name:   "non api paths are not validated",
+			method: http.MethodPost,
+			path:   "/login",
+			headers: map[string]string{
+				"Sec-Fetch-Site": "cross-site",
+			},
+			want: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(SubPath, tt.subPath)
+			t.Setenv(ConsoleCSRFTrustedOrigins, tt.trustedOrigins)
+			subPathOnce = sync.Once{}
+			defer func() { subPathOnce = sync.Once{} }()
+
+			handler := CSRFMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.host != "" {
+				req.Host = tt.host
+			}
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+func TestCSRFMiddlewareDisabled(t *testing.T) {
+	t.Setenv(ConsoleCSRFProtection, "off")
+	handler := CSRFMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/buckets", nil)
+	req.Header.Set("Sec-Fetch-Site", "cross-site")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -97,6 +97,90 @@ func init() {
         }
       }
     },
+    "/admin/alerts": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns the firing and recently resolved alerts, the alert rules and the silences",
+        "operationId": "ListAlerts",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/silences": {
+      "post": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Silences the alerts matching a rule and a subject",
+        "operationId": "CreateAlertSilence",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertSilenceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertSilence"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/silences/{id}": {
+      "delete": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Removes an alert silence",
+        "operationId": "DeleteAlertSilence",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/admin/arns": {
       "get": {
         "tags": [
@@ -151,6 +235,116 @@ func init() {
         }
       }
     },
+    "/admin/info/custom-widgets": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns the user-defined dashboard widgets",
+        "operationId": "ListCustomWidgets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/customWidgetsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/info/custom-widgets/test": {
+      "post": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Validates a dashboard widget definition and returns the results of its queries",
+        "operationId": "TestCustomWidget",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/customWidgetTestRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/widgetDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/admin/info/custom-widgets/{namespace}/{widgetId}": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns the results of a user-defined dashboard widget",
+        "operationId": "CustomWidgetDetails",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "end",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "step",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/widgetDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/admin/info/widgets/{widgetId}": {
       "get": {
         "tags": [
@@ -375,32 +569,26 @@ func init() {
         }
       }
     },
-    "/bucket/{bucket}/access-rules": {
+    "/bucket/{bucket}/access-grants": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "List Access Rules With Given Bucket",
-        "operationId": "ListAccessRulesWithBucket",
+        "summary": "List the users and groups granted access to a prefix of the bucket",
+        "operationId": "ListBucketAccessGrants",
         "parameters": [
           {
             "type": "string",
             "name": "bucket",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/offset"
-          },
-          {
-            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listAccessRulesResponse"
+              "$ref": "#/definitions/bucketAccessGrantsResponse"
             }
           },
           "default": {
@@ -415,8 +603,8 @@ func init() {
         "tags": [
           "Bucket"
         ],
-        "summary": "Add Access Rule To Given Bucket",
-        "operationId": "SetAccessRuleWithBucket",
+        "summary": "Grant users and groups access to a prefix of the bucket",
+        "operationId": "GrantBucketAccess",
         "parameters": [
           {
             "type": "string",
@@ -425,11 +613,11 @@ func init() {
             "required": true
           },
           {
-            "name": "prefixaccess",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/prefixAccessPair"
+              "$ref": "#/definitions/bucketAccessGrantRequest"
             }
           }
         ],
@@ -437,7 +625,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "boolean"
+              "$ref": "#/definitions/bucketAccessGrant"
             }
           },
           "default": {
@@ -447,13 +635,15 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/bucket/{bucket}/access-grants/revoke": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Delete Access Rule From Given Bucket",
-        "operationId": "DeleteAccessRuleWithBucket",
+        "summary": "Revoke the access of users and groups to a prefix of the bucket",
+        "operationId": "RevokeBucketAccess",
         "parameters": [
           {
             "type": "string",
@@ -462,20 +652,17 @@ func init() {
             "required": true
           },
           {
-            "name": "prefix",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/prefixWrapper"
+              "$ref": "#/definitions/bucketAccessRevokeRequest"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "boolean"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -486,18 +673,32 @@ func init() {
         }
       }
     },
-    "/buckets": {
+    "/bucket/{bucket}/access-rules": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "List Buckets",
-        "operationId": "ListBuckets",
+        "summary": "List Access Rules With Given Bucket",
+        "operationId": "ListAccessRulesWithBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/listAccessRulesResponse"
             }
           },
           "default": {
@@ -508,19 +709,25 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Make bucket",
-        "operationId": "MakeBucket",
+        "summary": "Add Access Rule To Given Bucket",
+        "operationId": "SetAccessRuleWithBucket",
         "parameters": [
           {
-            "name": "body",
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "prefixaccess",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/makeBucketRequest"
+              "$ref": "#/definitions/prefixAccessPair"
             }
           }
         ],
@@ -528,7 +735,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/makeBucketsResponse"
+              "type": "boolean"
             }
           },
           "default": {
@@ -538,21 +745,112 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets-replication": {
-      "post": {
+      },
+      "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Sets Multi Bucket Replication in multiple Buckets",
-        "operationId": "SetMultiBucketReplication",
+        "summary": "Delete Access Rule From Given Bucket",
+        "operationId": "DeleteAccessRuleWithBucket",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "prefix",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/prefixWrapper"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "boolean"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List Buckets",
+        "operationId": "ListBuckets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Make bucket",
+        "operationId": "MakeBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/makeBucketRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/makeBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets-replication": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Sets Multi Bucket Replication in multiple Buckets",
+        "operationId": "SetMultiBucketReplication",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
               "$ref": "#/definitions/multiBucketReplication"
             }
           }
@@ -2426,6 +2724,126 @@ func init() {
         }
       }
     },
+    "/iam/export": {
+      "get": {
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Export the canned policies, users, groups, policy mappings and service accounts",
+        "operationId": "ExportIAM",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/iamBundle"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/iam/import": {
+      "post": {
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Import an IAM bundle previously exported",
+        "operationId": "ImportIAM",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/iamImportRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/iamImportResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/iam/stale-report": {
+      "get": {
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Report the unused users, groups, policies and service accounts",
+        "operationId": "GetStaleIdentityReport",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "disabledDays",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/staleIdentityReport"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/iam/stale-report/cleanup": {
+      "post": {
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Remove the identities flagged by the stale identity report",
+        "operationId": "CleanupStaleIdentities",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/staleIdentityCleanupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/staleIdentityCleanupResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/idp/{type}": {
       "get": {
         "tags": [
@@ -2839,20 +3257,20 @@ func init() {
         }
       }
     },
-    "/list-external-buckets": {
+    "/ldap-entities/policies/attach": {
       "post": {
         "tags": [
-          "Bucket"
+          "idp"
         ],
-        "summary": "Lists an External list of buckets using custom credentials",
-        "operationId": "ListExternalBuckets",
+        "summary": "Attach policies to LDAP user and group DNs",
+        "operationId": "AttachLDAPPolicies",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/listExternalBucketsParams"
+              "$ref": "#/definitions/ldapPolicyAssociationRequest"
             }
           }
         ],
@@ -2860,7 +3278,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/ldapPolicyAssociationResponse"
             }
           },
           "default": {
@@ -2872,19 +3290,28 @@ func init() {
         }
       }
     },
-    "/login": {
-      "get": {
-        "security": [],
+    "/ldap-entities/policies/detach": {
+      "post": {
         "tags": [
-          "Auth"
+          "idp"
+        ],
+        "summary": "Detach policies from LDAP user and group DNs",
+        "operationId": "DetachLDAPPolicies",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ldapPolicyAssociationRequest"
+            }
+          }
         ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginDetails"
+              "$ref": "#/definitions/ldapPolicyAssociationResponse"
             }
           },
           "default": {
@@ -2894,27 +3321,38 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [],
+      }
+    },
+    "/ldap-entities/search": {
+      "get": {
         "tags": [
-          "Auth"
+          "idp"
         ],
-        "summary": "Login to Console",
-        "operationId": "Login",
+        "summary": "Search the LDAP directory for user and group DNs with the lookup bind",
+        "operationId": "SearchLDAPEntities",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginRequest"
-            }
+            "type": "string",
+            "description": "Text the user and group names must contain",
+            "name": "query",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Restrict the results to ` + "`" + `user` + "`" + ` or ` + "`" + `group` + "`" + ` DNs",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful login."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/ldapEntitiesSearchResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2925,7 +3363,93 @@ func init() {
         }
       }
     },
-    "/login/oauth2/auth": {
+    "/list-external-buckets": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Lists an External list of buckets using custom credentials",
+        "operationId": "ListExternalBuckets",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/listExternalBucketsParams"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/login": {
+      "get": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/loginDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Login to Console",
+        "operationId": "Login",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful login."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/login/oauth2/auth": {
       "post": {
         "security": [],
         "tags": [
@@ -3211,6 +3735,72 @@ func init() {
         }
       }
     },
+    "/policy/lint": {
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Lint a policy document for risky or broken constructs",
+        "operationId": "LintPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lintPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/lintPolicyResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/policy/simulate": {
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Simulate the policy evaluation of a user, group or service account",
+        "operationId": "SimulatePolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/simulatePolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/simulatePolicyResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/policy/{name}": {
       "get": {
         "tags": [
@@ -3268,6 +3858,83 @@ func init() {
         }
       }
     },
+    "/policy/{name}/history": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Lists the changes of a policy along with the differences between versions",
+        "operationId": "ListPolicyHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyHistoryResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/policy/{name}/history/{version}/rollback": {
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Reverts a change of a policy restoring the document it replaced",
+        "operationId": "RollbackPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "version",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rollbackPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/releases": {
       "get": {
         "tags": [
@@ -3467,6 +4134,29 @@ func init() {
         }
       }
     },
+    "/service-account-notifications": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "List the expiry and rotation notifications of the service accounts",
+        "operationId": "ListServiceAccountNotifications",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountNotifications"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/service-accounts": {
       "get": {
         "tags": [
@@ -3870,34 +4560,26 @@ func init() {
         }
       }
     },
-    "/user/{name}/groups": {
-      "put": {
+    "/user/{name}/effective-permissions": {
+      "get": {
         "tags": [
           "User"
         ],
-        "summary": "Update Groups for a user",
-        "operationId": "UpdateUserGroups",
+        "summary": "returns the permissions a user effectively holds on every bucket",
+        "operationId": "GetUserEffectivePermissions",
         "parameters": [
           {
             "type": "string",
             "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUserGroups"
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/effectivePermissionsResponse"
             }
           },
           "default": {
@@ -3909,13 +4591,16 @@ func init() {
         }
       }
     },
-    "/user/{name}/policies": {
+    "/user/{name}/effective-permissions/csv": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Policy"
+          "User"
         ],
-        "summary": "returns policies assigned for a specified user",
-        "operationId": "GetSAUserPolicy",
+        "summary": "exports the permissions a user effectively holds on every bucket as CSV",
+        "operationId": "ExportUserEffectivePermissions",
         "parameters": [
           {
             "type": "string",
@@ -3928,7 +4613,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/aUserPolicyResponse"
+              "type": "file"
             }
           },
           "default": {
@@ -3940,8 +4625,114 @@ func init() {
         }
       }
     },
-    "/user/{name}/service-account-credentials": {
-      "post": {
+    "/user/{name}/expiry": {
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Set or clear the expiry of a user",
+        "operationId": "UpdateUserExpiry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/userExpiryRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/groups": {
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Update Groups for a user",
+        "operationId": "UpdateUserGroups",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/policies": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "returns policies assigned for a specified user",
+        "operationId": "GetSAUserPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/aUserPolicyResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/service-account-credentials": {
+      "post": {
         "tags": [
           "User"
         ],
@@ -4139,6 +4930,39 @@ func init() {
         }
       }
     },
+    "/users/provision": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Validates and creates a list of users, optionally generating their secret keys",
+        "operationId": "BulkProvisionUsers",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkProvisionUsersRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bulkProvisionUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/users/service-accounts": {
       "post": {
         "tags": [
@@ -4275,6 +5099,10 @@ func init() {
         "policy"
       ],
       "properties": {
+        "comment": {
+          "description": "Optional comment stored in the policy history",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
@@ -4295,6 +5123,10 @@ func init() {
         "accessKey": {
           "type": "string"
         },
+        "expiresAt": {
+          "description": "RFC 3339 date the user is disabled at",
+          "type": "string"
+        },
         "groups": {
           "type": "array",
           "items": {
@@ -4307,6 +5139,10 @@ func init() {
             "type": "string"
           }
         },
+        "retention": {
+          "description": "how long the user is kept once disabled before it is deleted, e.g. 720h, it is never deleted when empty",
+          "type": "string"
+        },
         "secretKey": {
           "type": "string"
         }
@@ -4349,6 +5185,165 @@ func init() {
         }
       }
     },
+    "alert": {
+      "type": "object",
+      "properties": {
+        "endsAt": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "rule": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string"
+        },
+        "silenced": {
+          "type": "boolean"
+        },
+        "startsAt": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "firing",
+            "resolved"
+          ]
+        },
+        "subject": {
+          "description": "What the alert is about, e.g. the drive, bucket or tier",
+          "type": "string"
+        }
+      }
+    },
+    "alertRule": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "type": "string",
+          "enum": [
+            "driveOffline",
+            "serverOffline",
+            "bucketQuota",
+            "kmsUnreachable",
+            "tierUnreachable",
+            "prometheus"
+          ]
+        },
+        "name": {
+          "type": "string"
+        },
+        "query": {
+          "description": "Prometheus query of the prometheus rules",
+          "type": "string"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "critical"
+          ]
+        },
+        "threshold": {
+          "description": "Quota percentage of the bucketQuota rules, value above which the series of the prometheus rules fire",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "alertSilence": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "endsAt": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "rule": {
+          "description": "Rule of the silenced alerts, any rule when empty",
+          "type": "string"
+        },
+        "startsAt": {
+          "type": "string"
+        },
+        "subject": {
+          "description": "Subject of the silenced alerts, ` + "`" + `*` + "`" + ` matches any characters",
+          "type": "string"
+        }
+      }
+    },
+    "alertSilenceRequest": {
+      "type": "object",
+      "required": [
+        "duration"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "duration": {
+          "description": "How long the alerts are silenced, e.g. ` + "`" + `2h` + "`" + `",
+          "type": "string"
+        },
+        "rule": {
+          "type": "string"
+        },
+        "subject": {
+          "type": "string"
+        }
+      }
+    },
+    "alertsResponse": {
+      "type": "object",
+      "properties": {
+        "alerts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alert"
+          }
+        },
+        "enabled": {
+          "description": "Whether the alert rules are evaluated",
+          "type": "boolean"
+        },
+        "history": {
+          "description": "Recently resolved alerts, newest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alert"
+          }
+        },
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertRule"
+          }
+        },
+        "silences": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertSilence"
+          }
+        }
+      }
+    },
     "apiKey": {
       "type": "object",
       "properties": {
@@ -4455,17 +5450,132 @@ func init() {
         "CUSTOM"
       ]
     },
-    "bucketEncryptionInfo": {
+    "bucketAccessGrant": {
       "type": "object",
       "properties": {
-        "algorithm": {
+        "access": {
           "type": "string"
         },
-        "kmsMasterKeyID": {
-          "type": "string"
-        }
-      }
-    },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketAccessGrantRequest": {
+      "type": "object",
+      "required": [
+        "access"
+      ],
+      "properties": {
+        "access": {
+          "type": "string",
+          "enum": [
+            "readonly",
+            "writeonly",
+            "readwrite"
+          ]
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketAccessGrantsResponse": {
+      "type": "object",
+      "properties": {
+        "grants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketAccessGrant"
+          }
+        }
+      }
+    },
+    "bucketAccessRevokeRequest": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "users": {
+          "description": "users losing the access, every user and group loses it when both users and groups are empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketEffectivePermissions": {
+      "type": "object",
+      "properties": {
+        "admin": {
+          "type": "string"
+        },
+        "allowedActions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "delete": {
+          "type": "string"
+        },
+        "read": {
+          "type": "string"
+        },
+        "write": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketEncryptionInfo": {
+      "type": "object",
+      "properties": {
+        "algorithm": {
+          "type": "string"
+        },
+        "kmsMasterKeyID": {
+          "type": "string"
+        }
+      }
+    },
     "bucketEncryptionRequest": {
       "type": "object",
       "properties": {
@@ -4702,6 +5812,92 @@ func init() {
         }
       }
     },
+    "bulkProvisionUser": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretKey": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "bulkProvisionUserResult": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "row": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "bulkProvisionUsersRequest": {
+      "type": "object",
+      "properties": {
+        "csv": {
+          "description": "CSV rows with a header, groups and policies are separated by semicolons",
+          "type": "string"
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "generateSecrets": {
+          "type": "boolean"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bulkProvisionUser"
+          }
+        }
+      }
+    },
+    "bulkProvisionUsersResponse": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "type": "boolean"
+        },
+        "credentialsFile": {
+          "description": "CSV file with the generated secret keys, only returned once",
+          "type": "string"
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bulkProvisionUserResult"
+          }
+        }
+      }
+    },
     "bulkUserGroups": {
       "type": "object",
       "required": [
@@ -4862,6 +6058,72 @@ func init() {
         }
       }
     },
+    "customWidget": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "Namespaced ID of the widget, ` + "`" + `\u003cnamespace\u003e/\u003cwidgetId\u003e` + "`" + `",
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "source": {
+          "description": "Where the widget is defined, ` + "`" + `file` + "`" + ` or ` + "`" + `bucket` + "`" + `",
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "widgetId": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "customWidgetTestRequest": {
+      "type": "object",
+      "required": [
+        "definition"
+      ],
+      "properties": {
+        "definition": {
+          "description": "YAML or JSON definition of the widget",
+          "type": "string"
+        },
+        "end": {
+          "type": "integer"
+        },
+        "start": {
+          "type": "integer"
+        },
+        "step": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "customWidgetsResponse": {
+      "type": "object",
+      "properties": {
+        "errors": {
+          "description": "Errors of the widget definitions that couldn't be loaded",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/customWidget"
+          }
+        }
+      }
+    },
     "deleteFile": {
       "type": "object",
       "properties": {
@@ -4876,6 +6138,32 @@ func init() {
         }
       }
     },
+    "effectivePermissionsResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketEffectivePermissions"
+          }
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedPolicy"
+          }
+        },
+        "user": {
+          "type": "string"
+        }
+      }
+    },
     "envOverride": {
       "type": "object",
       "properties": {
@@ -4933,13 +6221,185 @@ func init() {
         }
       }
     },
-    "iamEntity": {
-      "type": "string"
-    },
-    "iamPolicy": {
+    "iamBundle": {
       "type": "object",
       "properties": {
-        "statement": {
+        "exportedAt": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamBundleGroup"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamBundlePolicy"
+          }
+        },
+        "policyMappings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamBundlePolicyMapping"
+          }
+        },
+        "serviceAccounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamBundleServiceAccount"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamBundleUser"
+          }
+        },
+        "version": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "iamBundleGroup": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "iamBundlePolicy": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "iamBundlePolicyMapping": {
+      "type": "object",
+      "properties": {
+        "entity": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "iamBundleServiceAccount": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "impliedPolicy": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "parentUser": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "iamBundleUser": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "iamEntity": {
+      "type": "string"
+    },
+    "iamImportChange": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "detail": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "iamImportRequest": {
+      "type": "object",
+      "properties": {
+        "bundle": {
+          "$ref": "#/definitions/iamBundle"
+        },
+        "conflictStrategy": {
+          "type": "string"
+        },
+        "dryRun": {
+          "type": "boolean"
+        }
+      }
+    },
+    "iamImportResponse": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamImportChange"
+          }
+        },
+        "dryRun": {
+          "type": "boolean"
+        }
+      }
+    },
+    "iamPolicy": {
+      "type": "object",
+      "properties": {
+        "statement": {
           "type": "array",
           "items": {
             "$ref": "#/definitions/iamPolicyStatement"
@@ -5266,6 +6726,34 @@ func init() {
         }
       }
     },
+    "ldapEntitiesSearchResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ldapEntitySearchResult"
+          }
+        }
+      }
+    },
+    "ldapEntitySearchResult": {
+      "type": "object",
+      "properties": {
+        "dn": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "ldapGroupPolicyEntity": {
       "type": "object",
       "properties": {
@@ -5280,6 +6768,69 @@ func init() {
         }
       }
     },
+    "ldapPolicyAssociationRequest": {
+      "type": "object",
+      "required": [
+        "policies"
+      ],
+      "properties": {
+        "groups": {
+          "description": "Group DNs",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "users": {
+          "description": "User DNs",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ldapPolicyAssociationResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ldapPolicyAssociationResult"
+          }
+        }
+      }
+    },
+    "ldapPolicyAssociationResult": {
+      "type": "object",
+      "properties": {
+        "dn": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "policies": {
+          "description": "Policies actually attached or detached",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
     "ldapPolicyEntity": {
       "type": "object",
       "properties": {
@@ -5337,6 +6888,32 @@ func init() {
         }
       }
     },
+    "lintPolicyRequest": {
+      "type": "object",
+      "required": [
+        "policy"
+      ],
+      "properties": {
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "lintPolicyResponse": {
+      "type": "object",
+      "properties": {
+        "findings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyLintFinding"
+          }
+        },
+        "valid": {
+          "description": "False when any of the findings is an error",
+          "type": "boolean"
+        }
+      }
+    },
     "listAccessRulesResponse": {
       "type": "object",
       "properties": {
@@ -5632,14 +7209,34 @@ func init() {
         }
       }
     },
-    "maxShareLinkExpResponse": {
+    "matchedStatement": {
       "type": "object",
-      "required": [
-        "exp"
-      ],
       "properties": {
-        "exp": {
-          "type": "number",
+        "effect": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "sid": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string"
+        },
+        "statement": {
+          "type": "string"
+        }
+      }
+    },
+    "maxShareLinkExpResponse": {
+      "type": "object",
+      "required": [
+        "exp"
+      ],
+      "properties": {
+        "exp": {
+          "type": "number",
           "format": "int64"
         }
       }
@@ -6043,6 +7640,10 @@ func init() {
     "policy": {
       "type": "object",
       "properties": {
+        "historyError": {
+          "description": "Why the change of the policy couldn't be recorded in its history, empty when it was",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
@@ -6065,6 +7666,23 @@ func init() {
         }
       }
     },
+    "policyDiffEntry": {
+      "type": "object",
+      "properties": {
+        "from": {
+          "type": "string"
+        },
+        "op": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "to": {
+          "type": "string"
+        }
+      }
+    },
     "policyEntity": {
       "type": "string",
       "default": "user",
@@ -6073,6 +7691,90 @@ func init() {
         "group"
       ]
     },
+    "policyHistoryEntry": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "diff": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyDiffEntry"
+          }
+        },
+        "document": {
+          "type": "string"
+        },
+        "editor": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "previousDocument": {
+          "type": "string"
+        },
+        "timestamp": {
+          "type": "string"
+        },
+        "version": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "policyHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyHistoryEntry"
+          }
+        },
+        "errors": {
+          "description": "The changes that can't be read, e.g. because they weren't recorded by the console",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "policyLintFinding": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "error",
+            "warning",
+            "info"
+          ]
+        },
+        "sid": {
+          "type": "string"
+        },
+        "statement": {
+          "description": "Index of the statement the finding refers to, -1 for the whole document",
+          "type": "integer",
+          "format": "int32"
+        },
+        "suggestion": {
+          "type": "string"
+        }
+      }
+    },
     "prefixAccessPair": {
       "type": "object",
       "properties": {
@@ -6462,6 +8164,14 @@ func init() {
         }
       }
     },
+    "rollbackPolicyRequest": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "type": "string"
+        }
+      }
+    },
     "selectedSAs": {
       "type": "array",
       "items": {
@@ -6584,6 +8294,63 @@ func init() {
         }
       }
     },
+    "serviceAccountNotification": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "deleteAfter": {
+          "description": "When the rotated access key gets deleted",
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "expiring",
+            "expired",
+            "aged",
+            "rotated",
+            "rotationFailed",
+            "retired"
+          ]
+        },
+        "message": {
+          "type": "string"
+        },
+        "newAccessKey": {
+          "description": "Access key replacing a rotated one",
+          "type": "string"
+        },
+        "parentUser": {
+          "type": "string"
+        },
+        "secretKey": {
+          "description": "Secret key of the replacing access key, only returned once to its owner by the console that rotated it",
+          "type": "string"
+        },
+        "time": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountNotifications": {
+      "type": "object",
+      "properties": {
+        "notifications": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceAccountNotification"
+          }
+        }
+      }
+    },
     "serviceAccountRequest": {
       "type": "object",
       "properties": {
@@ -6875,6 +8642,120 @@ func init() {
         }
       }
     },
+    "simulatePolicyRequest": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "entityName",
+        "actions",
+        "resource"
+      ],
+      "properties": {
+        "actions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "conditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationCondition"
+          }
+        },
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "$ref": "#/definitions/simulationEntity"
+        },
+        "resource": {
+          "type": "string"
+        }
+      }
+    },
+    "simulatePolicyResponse": {
+      "type": "object",
+      "properties": {
+        "findings": {
+          "description": "Issues found getting the policies of the entity, e.g. a mapped policy missing on the server",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedPolicy"
+          }
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedActionResult"
+          }
+        }
+      }
+    },
+    "simulatedActionResult": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "allowed": {
+          "type": "boolean"
+        },
+        "matchedStatements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/matchedStatement"
+          }
+        },
+        "reason": {
+          "description": "Why the action is denied regardless of the policies, e.g. the account is disabled",
+          "type": "string"
+        }
+      }
+    },
+    "simulatedPolicy": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string"
+        }
+      }
+    },
+    "simulationCondition": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "values": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "simulationEntity": {
+      "type": "string",
+      "default": "user",
+      "enum": [
+        "user",
+        "group",
+        "serviceAccount"
+      ]
+    },
     "siteReplicationAddRequest": {
       "type": "array",
       "items": {
@@ -6956,27 +8837,129 @@ func init() {
         }
       }
     },
-    "startProfilingItem": {
+    "staleIdentityCleanupItem": {
       "type": "object",
       "properties": {
-        "error": {
+        "entityType": {
           "type": "string"
         },
-        "nodeName": {
+        "name": {
           "type": "string"
-        },
-        "success": {
-          "type": "boolean"
         }
       }
     },
-    "startProfilingList": {
+    "staleIdentityCleanupRequest": {
       "type": "object",
+      "required": [
+        "items"
+      ],
       "properties": {
-        "startResults": {
+        "disabledDays": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "items": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/startProfilingItem"
+            "$ref": "#/definitions/staleIdentityCleanupItem"
+          }
+        }
+      }
+    },
+    "staleIdentityCleanupResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/staleIdentityCleanupResult"
+          }
+        }
+      }
+    },
+    "staleIdentityCleanupResult": {
+      "type": "object",
+      "properties": {
+        "entityType": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "staleIdentityFinding": {
+      "type": "object",
+      "properties": {
+        "cleanupAction": {
+          "type": "string"
+        },
+        "detail": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "userWithoutPolicy",
+            "emptyGroup",
+            "unusedPolicy",
+            "disabledUser",
+            "orphanServiceAccount"
+          ]
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "staleIdentityReport": {
+      "type": "object",
+      "properties": {
+        "disabledDays": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "findings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/staleIdentityFinding"
+          }
+        },
+        "generatedAt": {
+          "type": "string"
+        }
+      }
+    },
+    "startProfilingItem": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "nodeName": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "startProfilingList": {
+      "type": "object",
+      "properties": {
+        "startResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/startProfilingItem"
           }
         },
         "total": {
@@ -7068,6 +9051,12 @@ func init() {
         "accessKey": {
           "type": "string"
         },
+        "deleteAt": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string"
+        },
         "hasPolicy": {
           "type": "boolean"
         },
@@ -7088,6 +9077,19 @@ func init() {
         }
       }
     },
+    "userExpiryRequest": {
+      "type": "object",
+      "properties": {
+        "expiresAt": {
+          "description": "RFC 3339 date the user is disabled at, the expiry is cleared when empty",
+          "type": "string"
+        },
+        "retention": {
+          "description": "how long the user is kept once disabled before it is deleted, e.g. 720h, it is never deleted when empty",
+          "type": "string"
+        }
+      }
+    },
     "userSAs": {
       "type": "object",
       "properties": {
@@ -7332,18 +9334,18 @@ func init() {
         }
       }
     },
-    "/admin/arns": {
+    "/admin/alerts": {
       "get": {
         "tags": [
           "System"
         ],
-        "summary": "Returns a list of active ARNs in the instance",
-        "operationId": "ArnList",
+        "summary": "Returns the firing and recently resolved alerts, the alert rules and the silences",
+        "operationId": "ListAlerts",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/arnsResponse"
+              "$ref": "#/definitions/alertsResponse"
             }
           },
           "default": {
@@ -7355,26 +9357,28 @@ func init() {
         }
       }
     },
-    "/admin/info": {
-      "get": {
+    "/admin/alerts/silences": {
+      "post": {
         "tags": [
           "System"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "AdminInfo",
+        "summary": "Silences the alerts matching a rule and a subject",
+        "operationId": "CreateAlertSilence",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "name": "defaultOnly",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertSilenceRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/adminInfoResponse"
+              "$ref": "#/definitions/alertSilence"
             }
           },
           "default": {
@@ -7386,43 +9390,46 @@ func init() {
         }
       }
     },
-    "/admin/info/widgets/{widgetId}": {
-      "get": {
+    "/admin/alerts/silences/{id}": {
+      "delete": {
         "tags": [
           "System"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "DashboardWidgetDetails",
+        "summary": "Removes an alert silence",
+        "operationId": "DeleteAlertSilence",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "widgetId",
+            "type": "string",
+            "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
-          {
-            "type": "integer",
-            "name": "start",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "end",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "step",
-            "in": "query"
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
+        }
+      }
+    },
+    "/admin/arns": {
+      "get": {
+        "tags": [
+          "System"
         ],
+        "summary": "Returns a list of active ARNs in the instance",
+        "operationId": "ArnList",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/widgetDetails"
+              "$ref": "#/definitions/arnsResponse"
             }
           },
           "default": {
@@ -7434,32 +9441,18 @@ func init() {
         }
       }
     },
-    "/admin/inspect": {
+    "/admin/info": {
       "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
-          "Inspect"
+          "System"
         ],
-        "summary": "Inspect Files on Drive",
-        "operationId": "Inspect",
+        "summary": "Returns information about the deployment",
+        "operationId": "AdminInfo",
         "parameters": [
-          {
-            "type": "string",
-            "name": "file",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "volume",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "boolean",
-            "name": "encrypt",
+            "default": false,
+            "name": "defaultOnly",
             "in": "query"
           }
         ],
@@ -7467,7 +9460,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/adminInfoResponse"
             }
           },
           "default": {
@@ -7479,18 +9472,18 @@ func init() {
         }
       }
     },
-    "/admin/notification_endpoints": {
+    "/admin/info/custom-widgets": {
       "get": {
         "tags": [
-          "Configuration"
+          "System"
         ],
-        "summary": "Returns a list of active notification endpoints",
-        "operationId": "NotificationEndpointList",
+        "summary": "Returns the user-defined dashboard widgets",
+        "operationId": "ListCustomWidgets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/notifEndpointResponse"
+              "$ref": "#/definitions/customWidgetsResponse"
             }
           },
           "default": {
@@ -7500,28 +9493,30 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/admin/info/custom-widgets/test": {
       "post": {
         "tags": [
-          "Configuration"
+          "System"
         ],
-        "summary": "Allows to configure a new notification endpoint",
-        "operationId": "AddNotificationEndpoint",
+        "summary": "Validates a dashboard widget definition and returns the results of its queries",
+        "operationId": "TestCustomWidget",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/notificationEndpoint"
+              "$ref": "#/definitions/customWidgetTestRequest"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setNotificationEndpointResponse"
+              "$ref": "#/definitions/widgetDetails"
             }
           },
           "default": {
@@ -7533,32 +9528,41 @@ func init() {
         }
       }
     },
-    "/bucket-policy/{bucket}": {
+    "/admin/info/custom-widgets/{namespace}/{widgetId}": {
       "get": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "List Policies With Given Bucket",
-        "operationId": "ListPoliciesWithBucket",
+        "summary": "Returns the results of a user-defined dashboard widget",
+        "operationId": "CustomWidgetDetails",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "namespace",
             "in": "path",
             "required": true
           },
           {
-            "type": "number",
+            "type": "integer",
             "format": "int32",
-            "default": 0,
-            "name": "offset",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "name": "start",
             "in": "query"
           },
           {
-            "type": "number",
+            "type": "integer",
+            "name": "end",
+            "in": "query"
+          },
+          {
+            "type": "integer",
             "format": "int32",
-            "default": 20,
-            "name": "limit",
+            "name": "step",
             "in": "query"
           }
         ],
@@ -7566,7 +9570,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
+              "$ref": "#/definitions/widgetDetails"
             }
           },
           "default": {
@@ -7578,32 +9582,35 @@ func init() {
         }
       }
     },
-    "/bucket-users/{bucket}": {
+    "/admin/info/widgets/{widgetId}": {
       "get": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "List Users With Access to a Given Bucket",
-        "operationId": "ListUsersWithAccessToBucket",
+        "summary": "Returns information about the deployment",
+        "operationId": "DashboardWidgetDetails",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket",
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
             "in": "path",
             "required": true
           },
           {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "offset",
+            "type": "integer",
+            "name": "start",
             "in": "query"
           },
           {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
+            "type": "integer",
+            "name": "end",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "step",
             "in": "query"
           }
         ],
@@ -7611,10 +9618,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/widgetDetails"
             }
           },
           "default": {
@@ -7626,32 +9630,32 @@ func init() {
         }
       }
     },
-    "/bucket/{bucket}/access-rules": {
+    "/admin/inspect": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Bucket"
+          "Inspect"
         ],
-        "summary": "List Access Rules With Given Bucket",
-        "operationId": "ListAccessRulesWithBucket",
+        "summary": "Inspect Files on Drive",
+        "operationId": "Inspect",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
-            "in": "path",
+            "name": "file",
+            "in": "query",
             "required": true
           },
           {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "volume",
+            "in": "query",
+            "required": true
           },
           {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
+            "type": "boolean",
+            "name": "encrypt",
             "in": "query"
           }
         ],
@@ -7659,7 +9663,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listAccessRulesResponse"
+              "type": "file"
             }
           },
           "default": {
@@ -7669,34 +9673,20 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/admin/notification_endpoints": {
+      "get": {
         "tags": [
-          "Bucket"
-        ],
-        "summary": "Add Access Rule To Given Bucket",
-        "operationId": "SetAccessRuleWithBucket",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "prefixaccess",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/prefixAccessPair"
-            }
-          }
+          "Configuration"
         ],
+        "summary": "Returns a list of active notification endpoints",
+        "operationId": "NotificationEndpointList",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "boolean"
+              "$ref": "#/definitions/notifEndpointResponse"
             }
           },
           "default": {
@@ -7707,33 +9697,27 @@ func init() {
           }
         }
       },
-      "delete": {
+      "post": {
         "tags": [
-          "Bucket"
+          "Configuration"
         ],
-        "summary": "Delete Access Rule From Given Bucket",
-        "operationId": "DeleteAccessRuleWithBucket",
+        "summary": "Allows to configure a new notification endpoint",
+        "operationId": "AddNotificationEndpoint",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "prefix",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/prefixWrapper"
+              "$ref": "#/definitions/notificationEndpoint"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "type": "boolean"
+              "$ref": "#/definitions/setNotificationEndpointResponse"
             }
           },
           "default": {
@@ -7745,49 +9729,40 @@ func init() {
         }
       }
     },
-    "/buckets": {
+    "/bucket-policy/{bucket}": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "List Buckets",
-        "operationId": "ListBuckets",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
-        }
-      },
-      "post": {
-        "tags": [
-          "Bucket"
-        ],
-        "summary": "Make bucket",
-        "operationId": "MakeBucket",
+        "summary": "List Policies With Given Bucket",
+        "operationId": "ListPoliciesWithBucket",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/makeBucketRequest"
-            }
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/makeBucketsResponse"
+              "$ref": "#/definitions/listPoliciesResponse"
             }
           },
           "default": {
@@ -7799,28 +9774,43 @@ func init() {
         }
       }
     },
-    "/buckets-replication": {
-      "post": {
+    "/bucket-users/{bucket}": {
+      "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Sets Multi Bucket Replication in multiple Buckets",
-        "operationId": "SetMultiBucketReplication",
+        "summary": "List Users With Access to a Given Bucket",
+        "operationId": "ListUsersWithAccessToBucket",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/multiBucketReplication"
-            }
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/multiBucketResponseState"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "default": {
@@ -7832,18 +9822,26 @@ func init() {
         }
       }
     },
-    "/buckets/max-share-exp": {
+    "/bucket/{bucket}/access-grants": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Get max expiration time for share link in seconds",
-        "operationId": "GetMaxShareLinkExp",
+        "summary": "List the users and groups granted access to a prefix of the bucket",
+        "operationId": "ListBucketAccessGrants",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/maxShareLinkExpResponse"
+              "$ref": "#/definitions/bucketAccessGrantsResponse"
             }
           },
           "default": {
@@ -7853,26 +9851,35 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/delete-all-replication-rules": {
-      "delete": {
+      },
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Deletes all replication rules from a bucket",
-        "operationId": "DeleteAllReplicationRules",
+        "summary": "Grant users and groups access to a prefix of the bucket",
+        "operationId": "GrantBucketAccess",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketAccessGrantRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketAccessGrant"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -7883,44 +9890,31 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-objects": {
+    "/bucket/{bucket}/access-grants/revoke": {
       "post": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Delete Multiple Objects",
-        "operationId": "DeleteMultipleObjects",
+        "summary": "Revoke the access of users and groups to a prefix of the bucket",
+        "operationId": "RevokeBucketAccess",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "bypass",
-            "in": "query"
-          },
-          {
-            "name": "files",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/deleteFile"
-              }
+              "$ref": "#/definitions/bucketAccessRevokeRequest"
             }
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -7932,32 +9926,41 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-selected-replication-rules": {
-      "delete": {
+    "/bucket/{bucket}/access-rules": {
+      "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Deletes selected replication rules from a bucket",
-        "operationId": "DeleteSelectedReplicationRules",
-        "parameters": [
+        "summary": "List Access Rules With Given Bucket",
+        "operationId": "ListAccessRulesWithBucket",
+        "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "name": "rules",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bucketReplicationRuleList"
-            }
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listAccessRulesResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -7966,26 +9969,35 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/encryption/disable": {
-      "post": {
+      },
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Disable bucket encryption.",
-        "operationId": "DisableBucketEncryption",
+        "summary": "Add Access Rule To Given Bucket",
+        "operationId": "SetAccessRuleWithBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "prefixaccess",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/prefixAccessPair"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "boolean"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -7994,34 +10006,35 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/encryption/enable": {
-      "post": {
+      },
+      "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Enable bucket encryption.",
-        "operationId": "EnableBucketEncryption",
+        "summary": "Delete Access Rule From Given Bucket",
+        "operationId": "DeleteAccessRuleWithBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
+            "name": "prefix",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bucketEncryptionRequest"
+              "$ref": "#/definitions/prefixWrapper"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "boolean"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8032,26 +10045,18 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/info": {
+    "/buckets": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Get bucket encryption information.",
-        "operationId": "GetBucketEncryptionInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "List Buckets",
+        "operationId": "ListBuckets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketEncryptionInfo"
+              "$ref": "#/definitions/listBucketsResponse"
             }
           },
           "default": {
@@ -8061,42 +10066,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/events": {
-      "get": {
+      },
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "List Bucket Events",
-        "operationId": "ListBucketEvents",
+        "summary": "Make bucket",
+        "operationId": "MakeBucket",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/makeBucketRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketEventsResponse"
+              "$ref": "#/definitions/makeBucketsResponse"
             }
           },
           "default": {
@@ -8106,32 +10097,31 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/buckets-replication": {
       "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Create Bucket Event",
-        "operationId": "CreateBucketEvent",
+        "summary": "Sets Multi Bucket Replication in multiple Buckets",
+        "operationId": "SetMultiBucketReplication",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bucketEventRequest"
+              "$ref": "#/definitions/multiBucketReplication"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/multiBucketResponseState"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8142,38 +10132,19 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/events/{arn}": {
-      "delete": {
+    "/buckets/max-share-exp": {
+      "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Delete Bucket Event",
-        "operationId": "DeleteBucketEvent",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "arn",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+        "summary": "Get max expiration time for share link in seconds",
+        "operationId": "GetMaxShareLinkExp",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/notificationDeleteRequest"
+              "$ref": "#/definitions/maxShareLinkExpResponse"
             }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -8184,13 +10155,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/object-locking": {
-      "get": {
+    "/buckets/{bucket_name}/delete-all-replication-rules": {
+      "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Returns the status of object locking support on the bucket",
-        "operationId": "GetBucketObjectLockingStatus",
+        "summary": "Deletes all replication rules from a bucket",
+        "operationId": "DeleteAllReplicationRules",
         "parameters": [
           {
             "type": "string",
@@ -8200,11 +10171,8 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketObLockingResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -8215,21 +10183,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects": {
-      "get": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
+    "/buckets/{bucket_name}/delete-objects": {
+      "post": {
         "tags": [
           "Object"
         ],
-        "summary": "List Objects",
-        "operationId": "ListObjects",
+        "summary": "Delete Multiple Objects",
+        "operationId": "DeleteMultipleObjects",
         "parameters": [
           {
             "type": "string",
@@ -8237,40 +10197,31 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
           {
             "type": "boolean",
-            "name": "with_versions",
+            "name": "all_versions",
             "in": "query"
           },
           {
             "type": "boolean",
-            "name": "with_metadata",
+            "name": "bypass",
             "in": "query"
           },
           {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
-            "in": "query"
+            "name": "files",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/deleteFile"
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listObjectsResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -8279,13 +10230,15 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/buckets/{bucket_name}/delete-selected-replication-rules": {
       "delete": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Delete Object",
-        "operationId": "DeleteObject",
+        "summary": "Deletes selected replication rules from a bucket",
+        "operationId": "DeleteSelectedReplicationRules",
         "parameters": [
           {
             "type": "string",
@@ -8294,39 +10247,16 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "non_current_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "bypass",
-            "in": "query"
+            "name": "rules",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRuleList"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -8338,61 +10268,24 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download": {
-      "get": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/buckets/{bucket_name}/encryption/disable": {
+      "post": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Download Object",
-        "operationId": "Download Object",
+        "summary": "Disable bucket encryption.",
+        "operationId": "DisableBucketEncryption",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "name": "preview",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "default": "",
-            "name": "override_file_name",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "file"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -8403,24 +10296,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download-multiple": {
+    "/buckets/{bucket_name}/encryption/enable": {
       "post": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Download Multiple Objects",
-        "operationId": "DownloadMultipleObjects",
+        "summary": "Enable bucket encryption.",
+        "operationId": "EnableBucketEncryption",
         "parameters": [
           {
             "type": "string",
@@ -8429,23 +10311,17 @@ func init() {
             "required": true
           },
           {
-            "name": "objectList",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/bucketEncryptionRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "file"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -8456,44 +10332,27 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/legalhold": {
-      "put": {
+    "/buckets/{bucket_name}/encryption/info": {
+      "get": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Put Object's legalhold status",
-        "operationId": "PutObjectLegalHold",
+        "summary": "Get bucket encryption information.",
+        "operationId": "GetBucketEncryptionInfo",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectLegalHoldRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketEncryptionInfo"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8504,13 +10363,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/metadata": {
+    "/buckets/{bucket_name}/events": {
       "get": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Gets the metadata of an object",
-        "operationId": "GetObjectMetadata",
+        "summary": "List Bucket Events",
+        "operationId": "ListBucketEvents",
         "parameters": [
           {
             "type": "string",
@@ -8519,14 +10378,17 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
           },
           {
-            "type": "string",
-            "name": "versionID",
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
             "in": "query"
           }
         ],
@@ -8534,7 +10396,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/metadata"
+              "$ref": "#/definitions/listBucketEventsResponse"
             }
           },
           "default": {
@@ -8544,15 +10406,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/objects/restore": {
-      "put": {
+      },
+      "post": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Restore Object to a selected version",
-        "operationId": "PutObjectRestore",
+        "summary": "Create Bucket Event",
+        "operationId": "CreateBucketEvent",
         "parameters": [
           {
             "type": "string",
@@ -8561,20 +10421,16 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEventRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -8586,13 +10442,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/retention": {
-      "put": {
+    "/buckets/{bucket_name}/events/{arn}": {
+      "delete": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Put Object's retention status",
-        "operationId": "PutObjectRetention",
+        "summary": "Delete Bucket Event",
+        "operationId": "DeleteBucketEvent",
         "parameters": [
           {
             "type": "string",
@@ -8602,14 +10458,8 @@ func init() {
           },
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
+            "name": "arn",
+            "in": "path",
             "required": true
           },
           {
@@ -8617,12 +10467,12 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putObjectRetentionRequest"
+              "$ref": "#/definitions/notificationDeleteRequest"
             }
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -8632,36 +10482,29 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/buckets/{bucket_name}/object-locking": {
+      "get": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Delete Object retention from an object",
-        "operationId": "DeleteObjectRetention",
+        "summary": "Returns the status of object locking support on the bucket",
+        "operationId": "GetBucketObjectLockingStatus",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketObLockingResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8672,13 +10515,21 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/share": {
+    "/buckets/{bucket_name}/objects": {
       "get": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
         "tags": [
           "Object"
         ],
-        "summary": "Shares an Object on a url",
-        "operationId": "ShareObject",
+        "summary": "List Objects",
+        "operationId": "ListObjects",
         "parameters": [
           {
             "type": "string",
@@ -8689,18 +10540,28 @@ func init() {
           {
             "type": "string",
             "name": "prefix",
-            "in": "query",
-            "required": true
+            "in": "query"
           },
           {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
           },
           {
-            "type": "string",
-            "name": "expires",
+            "type": "boolean",
+            "name": "with_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_metadata",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
             "in": "query"
           }
         ],
@@ -8708,7 +10569,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/listObjectsResponse"
             }
           },
           "default": {
@@ -8718,15 +10579,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/objects/tags": {
-      "put": {
+      },
+      "delete": {
         "tags": [
           "Object"
         ],
-        "summary": "Put Object's tags",
-        "operationId": "PutObjectTags",
+        "summary": "Delete Object",
+        "operationId": "DeleteObject",
         "parameters": [
           {
             "type": "string",
@@ -8743,16 +10602,27 @@ func init() {
           {
             "type": "string",
             "name": "version_id",
-            "in": "query",
-            "required": true
+            "in": "query"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectTagsRequest"
-            }
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "non_current_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "bypass",
+            "in": "query"
           }
         ],
         "responses": {
@@ -8768,8 +10638,8 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/upload": {
-      "post": {
+    "/buckets/{bucket_name}/objects/download": {
+      "get": {
         "security": [
           {
             "key": []
@@ -8778,13 +10648,14 @@ func init() {
             "anonymous": []
           }
         ],
-        "consumes": [
-          "multipart/form-data"
+        "produces": [
+          "application/octet-stream"
         ],
         "tags": [
           "Object"
         ],
-        "summary": "Uploads an Object.",
+        "summary": "Download Object",
+        "operationId": "Download Object",
         "parameters": [
           {
             "type": "string",
@@ -8795,12 +10666,33 @@ func init() {
           {
             "type": "string",
             "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "name": "preview",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "default": "",
+            "name": "override_file_name",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8811,26 +10703,48 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/replication": {
-      "get": {
+    "/buckets/{bucket_name}/objects/download-multiple": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplication",
+        "summary": "Download Multiple Objects",
+        "operationId": "DownloadMultipleObjects",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "objectList",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketReplicationResponse"
+              "type": "file"
             }
           },
           "default": {
@@ -8842,13 +10756,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/replication/{rule_id}": {
-      "get": {
+    "/buckets/{bucket_name}/objects/legalhold": {
+      "put": {
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplicationRule",
+        "summary": "Put Object's legalhold status",
+        "operationId": "PutObjectLegalHold",
         "parameters": [
           {
             "type": "string",
@@ -8858,17 +10772,28 @@ func init() {
           },
           {
             "type": "string",
-            "name": "rule_id",
-            "in": "path",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectLegalHoldRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketReplicationRule"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -8877,13 +10802,15 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/metadata": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Update Replication rule",
-        "operationId": "UpdateMultiBucketReplication",
+        "summary": "Gets the metadata of an object",
+        "operationId": "GetObjectMetadata",
         "parameters": [
           {
             "type": "string",
@@ -8893,22 +10820,22 @@ func init() {
           },
           {
             "type": "string",
-            "name": "rule_id",
-            "in": "path",
+            "name": "prefix",
+            "in": "query",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/multiBucketReplicationEdit"
-            }
+            "type": "string",
+            "name": "versionID",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/metadata"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8917,13 +10844,15 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/restore": {
+      "put": {
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Bucket Replication Rule Delete",
-        "operationId": "DeleteBucketReplicationRule",
+        "summary": "Restore Object to a selected version",
+        "operationId": "PutObjectRestore",
         "parameters": [
           {
             "type": "string",
@@ -8933,13 +10862,19 @@ func init() {
           },
           {
             "type": "string",
-            "name": "rule_id",
-            "in": "path",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
             "required": true
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -8951,27 +10886,44 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/retention": {
-      "get": {
+    "/buckets/{bucket_name}/objects/retention": {
+      "put": {
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Get Bucket's retention config",
-        "operationId": "GetBucketRetentionConfig",
+        "summary": "Put Object's retention status",
+        "operationId": "PutObjectRetention",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectRetentionRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/getBucketRetentionConfig"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -8981,12 +10933,12 @@ func init() {
           }
         }
       },
-      "put": {
+      "delete": {
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Set Bucket's retention config",
-        "operationId": "SetBucketRetentionConfig",
+        "summary": "Delete Object retention from an object",
+        "operationId": "DeleteObjectRetention",
         "parameters": [
           {
             "type": "string",
@@ -8995,12 +10947,16 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putBucketRetentionRequest"
-            }
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
@@ -9016,13 +10972,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/rewind/{date}": {
+    "/buckets/{bucket_name}/objects/share": {
       "get": {
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Get objects in a bucket for a rewind date",
-        "operationId": "GetBucketRewind",
+        "summary": "Shares an Object on a url",
+        "operationId": "ShareObject",
         "parameters": [
           {
             "type": "string",
@@ -9032,13 +10988,19 @@ func init() {
           },
           {
             "type": "string",
-            "name": "date",
-            "in": "path",
+            "name": "prefix",
+            "in": "query",
             "required": true
           },
           {
             "type": "string",
-            "name": "prefix",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "expires",
             "in": "query"
           }
         ],
@@ -9046,7 +11008,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/rewindResponse"
+              "type": "string"
             }
           },
           "default": {
@@ -9058,13 +11020,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/tags": {
+    "/buckets/{bucket_name}/objects/tags": {
       "put": {
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Put Bucket's tags",
-        "operationId": "PutBucketTags",
+        "summary": "Put Object's tags",
+        "operationId": "PutObjectTags",
         "parameters": [
           {
             "type": "string",
@@ -9072,12 +11034,24 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putBucketTagsRequest"
+              "$ref": "#/definitions/putObjectTagsRequest"
             }
           }
         ],
@@ -9094,27 +11068,39 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/versioning": {
-      "get": {
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Bucket Versioning",
-        "operationId": "GetBucketVersioning",
+        "summary": "Uploads an Object.",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketVersioningResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9123,32 +11109,29 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/replication": {
+      "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Set Bucket Versioning",
-        "operationId": "SetBucketVersioning",
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplication",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketVersioning"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -9159,17 +11142,23 @@ func init() {
         }
       }
     },
-    "/buckets/{name}": {
+    "/buckets/{bucket_name}/replication/{rule_id}": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Info",
-        "operationId": "BucketInfo",
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplicationRule",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
             "in": "path",
             "required": true
           }
@@ -9178,8 +11167,48 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucket"
+              "$ref": "#/definitions/bucketReplicationRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Update Replication rule",
+        "operationId": "UpdateMultiBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/multiBucketReplicationEdit"
             }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9193,12 +11222,18 @@ func init() {
         "tags": [
           "Bucket"
         ],
-        "summary": "Delete Bucket",
-        "operationId": "DeleteBucket",
+        "summary": "Bucket Replication Rule Delete",
+        "operationId": "DeleteBucketReplicationRule",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
             "in": "path",
             "required": true
           }
@@ -9216,17 +11251,17 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/quota": {
+    "/buckets/{bucket_name}/retention": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Get Bucket Quota",
-        "operationId": "GetBucketQuota",
+        "summary": "Get Bucket's retention config",
+        "operationId": "GetBucketRetentionConfig",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           }
@@ -9235,7 +11270,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketQuota"
+              "$ref": "#/definitions/getBucketRetentionConfig"
             }
           },
           "default": {
@@ -9250,12 +11285,12 @@ func init() {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Quota",
-        "operationId": "SetBucketQuota",
+        "summary": "Set Bucket's retention config",
+        "operationId": "SetBucketRetentionConfig",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
@@ -9264,16 +11299,13 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setBucketQuota"
+              "$ref": "#/definitions/putBucketRetentionRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9284,65 +11316,29 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/set-policy": {
-      "put": {
+    "/buckets/{bucket_name}/rewind/{date}": {
+      "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Set Policy",
-        "operationId": "BucketSetPolicy",
+        "summary": "Get objects in a bucket for a rewind date",
+        "operationId": "GetBucketRewind",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketPolicyRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
-        }
-      }
-    },
-    "/configs": {
-      "get": {
-        "tags": [
-          "Configuration"
-        ],
-        "summary": "List Configurations",
-        "operationId": "ListConfig",
-        "parameters": [
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "date",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
+            "type": "string",
+            "name": "prefix",
             "in": "query"
           }
         ],
@@ -9350,30 +11346,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listConfigResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
-        }
-      }
-    },
-    "/configs/export": {
-      "get": {
-        "tags": [
-          "Configuration"
-        ],
-        "summary": "Export the current config from MinIO server",
-        "operationId": "ExportConfig",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/configExportResponse"
+              "$ref": "#/definitions/rewindResponse"
             }
           },
           "default": {
@@ -9385,21 +11358,27 @@ func init() {
         }
       }
     },
-    "/configs/import": {
-      "post": {
-        "consumes": [
-          "multipart/form-data"
-        ],
+    "/buckets/{bucket_name}/tags": {
+      "put": {
         "tags": [
-          "Configuration"
+          "Bucket"
         ],
-        "summary": "Uploads a file to import MinIO server config.",
+        "summary": "Put Bucket's tags",
+        "operationId": "PutBucketTags",
         "parameters": [
           {
-            "type": "file",
-            "name": "file",
-            "in": "formData",
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putBucketTagsRequest"
+            }
           }
         ],
         "responses": {
@@ -9415,17 +11394,17 @@ func init() {
         }
       }
     },
-    "/configs/{name}": {
+    "/buckets/{bucket_name}/versioning": {
       "get": {
         "tags": [
-          "Configuration"
+          "Bucket"
         ],
-        "summary": "Configuration info",
-        "operationId": "ConfigInfo",
+        "summary": "Bucket Versioning",
+        "operationId": "GetBucketVersioning",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           }
@@ -9434,10 +11413,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/configuration"
-              }
+              "$ref": "#/definitions/bucketVersioningResponse"
             }
           },
           "default": {
@@ -9450,14 +11426,14 @@ func init() {
       },
       "put": {
         "tags": [
-          "Configuration"
+          "Bucket"
         ],
-        "summary": "Set Configuration",
-        "operationId": "SetConfig",
+        "summary": "Set Bucket Versioning",
+        "operationId": "SetBucketVersioning",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
@@ -9466,16 +11442,13 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setConfigRequest"
+              "$ref": "#/definitions/setBucketVersioning"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/setConfigResponse"
-            }
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9486,13 +11459,13 @@ func init() {
         }
       }
     },
-    "/configs/{name}/reset": {
-      "post": {
+    "/buckets/{name}": {
+      "get": {
         "tags": [
-          "Configuration"
+          "Bucket"
         ],
-        "summary": "Configuration reset",
-        "operationId": "ResetConfig",
+        "summary": "Bucket Info",
+        "operationId": "BucketInfo",
         "parameters": [
           {
             "type": "string",
@@ -9505,7 +11478,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setConfigResponse"
+              "$ref": "#/definitions/bucket"
             }
           },
           "default": {
@@ -9515,33 +11488,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/download-shared-object/{url}": {
-      "get": {
-        "security": [],
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "delete": {
         "tags": [
-          "Public"
+          "Bucket"
         ],
-        "summary": "Downloads an object from a presigned url",
-        "operationId": "DownloadSharedObject",
+        "summary": "Delete Bucket",
+        "operationId": "DeleteBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "url",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9552,13 +11516,13 @@ func init() {
         }
       }
     },
-    "/group/{name}": {
+    "/buckets/{name}/quota": {
       "get": {
         "tags": [
-          "Group"
+          "Bucket"
         ],
-        "summary": "Group info",
-        "operationId": "GroupInfo",
+        "summary": "Get Bucket Quota",
+        "operationId": "GetBucketQuota",
         "parameters": [
           {
             "type": "string",
@@ -9571,7 +11535,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "$ref": "#/definitions/bucketQuota"
             }
           },
           "default": {
@@ -9584,10 +11548,10 @@ func init() {
       },
       "put": {
         "tags": [
-          "Group"
+          "Bucket"
         ],
-        "summary": "Update Group Members or Status",
-        "operationId": "UpdateGroup",
+        "summary": "Bucket Quota",
+        "operationId": "SetBucketQuota",
         "parameters": [
           {
             "type": "string",
@@ -9600,7 +11564,7 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateGroupRequest"
+              "$ref": "#/definitions/setBucketQuota"
             }
           }
         ],
@@ -9608,7 +11572,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "$ref": "#/definitions/bucket"
             }
           },
           "default": {
@@ -9618,24 +11582,37 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/buckets/{name}/set-policy": {
+      "put": {
         "tags": [
-          "Group"
+          "Bucket"
         ],
-        "summary": "Remove group",
-        "operationId": "RemoveGroup",
+        "summary": "Bucket Set Policy",
+        "operationId": "BucketSetPolicy",
         "parameters": [
           {
             "type": "string",
             "name": "name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketPolicyRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -9646,13 +11623,13 @@ func init() {
         }
       }
     },
-    "/groups": {
+    "/configs": {
       "get": {
         "tags": [
-          "Group"
+          "Configuration"
         ],
-        "summary": "List Groups",
-        "operationId": "ListGroups",
+        "summary": "List Configurations",
+        "operationId": "ListConfig",
         "parameters": [
           {
             "type": "number",
@@ -9673,7 +11650,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listGroupsResponse"
+              "$ref": "#/definitions/listConfigResponse"
             }
           },
           "default": {
@@ -9683,26 +11660,21 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/configs/export": {
+      "get": {
         "tags": [
-          "Group"
+          "Configuration"
         ],
-        "summary": "Add Group",
-        "operationId": "AddGroup",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+        "summary": "Export the current config from MinIO server",
+        "operationId": "ExportConfig",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/addGroupRequest"
+              "$ref": "#/definitions/configExportResponse"
             }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9713,18 +11685,47 @@ func init() {
         }
       }
     },
-    "/idp/{type}": {
-      "get": {
-        "tags": [
-          "idp"
-        ],
-        "summary": "List IDP Configurations",
-        "operationId": "ListConfigurations",
+    "/configs/import": {
+      "post": {
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Uploads a file to import MinIO server config.",
+        "parameters": [
+          {
+            "type": "file",
+            "name": "file",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/configs/{name}": {
+      "get": {
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Configuration info",
+        "operationId": "ConfigInfo",
         "parameters": [
           {
             "type": "string",
-            "description": "IDP Configuration Type",
-            "name": "type",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -9733,7 +11734,10 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/idpListConfigurationsResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/configuration"
+              }
             }
           },
           "default": {
@@ -9744,20 +11748,16 @@ func init() {
           }
         }
       },
-      "post": {
-        "consumes": [
-          "application/json"
-        ],
+      "put": {
         "tags": [
-          "idp"
+          "Configuration"
         ],
-        "summary": "Create IDP Configuration",
-        "operationId": "CreateConfiguration",
+        "summary": "Set Configuration",
+        "operationId": "SetConfig",
         "parameters": [
           {
             "type": "string",
-            "description": "IDP Configuration Type",
-            "name": "type",
+            "name": "name",
             "in": "path",
             "required": true
           },
@@ -9766,15 +11766,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/idpServerConfiguration"
+              "$ref": "#/definitions/setConfigRequest"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setIDPResponse"
+              "$ref": "#/definitions/setConfigResponse"
             }
           },
           "default": {
@@ -9786,34 +11786,26 @@ func init() {
         }
       }
     },
-    "/idp/{type}/{name}": {
-      "get": {
+    "/configs/{name}/reset": {
+      "post": {
         "tags": [
-          "idp"
+          "Configuration"
         ],
-        "summary": "Get IDP Configuration",
-        "operationId": "GetConfiguration",
+        "summary": "Configuration reset",
+        "operationId": "ResetConfig",
         "parameters": [
           {
             "type": "string",
-            "description": "IDP Configuration Name",
             "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "IDP Configuration Type",
-            "name": "type",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/idpServerConfiguration"
+              "$ref": "#/definitions/setConfigResponse"
             }
           },
           "default": {
@@ -9823,36 +11815,54 @@ func init() {
             }
           }
         }
-      },
-      "put": {
-        "consumes": [
-          "application/json"
+      }
+    },
+    "/download-shared-object/{url}": {
+      "get": {
+        "security": [],
+        "produces": [
+          "application/octet-stream"
         ],
         "tags": [
-          "idp"
+          "Public"
         ],
-        "summary": "Update IDP Configuration",
-        "operationId": "UpdateConfiguration",
+        "summary": "Downloads an object from a presigned url",
+        "operationId": "DownloadSharedObject",
         "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/idpServerConfiguration"
-            }
-          },
           {
             "type": "string",
-            "description": "IDP Configuration Name",
-            "name": "name",
+            "name": "url",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/group/{name}": {
+      "get": {
+        "tags": [
+          "Group"
+        ],
+        "summary": "Group info",
+        "operationId": "GroupInfo",
+        "parameters": [
           {
             "type": "string",
-            "description": "IDP Configuration Type",
-            "name": "type",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -9861,7 +11871,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setIDPResponse"
+              "$ref": "#/definitions/group"
             }
           },
           "default": {
@@ -9872,33 +11882,33 @@ func init() {
           }
         }
       },
-      "delete": {
+      "put": {
         "tags": [
-          "idp"
+          "Group"
         ],
-        "summary": "Delete IDP Configuration",
-        "operationId": "DeleteConfiguration",
+        "summary": "Update Group Members or Status",
+        "operationId": "UpdateGroup",
         "parameters": [
           {
             "type": "string",
-            "description": "IDP Configuration Name",
             "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "IDP Configuration Type",
-            "name": "type",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateGroupRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setIDPResponse"
+              "$ref": "#/definitions/group"
             }
           },
           "default": {
@@ -9908,21 +11918,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/kms/apis": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "KMS"
+          "Group"
+        ],
+        "summary": "Remove group",
+        "operationId": "RemoveGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "KMS apis",
-        "operationId": "KMSAPIs",
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/kmsAPIsResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9933,18 +11946,26 @@ func init() {
         }
       }
     },
-    "/kms/keys": {
+    "/groups": {
       "get": {
         "tags": [
-          "KMS"
+          "Group"
         ],
-        "summary": "KMS list keys",
-        "operationId": "KMSListKeys",
+        "summary": "List Groups",
+        "operationId": "ListGroups",
         "parameters": [
           {
-            "type": "string",
-            "description": "pattern to retrieve keys",
-            "name": "pattern",
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
             "in": "query"
           }
         ],
@@ -9952,7 +11973,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsListKeysResponse"
+              "$ref": "#/definitions/listGroupsResponse"
             }
           },
           "default": {
@@ -9965,17 +11986,17 @@ func init() {
       },
       "post": {
         "tags": [
-          "KMS"
+          "Group"
         ],
-        "summary": "KMS create key",
-        "operationId": "KMSCreateKey",
+        "summary": "Add Group",
+        "operationId": "AddGroup",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/kmsCreateKeyRequest"
+              "$ref": "#/definitions/addGroupRequest"
             }
           }
         ],
@@ -9992,27 +12013,18 @@ func init() {
         }
       }
     },
-    "/kms/keys/{name}": {
+    "/iam/export": {
       "get": {
         "tags": [
-          "KMS"
-        ],
-        "summary": "KMS key status",
-        "operationId": "KMSKeyStatus",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "KMS key name",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
+          "Configuration"
         ],
+        "summary": "Export the canned policies, users, groups, policy mappings and service accounts",
+        "operationId": "ExportIAM",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsKeyStatusResponse"
+              "$ref": "#/definitions/iamBundle"
             }
           },
           "default": {
@@ -10024,41 +12036,28 @@ func init() {
         }
       }
     },
-    "/kms/metrics": {
-      "get": {
+    "/iam/import": {
+      "post": {
         "tags": [
-          "KMS"
+          "Configuration"
         ],
-        "summary": "KMS metrics",
-        "operationId": "KMSMetrics",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
+        "summary": "Import an IAM bundle previously exported",
+        "operationId": "ImportIAM",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/kmsMetricsResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
+              "$ref": "#/definitions/iamImportRequest"
             }
           }
-        }
-      }
-    },
-    "/kms/status": {
-      "get": {
-        "tags": [
-          "KMS"
         ],
-        "summary": "KMS status",
-        "operationId": "KMSStatus",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/kmsStatusResponse"
+              "$ref": "#/definitions/iamImportResponse"
             }
           },
           "default": {
@@ -10070,51 +12069,26 @@ func init() {
         }
       }
     },
-    "/kms/version": {
+    "/iam/stale-report": {
       "get": {
         "tags": [
-          "KMS"
-        ],
-        "summary": "KMS version",
-        "operationId": "KMSVersion",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/kmsVersionResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
-        }
-      }
-    },
-    "/ldap-entities": {
-      "post": {
-        "tags": [
-          "idp"
+          "Configuration"
         ],
-        "summary": "Get LDAP Entities",
-        "operationId": "GetLDAPEntities",
+        "summary": "Report the unused users, groups, policies and service accounts",
+        "operationId": "GetStaleIdentityReport",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ldapEntitiesRequest"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "disabledDays",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/ldapEntities"
+              "$ref": "#/definitions/staleIdentityReport"
             }
           },
           "default": {
@@ -10126,20 +12100,20 @@ func init() {
         }
       }
     },
-    "/list-external-buckets": {
+    "/iam/stale-report/cleanup": {
       "post": {
         "tags": [
-          "Bucket"
+          "Configuration"
         ],
-        "summary": "Lists an External list of buckets using custom credentials",
-        "operationId": "ListExternalBuckets",
+        "summary": "Remove the identities flagged by the stale identity report",
+        "operationId": "CleanupStaleIdentities",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/listExternalBucketsParams"
+              "$ref": "#/definitions/staleIdentityCleanupRequest"
             }
           }
         ],
@@ -10147,7 +12121,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/staleIdentityCleanupResponse"
             }
           },
           "default": {
@@ -10159,19 +12133,27 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/idp/{type}": {
       "get": {
-        "security": [],
         "tags": [
-          "Auth"
+          "idp"
+        ],
+        "summary": "List IDP Configurations",
+        "operationId": "ListConfigurations",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "IDP Configuration Type",
+            "name": "type",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginDetails"
+              "$ref": "#/definitions/idpListConfigurationsResponse"
             }
           },
           "default": {
@@ -10183,25 +12165,37 @@ func init() {
         }
       },
       "post": {
-        "security": [],
+        "consumes": [
+          "application/json"
+        ],
         "tags": [
-          "Auth"
+          "idp"
         ],
-        "summary": "Login to Console",
-        "operationId": "Login",
+        "summary": "Create IDP Configuration",
+        "operationId": "CreateConfiguration",
         "parameters": [
+          {
+            "type": "string",
+            "description": "IDP Configuration Type",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/loginRequest"
+              "$ref": "#/definitions/idpServerConfiguration"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful login."
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setIDPResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10212,27 +12206,35 @@ func init() {
         }
       }
     },
-    "/login/oauth2/auth": {
-      "post": {
-        "security": [],
+    "/idp/{type}/{name}": {
+      "get": {
         "tags": [
-          "Auth"
+          "idp"
         ],
-        "summary": "Identity Provider oauth2 callback endpoint.",
-        "operationId": "LoginOauth2Auth",
+        "summary": "Get IDP Configuration",
+        "operationId": "GetConfiguration",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginOauth2AuthRequest"
-            }
+            "type": "string",
+            "description": "IDP Configuration Name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "IDP Configuration Type",
+            "name": "type",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful login."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/idpServerConfiguration"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10241,28 +12243,46 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/logout": {
-      "post": {
+      },
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
         "tags": [
-          "Auth"
+          "idp"
         ],
-        "summary": "Logout from Console.",
-        "operationId": "Logout",
+        "summary": "Update IDP Configuration",
+        "operationId": "UpdateConfiguration",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logoutRequest"
+              "$ref": "#/definitions/idpServerConfiguration"
             }
+          },
+          {
+            "type": "string",
+            "description": "IDP Configuration Name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "IDP Configuration Type",
+            "name": "type",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setIDPResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10271,66 +12291,34 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/logs/search": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "Logging"
+          "idp"
         ],
-        "summary": "Search the logs",
-        "operationId": "LogSearch",
+        "summary": "Delete IDP Configuration",
+        "operationId": "DeleteConfiguration",
         "parameters": [
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Filter Parameters",
-            "name": "fp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 10,
-            "name": "pageSize",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "pageNo",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "timeDesc",
-              "timeAsc"
-            ],
-            "type": "string",
-            "default": "timeDesc",
-            "name": "order",
-            "in": "query"
-          },
           {
             "type": "string",
-            "name": "timeStart",
-            "in": "query"
+            "description": "IDP Configuration Name",
+            "name": "name",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "name": "timeEnd",
-            "in": "query"
+            "description": "IDP Configuration Type",
+            "name": "type",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/logSearchResponse"
+              "$ref": "#/definitions/setIDPResponse"
             }
           },
           "default": {
@@ -10342,21 +12330,18 @@ func init() {
         }
       }
     },
-    "/nodes": {
+    "/kms/apis": {
       "get": {
         "tags": [
-          "System"
+          "KMS"
         ],
-        "summary": "Lists Nodes",
-        "operationId": "ListNodes",
+        "summary": "KMS apis",
+        "operationId": "KMSAPIs",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/kmsAPIsResponse"
             }
           },
           "default": {
@@ -10368,26 +12353,18 @@ func init() {
         }
       }
     },
-    "/policies": {
+    "/kms/keys": {
       "get": {
         "tags": [
-          "Policy"
+          "KMS"
         ],
-        "summary": "List Policies",
-        "operationId": "ListPolicies",
+        "summary": "KMS list keys",
+        "operationId": "KMSListKeys",
         "parameters": [
           {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
+            "type": "string",
+            "description": "pattern to retrieve keys",
+            "name": "pattern",
             "in": "query"
           }
         ],
@@ -10395,7 +12372,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
+              "$ref": "#/definitions/kmsListKeysResponse"
             }
           },
           "default": {
@@ -10408,26 +12385,23 @@ func init() {
       },
       "post": {
         "tags": [
-          "Policy"
+          "KMS"
         ],
-        "summary": "Add Policy",
-        "operationId": "AddPolicy",
+        "summary": "KMS create key",
+        "operationId": "KMSCreateKey",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addPolicyRequest"
+              "$ref": "#/definitions/kmsCreateKeyRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/policy"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -10438,17 +12412,18 @@ func init() {
         }
       }
     },
-    "/policies/{policy}/groups": {
+    "/kms/keys/{name}": {
       "get": {
         "tags": [
-          "Policy"
+          "KMS"
         ],
-        "summary": "List Groups for a Policy",
-        "operationId": "ListGroupsForPolicy",
+        "summary": "KMS key status",
+        "operationId": "KMSKeyStatus",
         "parameters": [
           {
             "type": "string",
-            "name": "policy",
+            "description": "KMS key name",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -10457,10 +12432,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/kmsKeyStatusResponse"
             }
           },
           "default": {
@@ -10472,29 +12444,18 @@ func init() {
         }
       }
     },
-    "/policies/{policy}/users": {
+    "/kms/metrics": {
       "get": {
         "tags": [
-          "Policy"
-        ],
-        "summary": "List Users for a Policy",
-        "operationId": "ListUsersForPolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "policy",
-            "in": "path",
-            "required": true
-          }
+          "KMS"
         ],
+        "summary": "KMS metrics",
+        "operationId": "KMSMetrics",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/kmsMetricsResponse"
             }
           },
           "default": {
@@ -10506,53 +12467,19 @@ func init() {
         }
       }
     },
-    "/policy/{name}": {
+    "/kms/status": {
       "get": {
         "tags": [
-          "Policy"
-        ],
-        "summary": "Policy info",
-        "operationId": "PolicyInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
+          "KMS"
         ],
+        "summary": "KMS status",
+        "operationId": "KMSStatus",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/policy"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
+              "$ref": "#/definitions/kmsStatusResponse"
             }
-          }
-        }
-      },
-      "delete": {
-        "tags": [
-          "Policy"
-        ],
-        "summary": "Remove policy",
-        "operationId": "RemovePolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -10563,45 +12490,18 @@ func init() {
         }
       }
     },
-    "/releases": {
+    "/kms/version": {
       "get": {
         "tags": [
-          "release"
-        ],
-        "summary": "Get repo releases for a given version",
-        "operationId": "ListReleases",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "repo name",
-            "name": "repo",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Current Release",
-            "name": "current",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "search content",
-            "name": "search",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "filter releases",
-            "name": "filter",
-            "in": "query"
-          }
+          "KMS"
         ],
+        "summary": "KMS version",
+        "operationId": "KMSVersion",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/releaseListResponse"
+              "$ref": "#/definitions/kmsVersionResponse"
             }
           },
           "default": {
@@ -10613,18 +12513,28 @@ func init() {
         }
       }
     },
-    "/remote-buckets": {
-      "get": {
+    "/ldap-entities": {
+      "post": {
         "tags": [
-          "Bucket"
+          "idp"
+        ],
+        "summary": "Get LDAP Entities",
+        "operationId": "GetLDAPEntities",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ldapEntitiesRequest"
+            }
+          }
         ],
-        "summary": "List Remote Buckets",
-        "operationId": "ListRemoteBuckets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listRemoteBucketsResponse"
+              "$ref": "#/definitions/ldapEntities"
             }
           },
           "default": {
@@ -10634,26 +12544,31 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/ldap-entities/policies/attach": {
       "post": {
         "tags": [
-          "Bucket"
+          "idp"
         ],
-        "summary": "Add Remote Bucket",
-        "operationId": "AddRemoteBucket",
+        "summary": "Attach policies to LDAP user and group DNs",
+        "operationId": "AttachLDAPPolicies",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createRemoteBucket"
+              "$ref": "#/definitions/ldapPolicyAssociationRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/ldapPolicyAssociationResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10664,26 +12579,28 @@ func init() {
         }
       }
     },
-    "/remote-buckets/{name}": {
-      "get": {
+    "/ldap-entities/policies/detach": {
+      "post": {
         "tags": [
-          "Bucket"
+          "idp"
         ],
-        "summary": "Remote Bucket Details",
-        "operationId": "RemoteBucketDetails",
+        "summary": "Detach policies from LDAP user and group DNs",
+        "operationId": "DetachLDAPPolicies",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ldapPolicyAssociationRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/remoteBucket"
+              "$ref": "#/definitions/ldapPolicyAssociationResponse"
             }
           },
           "default": {
@@ -10695,30 +12612,40 @@ func init() {
         }
       }
     },
-    "/remote-buckets/{source-bucket-name}/{arn}": {
-      "delete": {
+    "/ldap-entities/search": {
+      "get": {
         "tags": [
-          "Bucket"
+          "idp"
         ],
-        "summary": "Delete Remote Bucket",
-        "operationId": "DeleteRemoteBucket",
+        "summary": "Search the LDAP directory for user and group DNs with the lookup bind",
+        "operationId": "SearchLDAPEntities",
         "parameters": [
           {
             "type": "string",
-            "name": "source-bucket-name",
-            "in": "path",
-            "required": true
+            "description": "Text the user and group names must contain",
+            "name": "query",
+            "in": "query"
           },
           {
             "type": "string",
-            "name": "arn",
-            "in": "path",
-            "required": true
+            "description": "Restrict the results to ` + "`" + `user` + "`" + ` or ` + "`" + `group` + "`" + ` DNs",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/ldapEntitiesSearchResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10729,28 +12656,28 @@ func init() {
         }
       }
     },
-    "/service-account-credentials": {
+    "/list-external-buckets": {
       "post": {
         "tags": [
-          "ServiceAccount"
+          "Bucket"
         ],
-        "summary": "Create Service Account With Credentials",
-        "operationId": "CreateServiceAccountCreds",
+        "summary": "Lists an External list of buckets using custom credentials",
+        "operationId": "ListExternalBuckets",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequestCreds"
+              "$ref": "#/definitions/listExternalBucketsParams"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
+              "$ref": "#/definitions/listBucketsResponse"
             }
           },
           "default": {
@@ -10762,34 +12689,19 @@ func init() {
         }
       }
     },
-    "/service-accounts": {
+    "/login": {
       "get": {
+        "security": [],
         "tags": [
-          "ServiceAccount"
-        ],
-        "summary": "List User's Service Accounts",
-        "operationId": "ListUserServiceAccounts",
-        "parameters": [
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
-            "in": "query"
-          }
+          "Auth"
         ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccounts"
+              "$ref": "#/definitions/loginDetails"
             }
           },
           "default": {
@@ -10801,27 +12713,25 @@ func init() {
         }
       },
       "post": {
+        "security": [],
         "tags": [
-          "ServiceAccount"
+          "Auth"
         ],
-        "summary": "Create Service Account",
-        "operationId": "CreateServiceAccount",
+        "summary": "Login to Console",
+        "operationId": "Login",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
+              "$ref": "#/definitions/loginRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
-            }
+          "204": {
+            "description": "A successful login."
           },
           "default": {
             "description": "Generic error response.",
@@ -10832,26 +12742,27 @@ func init() {
         }
       }
     },
-    "/service-accounts/delete-multi": {
-      "delete": {
+    "/login/oauth2/auth": {
+      "post": {
+        "security": [],
         "tags": [
-          "ServiceAccount"
+          "Auth"
         ],
-        "summary": "Delete Multiple Service Accounts",
-        "operationId": "DeleteMultipleServiceAccounts",
+        "summary": "Identity Provider oauth2 callback endpoint.",
+        "operationId": "LoginOauth2Auth",
         "parameters": [
           {
-            "name": "selectedSA",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/selectedSAs"
+              "$ref": "#/definitions/loginOauth2AuthRequest"
             }
           }
         ],
         "responses": {
           "204": {
-            "description": "A successful response."
+            "description": "A successful login."
           },
           "default": {
             "description": "Generic error response.",
@@ -10862,55 +12773,20 @@ func init() {
         }
       }
     },
-    "/service-accounts/{access_key}": {
-      "get": {
-        "tags": [
-          "ServiceAccount"
-        ],
-        "summary": "Get Service Account",
-        "operationId": "GetServiceAccount",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "access_key",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccount"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
-        }
-      },
-      "put": {
+    "/logout": {
+      "post": {
         "tags": [
-          "ServiceAccount"
+          "Auth"
         ],
-        "summary": "Set Service Account Policy",
-        "operationId": "UpdateServiceAccount",
+        "summary": "Logout from Console.",
+        "operationId": "Logout",
         "parameters": [
-          {
-            "type": "string",
-            "name": "access_key",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateServiceAccountRequest"
+              "$ref": "#/definitions/logoutRequest"
             }
           }
         ],
@@ -10925,24 +12801,67 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/logs/search": {
+      "get": {
         "tags": [
-          "ServiceAccount"
+          "Logging"
         ],
-        "summary": "Delete Service Account",
-        "operationId": "DeleteServiceAccount",
+        "summary": "Search the logs",
+        "operationId": "LogSearch",
         "parameters": [
           {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Filter Parameters",
+            "name": "fp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 10,
+            "name": "pageSize",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "pageNo",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "timeDesc",
+              "timeAsc"
+            ],
             "type": "string",
-            "name": "access_key",
-            "in": "path",
-            "required": true
+            "default": "timeDesc",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeStart",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeEnd",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/logSearchResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10953,16 +12872,22 @@ func init() {
         }
       }
     },
-    "/service/restart": {
-      "post": {
+    "/nodes": {
+      "get": {
         "tags": [
-          "Service"
+          "System"
         ],
-        "summary": "Restart Service",
-        "operationId": "RestartService",
+        "summary": "Lists Nodes",
+        "operationId": "ListNodes",
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10973,18 +12898,34 @@ func init() {
         }
       }
     },
-    "/session": {
+    "/policies": {
       "get": {
         "tags": [
-          "Auth"
+          "Policy"
+        ],
+        "summary": "List Policies",
+        "operationId": "ListPolicies",
+        "parameters": [
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          }
         ],
-        "summary": "Endpoint to check if your session is still valid",
-        "operationId": "SessionCheck",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/sessionResponse"
+              "$ref": "#/definitions/listPoliciesResponse"
             }
           },
           "default": {
@@ -10994,28 +12935,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/set-policy": {
-      "put": {
+      },
+      "post": {
         "tags": [
           "Policy"
         ],
-        "summary": "Set policy",
-        "operationId": "SetPolicy",
+        "summary": "Add Policy",
+        "operationId": "AddPolicy",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setPolicyNameRequest"
+              "$ref": "#/definitions/addPolicyRequest"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -11026,26 +12968,30 @@ func init() {
         }
       }
     },
-    "/set-policy-multi": {
-      "put": {
+    "/policies/{policy}/groups": {
+      "get": {
         "tags": [
           "Policy"
         ],
-        "summary": "Set policy to multiple users/groups",
-        "operationId": "SetPolicyMultiple",
+        "summary": "List Groups for a Policy",
+        "operationId": "ListGroupsForPolicy",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setPolicyMultipleNameRequest"
-            }
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -11056,40 +13002,17 @@ func init() {
         }
       }
     },
-    "/user/policy": {
+    "/policies/{policy}/users": {
       "get": {
         "tags": [
           "Policy"
         ],
-        "summary": "returns policies for logged in user",
-        "operationId": "GetUserPolicy",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "string"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
-        }
-      }
-    },
-    "/user/{name}": {
-      "get": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Get User Info",
-        "operationId": "GetUserInfo",
+        "summary": "List Users for a Policy",
+        "operationId": "ListUsersForPolicy",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "policy",
             "in": "path",
             "required": true
           }
@@ -11098,7 +13021,10 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "default": {
@@ -11108,26 +13034,22 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/policy/lint": {
+      "post": {
         "tags": [
-          "User"
+          "Policy"
         ],
-        "summary": "Update User Info",
-        "operationId": "UpdateUserInfo",
+        "summary": "Lint a policy document for risky or broken constructs",
+        "operationId": "LintPolicy",
         "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateUser"
+              "$ref": "#/definitions/lintPolicyRequest"
             }
           }
         ],
@@ -11135,34 +13057,8 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
+              "$ref": "#/definitions/lintPolicyResponse"
             }
-          }
-        }
-      },
-      "delete": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Remove user",
-        "operationId": "RemoveUser",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -11173,26 +13069,20 @@ func init() {
         }
       }
     },
-    "/user/{name}/groups": {
-      "put": {
+    "/policy/simulate": {
+      "post": {
         "tags": [
-          "User"
+          "Policy"
         ],
-        "summary": "Update Groups for a user",
-        "operationId": "UpdateUserGroups",
+        "summary": "Simulate the policy evaluation of a user, group or service account",
+        "operationId": "SimulatePolicy",
         "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateUserGroups"
+              "$ref": "#/definitions/simulatePolicyRequest"
             }
           }
         ],
@@ -11200,7 +13090,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/simulatePolicyResponse"
             }
           },
           "default": {
@@ -11212,13 +13102,13 @@ func init() {
         }
       }
     },
-    "/user/{name}/policies": {
+    "/policy/{name}": {
       "get": {
         "tags": [
           "Policy"
         ],
-        "summary": "returns policies assigned for a specified user",
-        "operationId": "GetSAUserPolicy",
+        "summary": "Policy info",
+        "operationId": "PolicyInfo",
         "parameters": [
           {
             "type": "string",
@@ -11231,7 +13121,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/aUserPolicyResponse"
+              "$ref": "#/definitions/policy"
             }
           },
           "default": {
@@ -11241,37 +13131,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/user/{name}/service-account-credentials": {
-      "post": {
+      },
+      "delete": {
         "tags": [
-          "User"
+          "Policy"
         ],
-        "summary": "Create Service Account for User With Credentials",
-        "operationId": "CreateServiceAccountCredentials",
+        "summary": "Remove policy",
+        "operationId": "RemovePolicy",
         "parameters": [
           {
             "type": "string",
             "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/serviceAccountRequestCreds"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -11282,13 +13159,13 @@ func init() {
         }
       }
     },
-    "/user/{name}/service-accounts": {
+    "/policy/{name}/history": {
       "get": {
         "tags": [
-          "User"
+          "Policy"
         ],
-        "summary": "returns a list of service accounts for a user",
-        "operationId": "ListAUserServiceAccounts",
+        "summary": "Lists the changes of a policy along with the differences between versions",
+        "operationId": "ListPolicyHistory",
         "parameters": [
           {
             "type": "string",
@@ -11301,7 +13178,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccounts"
+              "$ref": "#/definitions/policyHistoryResponse"
             }
           },
           "default": {
@@ -11311,13 +13188,15 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/policy/{name}/history/{version}/rollback": {
       "post": {
         "tags": [
-          "User"
+          "Policy"
         ],
-        "summary": "Create Service Account for User",
-        "operationId": "CreateAUserServiceAccount",
+        "summary": "Reverts a change of a policy restoring the document it replaced",
+        "operationId": "RollbackPolicy",
         "parameters": [
           {
             "type": "string",
@@ -11325,20 +13204,27 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "version",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
+              "$ref": "#/definitions/rollbackPolicyRequest"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
+              "$ref": "#/definitions/policy"
             }
           },
           "default": {
@@ -11350,26 +13236,37 @@ func init() {
         }
       }
     },
-    "/users": {
+    "/releases": {
       "get": {
         "tags": [
-          "User"
+          "release"
         ],
-        "summary": "List Users",
-        "operationId": "ListUsers",
+        "summary": "Get repo releases for a given version",
+        "operationId": "ListReleases",
         "parameters": [
           {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "offset",
+            "type": "string",
+            "description": "repo name",
+            "name": "repo",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Current Release",
+            "name": "current",
             "in": "query"
           },
           {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
+            "type": "string",
+            "description": "search content",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "filter releases",
+            "name": "filter",
             "in": "query"
           }
         ],
@@ -11377,7 +13274,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listUsersResponse"
+              "$ref": "#/definitions/releaseListResponse"
             }
           },
           "default": {
@@ -11387,28 +13284,20 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/remote-buckets": {
+      "get": {
         "tags": [
-          "User"
-        ],
-        "summary": "Add User",
-        "operationId": "AddUser",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addUserRequest"
-            }
-          }
+          "Bucket"
         ],
+        "summary": "List Remote Buckets",
+        "operationId": "ListRemoteBuckets",
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/listRemoteBucketsResponse"
             }
           },
           "default": {
@@ -11418,27 +13307,25 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/users-groups-bulk": {
-      "put": {
+      },
+      "post": {
         "tags": [
-          "User"
+          "Bucket"
         ],
-        "summary": "Bulk functionality to Add Users to Groups",
-        "operationId": "BulkUpdateUsersGroups",
+        "summary": "Add Remote Bucket",
+        "operationId": "AddRemoteBucket",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bulkUserGroups"
+              "$ref": "#/definitions/createRemoteBucket"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -11450,28 +13337,26 @@ func init() {
         }
       }
     },
-    "/users/service-accounts": {
-      "post": {
+    "/remote-buckets/{name}": {
+      "get": {
         "tags": [
-          "User"
+          "Bucket"
         ],
-        "summary": "Check number of service accounts for each user specified",
-        "operationId": "CheckUserServiceAccounts",
+        "summary": "Remote Bucket Details",
+        "operationId": "RemoteBucketDetails",
         "parameters": [
           {
-            "name": "selectedUsers",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/selectedUsers"
-            }
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/userServiceAccountSummary"
+              "$ref": "#/definitions/remoteBucket"
             }
           },
           "default": {
@@ -11482,10 +13367,955 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "ApiError": {
+    },
+    "/remote-buckets/{source-bucket-name}/{arn}": {
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Delete Remote Bucket",
+        "operationId": "DeleteRemoteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "source-bucket-name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-account-credentials": {
+      "post": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Create Service Account With Credentials",
+        "operationId": "CreateServiceAccountCreds",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequestCreds"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-account-notifications": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "List the expiry and rotation notifications of the service accounts",
+        "operationId": "ListServiceAccountNotifications",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountNotifications"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "List User's Service Accounts",
+        "operationId": "ListUserServiceAccounts",
+        "parameters": [
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccounts"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Create Service Account",
+        "operationId": "CreateServiceAccount",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts/delete-multi": {
+      "delete": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Delete Multiple Service Accounts",
+        "operationId": "DeleteMultipleServiceAccounts",
+        "parameters": [
+          {
+            "name": "selectedSA",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/selectedSAs"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts/{access_key}": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Get Service Account",
+        "operationId": "GetServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccount"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Set Service Account Policy",
+        "operationId": "UpdateServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateServiceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Delete Service Account",
+        "operationId": "DeleteServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/service/restart": {
+      "post": {
+        "tags": [
+          "Service"
+        ],
+        "summary": "Restart Service",
+        "operationId": "RestartService",
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/session": {
+      "get": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Endpoint to check if your session is still valid",
+        "operationId": "SessionCheck",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/sessionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/set-policy": {
+      "put": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Set policy",
+        "operationId": "SetPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyNameRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/set-policy-multi": {
+      "put": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Set policy to multiple users/groups",
+        "operationId": "SetPolicyMultiple",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyMultipleNameRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/policy": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "returns policies for logged in user",
+        "operationId": "GetUserPolicy",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Get User Info",
+        "operationId": "GetUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Update User Info",
+        "operationId": "UpdateUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUser"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Remove user",
+        "operationId": "RemoveUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/effective-permissions": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "returns the permissions a user effectively holds on every bucket",
+        "operationId": "GetUserEffectivePermissions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/effectivePermissionsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/effective-permissions/csv": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "User"
+        ],
+        "summary": "exports the permissions a user effectively holds on every bucket as CSV",
+        "operationId": "ExportUserEffectivePermissions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/expiry": {
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Set or clear the expiry of a user",
+        "operationId": "UpdateUserExpiry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/userExpiryRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/groups": {
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Update Groups for a user",
+        "operationId": "UpdateUserGroups",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/policies": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "returns policies assigned for a specified user",
+        "operationId": "GetSAUserPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/aUserPolicyResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/service-account-credentials": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Create Service Account for User With Credentials",
+        "operationId": "CreateServiceAccountCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequestCreds"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/user/{name}/service-accounts": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "returns a list of service accounts for a user",
+        "operationId": "ListAUserServiceAccounts",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccounts"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Create Service Account for User",
+        "operationId": "CreateAUserServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "List Users",
+        "operationId": "ListUsers",
+        "parameters": [
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Add User",
+        "operationId": "AddUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addUserRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users-groups-bulk": {
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Bulk functionality to Add Users to Groups",
+        "operationId": "BulkUpdateUsersGroups",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users/provision": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Validates and creates a list of users, optionally generating their secret keys",
+        "operationId": "BulkProvisionUsers",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkProvisionUsersRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bulkProvisionUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/users/service-accounts": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Check number of service accounts for each user specified",
+        "operationId": "CheckUserServiceAccounts",
+        "parameters": [
+          {
+            "name": "selectedUsers",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/selectedUsers"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/userServiceAccountSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "ApiError": {
       "type": "object",
       "properties": {
         "detailedMessage": {
@@ -11733,83 +14563,254 @@ func init() {
         }
       }
     },
-    "addPolicyRequest": {
+    "addPolicyRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "policy"
+      ],
+      "properties": {
+        "comment": {
+          "description": "Optional comment stored in the policy history",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "addUserRequest": {
+      "type": "object",
+      "required": [
+        "accessKey",
+        "secretKey",
+        "groups",
+        "policies"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "RFC 3339 date the user is disabled at",
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "retention": {
+          "description": "how long the user is kept once disabled before it is deleted, e.g. 720h, it is never deleted when empty",
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "adminInfoResponse": {
+      "type": "object",
+      "properties": {
+        "advancedMetricsStatus": {
+          "type": "string",
+          "enum": [
+            "not configured",
+            "available",
+            "unavailable"
+          ]
+        },
+        "backend": {
+          "$ref": "#/definitions/BackendProperties"
+        },
+        "buckets": {
+          "type": "integer"
+        },
+        "objects": {
+          "type": "integer"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serverProperties"
+          }
+        },
+        "usage": {
+          "type": "integer"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widget"
+          }
+        }
+      }
+    },
+    "alert": {
+      "type": "object",
+      "properties": {
+        "endsAt": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "rule": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string"
+        },
+        "silenced": {
+          "type": "boolean"
+        },
+        "startsAt": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "firing",
+            "resolved"
+          ]
+        },
+        "subject": {
+          "description": "What the alert is about, e.g. the drive, bucket or tier",
+          "type": "string"
+        }
+      }
+    },
+    "alertRule": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "type": "string",
+          "enum": [
+            "driveOffline",
+            "serverOffline",
+            "bucketQuota",
+            "kmsUnreachable",
+            "tierUnreachable",
+            "prometheus"
+          ]
+        },
+        "name": {
+          "type": "string"
+        },
+        "query": {
+          "description": "Prometheus query of the prometheus rules",
+          "type": "string"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "critical"
+          ]
+        },
+        "threshold": {
+          "description": "Quota percentage of the bucketQuota rules, value above which the series of the prometheus rules fire",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "alertSilence": {
       "type": "object",
-      "required": [
-        "name",
-        "policy"
-      ],
       "properties": {
-        "name": {
+        "comment": {
           "type": "string"
         },
-        "policy": {
+        "createdBy": {
+          "type": "string"
+        },
+        "endsAt": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "rule": {
+          "description": "Rule of the silenced alerts, any rule when empty",
+          "type": "string"
+        },
+        "startsAt": {
+          "type": "string"
+        },
+        "subject": {
+          "description": "Subject of the silenced alerts, ` + "`" + `*` + "`" + ` matches any characters",
           "type": "string"
         }
       }
     },
-    "addUserRequest": {
+    "alertSilenceRequest": {
       "type": "object",
       "required": [
-        "accessKey",
-        "secretKey",
-        "groups",
-        "policies"
+        "duration"
       ],
       "properties": {
-        "accessKey": {
+        "comment": {
           "type": "string"
         },
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "duration": {
+          "description": "How long the alerts are silenced, e.g. ` + "`" + `2h` + "`" + `",
+          "type": "string"
         },
-        "policies": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "rule": {
+          "type": "string"
         },
-        "secretKey": {
+        "subject": {
           "type": "string"
         }
       }
     },
-    "adminInfoResponse": {
+    "alertsResponse": {
       "type": "object",
       "properties": {
-        "advancedMetricsStatus": {
-          "type": "string",
-          "enum": [
-            "not configured",
-            "available",
-            "unavailable"
-          ]
-        },
-        "backend": {
-          "$ref": "#/definitions/BackendProperties"
-        },
-        "buckets": {
-          "type": "integer"
+        "alerts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alert"
+          }
         },
-        "objects": {
-          "type": "integer"
+        "enabled": {
+          "description": "Whether the alert rules are evaluated",
+          "type": "boolean"
         },
-        "servers": {
+        "history": {
+          "description": "Recently resolved alerts, newest first",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/serverProperties"
+            "$ref": "#/definitions/alert"
           }
         },
-        "usage": {
-          "type": "integer"
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertRule"
+          }
         },
-        "widgets": {
+        "silences": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/widget"
+            "$ref": "#/definitions/alertSilence"
           }
         }
       }
@@ -11920,6 +14921,121 @@ func init() {
         "CUSTOM"
       ]
     },
+    "bucketAccessGrant": {
+      "type": "object",
+      "properties": {
+        "access": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketAccessGrantRequest": {
+      "type": "object",
+      "required": [
+        "access"
+      ],
+      "properties": {
+        "access": {
+          "type": "string",
+          "enum": [
+            "readonly",
+            "writeonly",
+            "readwrite"
+          ]
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketAccessGrantsResponse": {
+      "type": "object",
+      "properties": {
+        "grants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketAccessGrant"
+          }
+        }
+      }
+    },
+    "bucketAccessRevokeRequest": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "users": {
+          "description": "users losing the access, every user and group loses it when both users and groups are empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketEffectivePermissions": {
+      "type": "object",
+      "properties": {
+        "admin": {
+          "type": "string"
+        },
+        "allowedActions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "delete": {
+          "type": "string"
+        },
+        "read": {
+          "type": "string"
+        },
+        "write": {
+          "type": "string"
+        }
+      }
+    },
     "bucketEncryptionInfo": {
       "type": "object",
       "properties": {
@@ -12137,28 +15253,114 @@ func init() {
         "rules": {
           "type": "array",
           "items": {
-            "type": "string"
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketVersioningResponse": {
+      "type": "object",
+      "properties": {
+        "MFADelete": {
+          "type": "string"
+        },
+        "excludeFolders": {
+          "type": "boolean"
+        },
+        "excludedPrefixes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BucketVersioningResponseExcludedPrefixesItems0"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "bulkProvisionUser": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretKey": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "bulkProvisionUserResult": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "row": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "bulkProvisionUsersRequest": {
+      "type": "object",
+      "properties": {
+        "csv": {
+          "description": "CSV rows with a header, groups and policies are separated by semicolons",
+          "type": "string"
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "generateSecrets": {
+          "type": "boolean"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bulkProvisionUser"
           }
         }
       }
     },
-    "bucketVersioningResponse": {
+    "bulkProvisionUsersResponse": {
       "type": "object",
       "properties": {
-        "MFADelete": {
-          "type": "string"
-        },
-        "excludeFolders": {
+        "applied": {
           "type": "boolean"
         },
-        "excludedPrefixes": {
+        "credentialsFile": {
+          "description": "CSV file with the generated secret keys, only returned once",
+          "type": "string"
+        },
+        "results": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/BucketVersioningResponseExcludedPrefixesItems0"
+            "$ref": "#/definitions/bulkProvisionUserResult"
           }
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
@@ -12314,88 +15516,352 @@ func init() {
             "sync"
           ]
         },
-        "targetBucket": {
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
+        }
+      }
+    },
+    "customWidget": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "Namespaced ID of the widget, ` + "`" + `\u003cnamespace\u003e/\u003cwidgetId\u003e` + "`" + `",
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "source": {
+          "description": "Where the widget is defined, ` + "`" + `file` + "`" + ` or ` + "`" + `bucket` + "`" + `",
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "widgetId": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "customWidgetTestRequest": {
+      "type": "object",
+      "required": [
+        "definition"
+      ],
+      "properties": {
+        "definition": {
+          "description": "YAML or JSON definition of the widget",
+          "type": "string"
+        },
+        "end": {
+          "type": "integer"
+        },
+        "start": {
+          "type": "integer"
+        },
+        "step": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "customWidgetsResponse": {
+      "type": "object",
+      "properties": {
+        "errors": {
+          "description": "Errors of the widget definitions that couldn't be loaded",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/customWidget"
+          }
+        }
+      }
+    },
+    "deleteFile": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "type": "string"
+        },
+        "recursive": {
+          "type": "boolean"
+        },
+        "versionID": {
+          "type": "string"
+        }
+      }
+    },
+    "effectivePermissionsResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketEffectivePermissions"
+          }
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedPolicy"
+          }
+        },
+        "user": {
+          "type": "string"
+        }
+      }
+    },
+    "envOverride": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "environmentConstants": {
+      "type": "object",
+      "properties": {
+        "maxConcurrentDownloads": {
+          "type": "integer"
+        },
+        "maxConcurrentUploads": {
+          "type": "integer"
+        }
+      }
+    },
+    "getBucketRetentionConfig": {
+      "type": "object",
+      "properties": {
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "group": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "iamBundle": {
+      "type": "object",
+      "properties": {
+        "exportedAt": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamBundleGroup"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamBundlePolicy"
+          }
+        },
+        "policyMappings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamBundlePolicyMapping"
+          }
+        },
+        "serviceAccounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamBundleServiceAccount"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamBundleUser"
+          }
+        },
+        "version": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "iamBundleGroup": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "iamBundlePolicy": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "iamBundlePolicyMapping": {
+      "type": "object",
+      "properties": {
+        "entity": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "iamBundleServiceAccount": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "impliedPolicy": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "parentUser": {
           "type": "string"
         },
-        "targetURL": {
+        "policy": {
+          "type": "string"
+        },
+        "status": {
           "type": "string"
         }
       }
     },
-    "deleteFile": {
+    "iamBundleUser": {
       "type": "object",
       "properties": {
-        "path": {
+        "accessKey": {
           "type": "string"
         },
-        "recursive": {
-          "type": "boolean"
-        },
-        "versionID": {
+        "status": {
           "type": "string"
         }
       }
     },
-    "envOverride": {
+    "iamEntity": {
+      "type": "string"
+    },
+    "iamImportChange": {
       "type": "object",
       "properties": {
-        "name": {
+        "action": {
           "type": "string"
         },
-        "value": {
+        "detail": {
           "type": "string"
-        }
-      }
-    },
-    "environmentConstants": {
-      "type": "object",
-      "properties": {
-        "maxConcurrentDownloads": {
-          "type": "integer"
         },
-        "maxConcurrentUploads": {
-          "type": "integer"
+        "entityType": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "getBucketRetentionConfig": {
+    "iamImportRequest": {
       "type": "object",
       "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
+        "bundle": {
+          "$ref": "#/definitions/iamBundle"
         },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
+        "conflictStrategy": {
+          "type": "string"
         },
-        "validity": {
-          "type": "integer",
-          "format": "int32"
+        "dryRun": {
+          "type": "boolean"
         }
       }
     },
-    "group": {
+    "iamImportResponse": {
       "type": "object",
       "properties": {
-        "members": {
+        "changes": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/iamImportChange"
           }
         },
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
+        "dryRun": {
+          "type": "boolean"
         }
       }
     },
-    "iamEntity": {
-      "type": "string"
-    },
     "iamPolicy": {
       "type": "object",
       "properties": {
@@ -12726,6 +16192,34 @@ func init() {
         }
       }
     },
+    "ldapEntitiesSearchResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ldapEntitySearchResult"
+          }
+        }
+      }
+    },
+    "ldapEntitySearchResult": {
+      "type": "object",
+      "properties": {
+        "dn": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "ldapGroupPolicyEntity": {
       "type": "object",
       "properties": {
@@ -12740,6 +16234,69 @@ func init() {
         }
       }
     },
+    "ldapPolicyAssociationRequest": {
+      "type": "object",
+      "required": [
+        "policies"
+      ],
+      "properties": {
+        "groups": {
+          "description": "Group DNs",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "users": {
+          "description": "User DNs",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ldapPolicyAssociationResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ldapPolicyAssociationResult"
+          }
+        }
+      }
+    },
+    "ldapPolicyAssociationResult": {
+      "type": "object",
+      "properties": {
+        "dn": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "policies": {
+          "description": "Policies actually attached or detached",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
     "ldapPolicyEntity": {
       "type": "object",
       "properties": {
@@ -12797,6 +16354,32 @@ func init() {
         }
       }
     },
+    "lintPolicyRequest": {
+      "type": "object",
+      "required": [
+        "policy"
+      ],
+      "properties": {
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "lintPolicyResponse": {
+      "type": "object",
+      "properties": {
+        "findings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyLintFinding"
+          }
+        },
+        "valid": {
+          "description": "False when any of the findings is an error",
+          "type": "boolean"
+        }
+      }
+    },
     "listAccessRulesResponse": {
       "type": "object",
       "properties": {
@@ -13092,6 +16675,26 @@ func init() {
         }
       }
     },
+    "matchedStatement": {
+      "type": "object",
+      "properties": {
+        "effect": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "sid": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string"
+        },
+        "statement": {
+          "type": "string"
+        }
+      }
+    },
     "maxShareLinkExpResponse": {
       "type": "object",
       "required": [
@@ -13503,6 +17106,10 @@ func init() {
     "policy": {
       "type": "object",
       "properties": {
+        "historyError": {
+          "description": "Why the change of the policy couldn't be recorded in its history, empty when it was",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
@@ -13511,28 +17118,129 @@ func init() {
         }
       }
     },
-    "policyArgs": {
+    "policyArgs": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "bucket_name": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        }
+      }
+    },
+    "policyDiffEntry": {
+      "type": "object",
+      "properties": {
+        "from": {
+          "type": "string"
+        },
+        "op": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "to": {
+          "type": "string"
+        }
+      }
+    },
+    "policyEntity": {
+      "type": "string",
+      "default": "user",
+      "enum": [
+        "user",
+        "group"
+      ]
+    },
+    "policyHistoryEntry": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "diff": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyDiffEntry"
+          }
+        },
+        "document": {
+          "type": "string"
+        },
+        "editor": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "previousDocument": {
+          "type": "string"
+        },
+        "timestamp": {
+          "type": "string"
+        },
+        "version": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "policyHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyHistoryEntry"
+          }
+        },
+        "errors": {
+          "description": "The changes that can't be read, e.g. because they weren't recorded by the console",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "policyLintFinding": {
       "type": "object",
       "properties": {
-        "action": {
+        "code": {
           "type": "string"
         },
-        "bucket_name": {
+        "message": {
           "type": "string"
         },
-        "id": {
+        "severity": {
+          "type": "string",
+          "enum": [
+            "error",
+            "warning",
+            "info"
+          ]
+        },
+        "sid": {
+          "type": "string"
+        },
+        "statement": {
+          "description": "Index of the statement the finding refers to, -1 for the whole document",
+          "type": "integer",
+          "format": "int32"
+        },
+        "suggestion": {
           "type": "string"
         }
       }
     },
-    "policyEntity": {
-      "type": "string",
-      "default": "user",
-      "enum": [
-        "user",
-        "group"
-      ]
-    },
     "prefixAccessPair": {
       "type": "object",
       "properties": {
@@ -13922,6 +17630,14 @@ func init() {
         }
       }
     },
+    "rollbackPolicyRequest": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "type": "string"
+        }
+      }
+    },
     "selectedSAs": {
       "type": "array",
       "items": {
@@ -14044,6 +17760,63 @@ func init() {
         }
       }
     },
+    "serviceAccountNotification": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "deleteAfter": {
+          "description": "When the rotated access key gets deleted",
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "expiring",
+            "expired",
+            "aged",
+            "rotated",
+            "rotationFailed",
+            "retired"
+          ]
+        },
+        "message": {
+          "type": "string"
+        },
+        "newAccessKey": {
+          "description": "Access key replacing a rotated one",
+          "type": "string"
+        },
+        "parentUser": {
+          "type": "string"
+        },
+        "secretKey": {
+          "description": "Secret key of the replacing access key, only returned once to its owner by the console that rotated it",
+          "type": "string"
+        },
+        "time": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountNotifications": {
+      "type": "object",
+      "properties": {
+        "notifications": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceAccountNotification"
+          }
+        }
+      }
+    },
     "serviceAccountRequest": {
       "type": "object",
       "properties": {
@@ -14318,6 +18091,120 @@ func init() {
         }
       }
     },
+    "simulatePolicyRequest": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "entityName",
+        "actions",
+        "resource"
+      ],
+      "properties": {
+        "actions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "conditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationCondition"
+          }
+        },
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "$ref": "#/definitions/simulationEntity"
+        },
+        "resource": {
+          "type": "string"
+        }
+      }
+    },
+    "simulatePolicyResponse": {
+      "type": "object",
+      "properties": {
+        "findings": {
+          "description": "Issues found getting the policies of the entity, e.g. a mapped policy missing on the server",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedPolicy"
+          }
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedActionResult"
+          }
+        }
+      }
+    },
+    "simulatedActionResult": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "allowed": {
+          "type": "boolean"
+        },
+        "matchedStatements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/matchedStatement"
+          }
+        },
+        "reason": {
+          "description": "Why the action is denied regardless of the policies, e.g. the account is disabled",
+          "type": "string"
+        }
+      }
+    },
+    "simulatedPolicy": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string"
+        }
+      }
+    },
+    "simulationCondition": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "values": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "simulationEntity": {
+      "type": "string",
+      "default": "user",
+      "enum": [
+        "user",
+        "group",
+        "serviceAccount"
+      ]
+    },
     "siteReplicationAddRequest": {
       "type": "array",
       "items": {
@@ -14399,6 +18286,108 @@ func init() {
         }
       }
     },
+    "staleIdentityCleanupItem": {
+      "type": "object",
+      "properties": {
+        "entityType": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "staleIdentityCleanupRequest": {
+      "type": "object",
+      "required": [
+        "items"
+      ],
+      "properties": {
+        "disabledDays": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/staleIdentityCleanupItem"
+          }
+        }
+      }
+    },
+    "staleIdentityCleanupResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/staleIdentityCleanupResult"
+          }
+        }
+      }
+    },
+    "staleIdentityCleanupResult": {
+      "type": "object",
+      "properties": {
+        "entityType": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "staleIdentityFinding": {
+      "type": "object",
+      "properties": {
+        "cleanupAction": {
+          "type": "string"
+        },
+        "detail": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "userWithoutPolicy",
+            "emptyGroup",
+            "unusedPolicy",
+            "disabledUser",
+            "orphanServiceAccount"
+          ]
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "staleIdentityReport": {
+      "type": "object",
+      "properties": {
+        "disabledDays": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "findings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/staleIdentityFinding"
+          }
+        },
+        "generatedAt": {
+          "type": "string"
+        }
+      }
+    },
     "startProfilingItem": {
       "type": "object",
       "properties": {
@@ -14511,6 +18500,12 @@ func init() {
         "accessKey": {
           "type": "string"
         },
+        "deleteAt": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string"
+        },
         "hasPolicy": {
           "type": "boolean"
         },
@@ -14531,6 +18526,19 @@ func init() {
         }
       }
     },
+    "userExpiryRequest": {
+      "type": "object",
+      "properties": {
+        "expiresAt": {
+          "description": "RFC 3339 date the user is disabled at, the expiry is cleared when empty",
+          "type": "string"
+        },
+        "retention": {
+          "description": "how long the user is kept once disabled before it is deleted, e.g. 720h, it is never deleted when empty",
+          "type": "string"
+        }
+      }
+    },
     "userSAs": {
       "type": "object",
       "properties": {
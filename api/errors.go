@@ -60,6 +60,10 @@ var (
 	ErrLoginNotAllowed                  = errors.New("login not allowed")
 	ErrHealthReportFail                 = errors.New("failure to generate Health report")
 	ErrNetworkError                     = errors.New("unable to login due to network error")
+	ErrIAMImportConflict                = errors.New("IAM bundle conflicts with existing entities")
+	ErrPolicyNoPreviousVersion          = errors.New("the policy change has no previous document to restore")
+	ErrPolicyHistoryUnverified          = errors.New("the policy change wasn't recorded by the console and can't be restored")
+	ErrPolicyLintFailed                 = errors.New("the policy failed the lint checks")
 )
 
 type CodedAPIError struct {
@@ -239,6 +243,22 @@ func ErrorWithContext(ctx context.Context, err ...interface{}) *CodedAPIError {
 				errorCode = 413
 				errorMessage = err1.Error()
 			}
+			if errors.Is(err1, ErrIAMImportConflict) {
+				errorCode = 409
+				errorMessage = ErrIAMImportConflict.Error()
+			}
+			if errors.Is(err1, ErrPolicyNoPreviousVersion) {
+				errorCode = 400
+				errorMessage = ErrPolicyNoPreviousVersion.Error()
+			}
+			if errors.Is(err1, ErrPolicyHistoryUnverified) {
+				errorCode = 400
+				errorMessage = ErrPolicyHistoryUnverified.Error()
+			}
+			if errors.Is(err1, ErrPolicyLintFailed) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
 			// bucket already exists
 			if openstor.ToErrorResponse(err1).Code == "BucketAlreadyOwnedByYou" {
 				errorCode = 400
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openstor/console/pkg/auth/idp/oauth2"
+)
+
+// healthCheckTimeout bounds every check of the readiness probe
+const healthCheckTimeout = 5 * time.Second
+
+// Status of the probes and of their checks
+const (
+	healthStatusOK      = "ok"
+	healthStatusFail    = "fail"
+	healthStatusSkipped = "skipped"
+)
+
+// healthCheck is the result of a check of the readiness probe
+type healthCheck struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Message  string `json:"message,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// healthResponse is the body of the probes
+type healthResponse struct {
+	Status string         `json:"status"`
+	Checks []*healthCheck `json:"checks,omitempty"`
+}
+
+func writeHealthResponse(w http.ResponseWriter, r *http.Request, response *healthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if response.Status != healthStatusOK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if r.Method == http.MethodHead {
+		return
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// serveHealthLive answers the liveness probe, the console process is alive as long as it serves requests
+func serveHealthLive(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	writeHealthResponse(w, r, &healthResponse{Status: healthStatusOK})
+}
+
+// serveHealthReady answers the readiness probe: the MinIO server must be reachable and the TLS certificates
+// valid, the discovery doc of the identity providers is checked when CONSOLE_HEALTH_CHECK_IDP is on. Every check
+// is reported, the console isn't ready when one of them fails.
+func serveHealthReady(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	ctx := r.Context()
+	response := &healthResponse{Status: healthStatusOK}
+	response.Checks = append(response.Checks, runHealthCheck(ctx, "minio", checkMinIOHealth))
+	response.Checks = append(response.Checks, runHealthCheck(ctx, "tls", checkTLSCertificates))
+	response.Checks = append(response.Checks, runHealthCheck(ctx, "idp", checkIDPDiscovery))
+	for _, check := range response.Checks {
+		if check.Status == healthStatusFail {
+			response.Status = healthStatusFail
+		}
+	}
+	writeHealthResponse(w, r, response)
+}
+
+// runHealthCheck runs a check, the check returns a message when it is skipped
+func runHealthCheck(ctx context.Context, name string, check func(ctx context.Context) (skipped string, err error)) *healthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	start := time.Now()
+	skipped, err := check(ctx)
+	result := &healthCheck{Name: name, Status: healthStatusOK, Duration: time.Since(start).String()}
+	switch {
+	case err != nil:
+		result.Status = healthStatusFail
+		result.Message = err.Error()
+	case skipped != "":
+		result.Status = healthStatusSkipped
+		result.Message = skipped
+	}
+	return result
+}
+
+// checkMinIOHealth calls the liveness endpoint of the MinIO server
+func checkMinIOHealth(ctx context.Context) (string, error) {
+	endpoint := strings.TrimSuffix(getMinIOServer(), "/") + "/minio/health/live"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := GetConsoleHTTPClient("").Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s returned %s", getMinIOServer(), resp.Status)
+	}
+	return "", nil
+}
+
+// checkTLSCertificates fails when one of the certificates the console serves is expired or not valid yet. The
+// certificates manager reloads them when they change on disk, it is asked for the certificate it serves by default
+// and for every server name of the certificates loaded at startup.
+func checkTLSCertificates(_ context.Context) (string, error) {
+	if GlobalTLSCertsManager == nil {
+		return "TLS isn't enabled", nil
+	}
+	serverNames := []string{""}
+	for _, cert := range GlobalPublicCerts {
+		serverNames = append(serverNames, cert.DNSNames...)
+	}
+	now := time.Now()
+	checked := map[*tls.Certificate]bool{}
+	for _, serverName := range serverNames {
+		certificate, err := GlobalTLSCertsManager.GetCertificate(&tls.ClientHelloInfo{ServerName: serverName})
+		if err != nil {
+			return "", err
+		}
+		// the manager parses the leaf of the certificates it loads
+		if certificate == nil || certificate.Leaf == nil || checked[certificate] {
+			continue
+		}
+		checked[certificate] = true
+		cert := certificate.Leaf
+		if now.After(cert.NotAfter) {
+			return "", fmt.Errorf("certificate %s expired on %s", cert.Subject, cert.NotAfter.UTC().Format(time.RFC3339))
+		}
+		if now.Before(cert.NotBefore) {
+			return "", fmt.Errorf("certificate %s isn't valid before %s", cert.Subject, cert.NotBefore.UTC().Format(time.RFC3339))
+		}
+	}
+	return "", nil
+}
+
+// checkIDPDiscovery verifies the discovery doc of every identity provider parses
+func checkIDPDiscovery(ctx context.Context) (string, error) {
+	if !getHealthCheckIDP() {
+		return "the identity providers aren't checked", nil
+	}
+	if len(GlobalMinIOConfig.OpenIDProviders) == 0 {
+		return "no identity provider is configured", nil
+	}
+	for _, name := range sortedKeys(GlobalMinIOConfig.OpenIDProviders) {
+		if err := oauth2.CheckDiscoveryDoc(ctx, GlobalMinIOConfig.OpenIDProviders[name].URL, GetConsoleHTTPClient("")); err != nil {
+			return "", fmt.Errorf("identity provider %s: %w", name, err)
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openstor/console/pkg/auth/idp/oauth2"
+	xcerts "github.com/openstor/pkg/v3/certs"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeTestCertificate writes a self-signed certificate and its key to the public.crt and private.key files of dir
+func writeTestCertificate(t *testing.T, dir, name string, notBefore, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := filepath.Join(dir, "public.crt"), filepath.Join(dir, "private.key")
+	if err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestCheckTLSCertificates(t *testing.T) {
+	funcAssert := assert.New(t)
+	defer func() {
+		GlobalPublicCerts = nil
+		GlobalTLSCertsManager = nil
+	}()
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCertificate(t, dir, "console", time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	manager, err := xcerts.NewManager(context.Background(), certFile, keyFile, tls.LoadX509KeyPair, xcerts.WithDisableAutoReload())
+	funcAssert.NoError(err)
+	GlobalTLSCertsManager = manager
+	certificate, err := manager.GetCertificate(&tls.ClientHelloInfo{})
+	funcAssert.NoError(err)
+	GlobalPublicCerts = []*x509.Certificate{certificate.Leaf}
+
+	// Test-1: the certificate loaded at startup is valid
+	_, err = checkTLSCertificates(context.Background())
+	funcAssert.NoError(err)
+
+	// Test-2: the certificate reloaded from disk is checked rather than the one loaded at startup
+	writeTestCertificate(t, dir, "console", time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+	funcAssert.NoError(manager.AddCertificate(certFile, keyFile))
+	_, err = checkTLSCertificates(context.Background())
+	if funcAssert.Error(err) {
+		funcAssert.Contains(err.Error(), "expired")
+	}
+}
+
+func TestServeHealthLive(t *testing.T) {
+	w := httptest.NewRecorder()
+	serveHealthLive(w, httptest.NewRequest(http.MethodGet, "/health/live", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	serveHealthLive(w, httptest.NewRequest(http.MethodPost, "/health/live", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestServeHealthReady(t *testing.T) {
+	minioUp := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/minio/health/live":
+			if !minioUp {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		case "/.well-known/openid-configuration":
+			w.Write([]byte(`{"issuer":"idp","authorization_endpoint":"https://idp/auth","token_endpoint":"https://idp/token"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	t.Setenv(ConsoleMinIOServer, server.URL)
+	defer func() {
+		GlobalTLSCertsManager = nil
+		GlobalMinIOConfig.OpenIDProviders = nil
+	}()
+
+	certFile, keyFile := writeTestCertificate(t, t.TempDir(), "console", time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	validCerts, err := xcerts.NewManager(context.Background(), certFile, keyFile, tls.LoadX509KeyPair, xcerts.WithDisableAutoReload())
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile = writeTestCertificate(t, t.TempDir(), "old", time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+	expiredCerts, err := xcerts.NewManager(context.Background(), certFile, keyFile, tls.LoadX509KeyPair, xcerts.WithDisableAutoReload())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		minioUp    bool
+		certs      *xcerts.Manager
+		checkIDP   string
+		idpURL     string
+		wantStatus int
+		wantChecks map[string]string
+	}{
+		{
+			name:       "ready without TLS",
+			minioUp:    true,
+			wantStatus: http.StatusOK,
+			wantChecks: map[string]string{"minio": healthStatusOK, "tls": healthStatusSkipped, "idp": healthStatusSkipped},
+		},
+		{
+			name:       "MinIO unreachable",
+			certs:      validCerts,
+			wantStatus: http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"minio": healthStatusFail, "tls": healthStatusOK, "idp": healthStatusSkipped},
+		},
+		{
+			name:       "certificate expired",
+			minioUp:    true,
+			certs:      expiredCerts,
+			wantStatus: http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"minio": healthStatusOK, "tls": healthStatusFail, "idp": healthStatusSkipped},
+		},
+		{
+			name:       "identity provider",
+			minioUp:    true,
+			checkIDP:   "on",
+			idpURL:     server.URL + "/.well-known/openid-configuration",
+			wantStatus: http.StatusOK,
+			wantChecks: map[string]string{"minio": healthStatusOK, "tls": healthStatusSkipped, "idp": healthStatusOK},
+		},
+		{
+			name:       "identity provider without discovery doc",
+			minioUp:    true,
+			checkIDP:   "on",
+			idpURL:     server.URL + "/missing",
+			wantStatus: http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"minio": healthStatusOK, "tls": healthStatusSkipped, "idp": healthStatusFail},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minioUp = tt.minioUp
+			GlobalTLSCertsManager = tt.certs
+			t.Setenv(ConsoleHealthCheckIDP, tt.checkIDP)
+			GlobalMinIOConfig.OpenIDProviders = nil
+			if tt.idpURL != "" {
+				GlobalMinIOConfig.OpenIDProviders = oauth2.OpenIDPCfg{"sso": {URL: tt.idpURL}}
+			}
+			w := httptest.NewRecorder()
+			serveHealthReady(w, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+			assert.Equal(t, tt.wantStatus, w.Code)
+			var response healthResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			checks := map[string]string{}
+			for _, check := range response.Checks {
+				checks[check.Name] = check.Status
+				if check.Status != healthStatusOK {
+					assert.NotEmpty(t, check.Message)
+				}
+			}
+			assert.Equal(t, tt.wantChecks, checks)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/openstor/console/pkg/auth/ldap"
+)
+
+// ldapSearchTimeout bounds a search of the LDAP directory when the request has no deadline
+const ldapSearchTimeout = 10 * time.Second
+
+// errLDAPDirectoryNotConfigured is returned when the directory is searched without the lookup bind being set
+var errLDAPDirectoryNotConfigured = errors.New("the LDAP directory can't be searched, " + ldap.ConsoleLDAPServerAddr +
+	" and the lookup bind credentials aren't set")
+
+// ldapDirectory searches the user and group DNs of the LDAP directory
+type ldapDirectory interface {
+	// search returns at most limit DNs of the entity type matching the query, limit 0 doesn't limit them
+	search(ctx context.Context, entityType, query string, limit int) ([]string, error)
+}
+
+// lookupBindLDAPDirectory searches the directory with the lookup bind, a read-only account of the directory as
+// the one the server looks up the users with
+type lookupBindLDAPDirectory struct {
+	addr         string
+	insecure     bool
+	startTLS     bool
+	tlsConfig    *tls.Config
+	bindDN       string
+	bindPassword string
+	// baseDNs and filters are by entity type
+	baseDNs map[string][]string
+	filters map[string]string
+}
+
+// newLDAPDirectory returns the directory configured with the CONSOLE_LDAP_* variables, nil when the server or
+// the lookup bind isn't set
+func newLDAPDirectory() ldapDirectory {
+	addr := ldap.GetServerAddr()
+	bindDN, bindPassword := ldap.GetLookupBind()
+	if addr == "" || bindDN == "" {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	userBaseDNs, userFilter := ldap.GetUserSearch()
+	groupBaseDNs, groupFilter := ldap.GetGroupSearch()
+	return &lookupBindLDAPDirectory{
+		addr:     addr,
+		insecure: ldap.GetServerInsecure(),
+		startTLS: ldap.GetServerStartTLS(),
+		tlsConfig: &tls.Config{
+			ServerName:         host,
+			RootCAs:            GlobalRootCAs,
+			InsecureSkipVerify: ldap.GetTLSSkipVerify(),
+			MinVersion:         tls.VersionTLS12,
+		},
+		bindDN:       bindDN,
+		bindPassword: bindPassword,
+		baseDNs: map[string][]string{
+			ldapEntityUser:  splitLDAPBaseDNs(userBaseDNs),
+			ldapEntityGroup: splitLDAPBaseDNs(groupBaseDNs),
+		},
+		filters: map[string]string{ldapEntityUser: userFilter, ldapEntityGroup: groupFilter},
+	}
+}
+
+// splitLDAPBaseDNs splits the base DNs separated by `;`
+func splitLDAPBaseDNs(baseDNs string) []string {
+	var dns []string
+	for _, dn := range strings.Split(baseDNs, ";") {
+		if dn = strings.TrimSpace(dn); dn != "" {
+			dns = append(dns, dn)
+		}
+	}
+	return dns
+}
+
+// ldapSearchFilter replaces the %s of the filter by the escaped query, `*%s*` matches everything when the query
+// is empty
+func ldapSearchFilter(filter, query string) string {
+	escaped := goldap.EscapeFilter(query)
+	if escaped == "" {
+		filter = strings.ReplaceAll(filter, "*%s*", "*")
+	}
+	return strings.ReplaceAll(filter, "%s", escaped)
+}
+
+func (d *lookupBindLDAPDirectory) dial() (*goldap.Conn, error) {
+	dialer := &net.Dialer{Timeout: ldapSearchTimeout}
+	if !d.insecure && !d.startTLS {
+		return goldap.DialURL("ldaps://"+d.addr, goldap.DialWithDialer(dialer), goldap.DialWithTLSConfig(d.tlsConfig))
+	}
+	conn, err := goldap.DialURL("ldap://"+d.addr, goldap.DialWithDialer(dialer))
+	if err != nil {
+		return nil, err
+	}
+	if d.startTLS {
+		if err := conn.StartTLS(d.tlsConfig); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func (d *lookupBindLDAPDirectory) search(ctx context.Context, entityType, query string, limit int) ([]string, error) {
+	baseDNs := d.baseDNs[entityType]
+	if len(baseDNs) == 0 {
+		return nil, fmt.Errorf("the %s search base DN isn't set", entityType)
+	}
+	conn, err := d.dial()
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the LDAP server: %w", err)
+	}
+	defer conn.Close()
+	timeout := ldapSearchTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	conn.SetTimeout(timeout)
+	if err := conn.Bind(d.bindDN, d.bindPassword); err != nil {
+		return nil, fmt.Errorf("unable to bind to the LDAP server with the lookup bind: %w", err)
+	}
+	filter := ldapSearchFilter(d.filters[entityType], query)
+	var dns []string
+	for _, baseDN := range baseDNs {
+		sizeLimit := 0
+		if limit > 0 {
+			sizeLimit = limit - len(dns)
+		}
+		request := goldap.NewSearchRequest(baseDN, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, sizeLimit, 0, false, filter, []string{"dn"}, nil)
+		result, err := conn.Search(request)
+		// the entries found until the size limit is reached are returned along with the error
+		if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+			return nil, err
+		}
+		if result == nil {
+			continue
+		}
+		for _, entry := range result.Entries {
+			dns = append(dns, entry.DN)
+		}
+		if limit > 0 && len(dns) >= limit {
+			return dns[:limit], nil
+		}
+	}
+	return dns, nil
+}
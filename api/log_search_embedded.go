@@ -0,0 +1,499 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// logSearchStoreFile is the file of the store directory keeping the indexed entries, one JSON entry per line
+const logSearchStoreFile = "entries.ndjson"
+
+// logSearchMaxIngestSize limits the size of a batch of audit entries posted to the ingest endpoint
+const logSearchMaxIngestSize = 32 << 20
+
+// logSearchFields are the fields of the results the `fp` filters apply to
+var logSearchFields = map[string]bool{
+	"api_name":             true,
+	"bucket":               true,
+	"object":               true,
+	"access_key":           true,
+	"remote_host":          true,
+	"request_id":           true,
+	"user_agent":           true,
+	"response_status":      true,
+	"response_status_code": true,
+}
+
+// globalLogSearchStore indexes the audit entries of the MinIO servers when the embedded log search is enabled
+var globalLogSearchStore *logSearchStore
+
+// logSearchAuditEntry holds the fields of the MinIO audit entries indexed by the embedded log search
+type logSearchAuditEntry struct {
+	Time time.Time `json:"time"`
+	API  struct {
+		Name               string `json:"name"`
+		Bucket             string `json:"bucket"`
+		Object             string `json:"object"`
+		Status             string `json:"status"`
+		StatusCode         int    `json:"statusCode"`
+		InputBytes         int64  `json:"rx"`
+		OutputBytes        int64  `json:"tx"`
+		TimeToResponse     string `json:"timeToResponse"`
+		TimeToResponseInNS string `json:"timeToResponseInNS"`
+	} `json:"api"`
+	RemoteHost string `json:"remotehost"`
+	RequestID  string `json:"requestID"`
+	UserAgent  string `json:"userAgent"`
+	AccessKey  string `json:"accessKey"`
+}
+
+// logSearchEntry is an indexed audit entry, its fields are the ones returned by the Log Search API
+type logSearchEntry struct {
+	Time                  time.Time `json:"time"`
+	APIName               string    `json:"api_name"`
+	Bucket                string    `json:"bucket"`
+	Object                string    `json:"object"`
+	AccessKey             string    `json:"access_key"`
+	TimeToResponseNS      int64     `json:"time_to_response_ns"`
+	RemoteHost            string    `json:"remote_host"`
+	RequestID             string    `json:"request_id"`
+	UserAgent             string    `json:"user_agent"`
+	ResponseStatus        string    `json:"response_status"`
+	ResponseStatusCode    int       `json:"response_status_code"`
+	RequestContentLength  int64     `json:"request_content_length"`
+	ResponseContentLength int64     `json:"response_content_length"`
+}
+
+// newLogSearchEntry indexes a MinIO audit entry
+func newLogSearchEntry(audit logSearchAuditEntry) logSearchEntry {
+	entry := logSearchEntry{
+		Time:                  audit.Time.UTC(),
+		APIName:               audit.API.Name,
+		Bucket:                audit.API.Bucket,
+		Object:                audit.API.Object,
+		AccessKey:             audit.AccessKey,
+		RemoteHost:            audit.RemoteHost,
+		RequestID:             audit.RequestID,
+		UserAgent:             audit.UserAgent,
+		ResponseStatus:        audit.API.Status,
+		ResponseStatusCode:    audit.API.StatusCode,
+		RequestContentLength:  audit.API.InputBytes,
+		ResponseContentLength: audit.API.OutputBytes,
+	}
+	if entry.Time.IsZero() {
+		entry.Time = time.Now().UTC()
+	}
+	// older servers only report the time to response as a duration
+	if ns, err := strconv.ParseInt(audit.API.TimeToResponseInNS, 10, 64); err == nil {
+		entry.TimeToResponseNS = ns
+	} else if d, err := time.ParseDuration(audit.API.TimeToResponse); err == nil {
+		entry.TimeToResponseNS = d.Nanoseconds()
+	}
+	return entry
+}
+
+// field returns the value of a filtered field
+func (e logSearchEntry) field(name string) string {
+	switch name {
+	case "api_name":
+		return e.APIName
+	case "bucket":
+		return e.Bucket
+	case "object":
+		return e.Object
+	case "access_key":
+		return e.AccessKey
+	case "remote_host":
+		return e.RemoteHost
+	case "request_id":
+		return e.RequestID
+	case "user_agent":
+		return e.UserAgent
+	case "response_status":
+		return e.ResponseStatus
+	case "response_status_code":
+		return strconv.Itoa(e.ResponseStatusCode)
+	}
+	return ""
+}
+
+// result returns the entry as a Log Search API result
+func (e logSearchEntry) result() map[string]interface{} {
+	return map[string]interface{}{
+		"time":                    e.Time.Format(time.RFC3339Nano),
+		"api_name":                e.APIName,
+		"bucket":                  e.Bucket,
+		"object":                  e.Object,
+		"access_key":              e.AccessKey,
+		"time_to_response_ns":     e.TimeToResponseNS,
+		"remote_host":             e.RemoteHost,
+		"request_id":              e.RequestID,
+		"user_agent":              e.UserAgent,
+		"response_status":         e.ResponseStatus,
+		"response_status_code":    e.ResponseStatusCode,
+		"request_content_length":  e.RequestContentLength,
+		"response_content_length": e.ResponseContentLength,
+	}
+}
+
+// logSearchQuery is a query of the embedded log search, built from the LogSearch parameters
+type logSearchQuery struct {
+	filters   map[string][]*regexp.Regexp
+	timeStart time.Time
+	timeEnd   time.Time
+	timeAsc   bool
+	pageSize  int
+	pageNo    int
+}
+
+// newLogSearchQuery parses the LogSearch parameters. The `fp` filters are `field:value` pairs where `*` matches
+// any number of characters, all of them have to match.
+func newLogSearchQuery(fps []string, order, timeStart, timeEnd string, pageSize, pageNo int32) (*logSearchQuery, error) {
+	query := &logSearchQuery{
+		filters:  map[string][]*regexp.Regexp{},
+		timeAsc:  order == "timeAsc",
+		pageSize: int(pageSize),
+		pageNo:   int(pageNo),
+	}
+	for _, fp := range fps {
+		field, value, ok := strings.Cut(fp, ":")
+		if !ok || !logSearchFields[field] {
+			return nil, fmt.Errorf("invalid filter %s: %w", fp, ErrBadRequest)
+		}
+		pattern := strings.ReplaceAll(regexp.QuoteMeta(value), `\*`, ".*")
+		query.filters[field] = append(query.filters[field], regexp.MustCompile("^"+pattern+"$"))
+	}
+	var err error
+	if timeStart != "" {
+		if query.timeStart, err = time.Parse(time.RFC3339, timeStart); err != nil {
+			return nil, fmt.Errorf("invalid timeStart %s: %w", timeStart, ErrBadRequest)
+		}
+	}
+	if timeEnd != "" {
+		if query.timeEnd, err = time.Parse(time.RFC3339, timeEnd); err != nil {
+			return nil, fmt.Errorf("invalid timeEnd %s: %w", timeEnd, ErrBadRequest)
+		}
+	}
+	if query.pageSize <= 0 || query.pageNo < 0 {
+		return nil, fmt.Errorf("invalid page: %w", ErrBadRequest)
+	}
+	return query, nil
+}
+
+func (q *logSearchQuery) match(e logSearchEntry) bool {
+	if !q.timeStart.IsZero() && e.Time.Before(q.timeStart) {
+		return false
+	}
+	if !q.timeEnd.IsZero() && e.Time.After(q.timeEnd) {
+		return false
+	}
+	for field, values := range q.filters {
+		for _, value := range values {
+			if !value.MatchString(e.field(field)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// logSearchStore keeps the most recent audit entries in memory, sorted by time. The entries older than the
+// retention are pruned and only the most recent maxEntries are kept. When a directory is set, the entries are
+// also appended to a file there so they survive a restart of the console.
+type logSearchStore struct {
+	retention  time.Duration
+	maxEntries int
+	path       string
+	now        func() time.Time
+
+	mu      sync.RWMutex
+	entries []logSearchEntry
+	file    *os.File
+	// pruned is set when entries were removed since the file was last written
+	pruned bool
+}
+
+// newLogSearchStore opens the store, loading the entries kept in the directory when one is set
+func newLogSearchStore(dir string, retention time.Duration, maxEntries int) (*logSearchStore, error) {
+	s := &logSearchStore{retention: retention, maxEntries: maxEntries, now: time.Now}
+	if dir == "" {
+		return s, nil
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	s.path = filepath.Join(dir, logSearchStoreFile)
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	// the loaded entries may be pruned, the file is written again with the entries left
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// load reads the entries of the store file, the lines that can't be decoded are skipped
+func (s *logSearchStore) load() error {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var entries []logSearchEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64<<10), 1<<20)
+	for scanner.Scan() {
+		var entry logSearchEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err == nil {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	s.add(entries)
+	return nil
+}
+
+// add indexes the entries and prunes the store, it doesn't write the store file
+func (s *logSearchStore) add(entries []logSearchEntry) {
+	for _, entry := range entries {
+		// the entries mostly arrive in order, the others are inserted at their place
+		i := len(s.entries)
+		if i > 0 && entry.Time.Before(s.entries[i-1].Time) {
+			i = sort.Search(len(s.entries), func(j int) bool {
+				return s.entries[j].Time.After(entry.Time)
+			})
+		}
+		s.entries = append(s.entries, logSearchEntry{})
+		copy(s.entries[i+1:], s.entries[i:])
+		s.entries[i] = entry
+	}
+	s.prune()
+}
+
+// prune removes the entries past the retention or beyond the maximum number of entries
+func (s *logSearchStore) prune() {
+	start := 0
+	if s.retention > 0 {
+		oldest := s.now().Add(-s.retention)
+		start = sort.Search(len(s.entries), func(i int) bool {
+			return !s.entries[i].Time.Before(oldest)
+		})
+	}
+	if s.maxEntries > 0 && len(s.entries)-start > s.maxEntries {
+		start = len(s.entries) - s.maxEntries
+	}
+	if start > 0 {
+		// the pruned entries are released when appending grows the slice again
+		s.entries = s.entries[start:]
+		s.pruned = true
+	}
+}
+
+// Add indexes the entries and appends them to the store file
+func (s *logSearchStore) Add(entries []logSearchEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.add(entries)
+	if s.path == "" {
+		return nil
+	}
+	if s.file == nil {
+		f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return err
+		}
+		s.file = f
+	}
+	w := bufio.NewWriter(s.file)
+	enc := json.NewEncoder(w)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// compact prunes the store and writes the store file again when entries were removed, the file is written
+// aside and renamed so a crash never leaves a partial file
+func (s *logSearchStore) compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prune()
+	if s.path == "" || !s.pruned {
+		return nil
+	}
+	if s.file != nil {
+		s.file.Close()
+		s.file = nil
+	}
+	tmp, err := os.OpenFile(s.path+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for _, entry := range s.entries {
+		if err = enc.Encode(entry); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(s.path+".tmp", s.path)
+	}
+	if err != nil {
+		os.Remove(s.path + ".tmp")
+		return err
+	}
+	s.pruned = false
+	return nil
+}
+
+// Search returns a page of the entries matching the query
+func (s *logSearchStore) Search(query *logSearchQuery) []map[string]interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	results := []map[string]interface{}{}
+	skip := query.pageNo * query.pageSize
+	for i := range s.entries {
+		entry := s.entries[len(s.entries)-1-i]
+		if query.timeAsc {
+			entry = s.entries[i]
+		}
+		if !query.match(entry) {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		results = append(results, entry.result())
+		if len(results) == query.pageSize {
+			break
+		}
+	}
+	return results
+}
+
+// Close closes the store file
+func (s *logSearchStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// startLogSearchStore opens the embedded log search store when enabled and returns the function closing it.
+// The entries past the retention are pruned every minute.
+func startLogSearchStore() func() {
+	if !getLogSearchEmbedded() {
+		return func() {}
+	}
+	store, err := newLogSearchStore(getLogSearchDir(), getLogSearchRetention(), getLogSearchMaxEntries())
+	if err != nil {
+		LogError("unable to start the embedded log search: %v", err)
+		return func() {}
+	}
+	globalLogSearchStore = store
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := store.compact(); err != nil {
+					LogError("embedded log search: %v", err)
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+		if err := store.Close(); err != nil {
+			LogError("embedded log search: %v", err)
+		}
+	}
+}
+
+// serveLogSearchIngest indexes the audit entries posted by the audit webhook of the MinIO servers, one JSON
+// entry per request or a batch of newline delimited entries.
+func serveLogSearchIngest(w http.ResponseWriter, r *http.Request) {
+	store := globalLogSearchStore
+	if store == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	// the audit webhook sends its auth token as is, with or without the Bearer scheme
+	token := getLogSearchIngestToken()
+	authorization := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || subtle.ConstantTimeCompare([]byte(authorization), []byte(token)) != 1 {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="console"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	var entries []logSearchEntry
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, logSearchMaxIngestSize))
+	for {
+		var audit logSearchAuditEntry
+		err := dec.Decode(&audit)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		entries = append(entries, newLogSearchEntry(audit))
+	}
+	if err := store.Add(entries); err != nil {
+		LogError("embedded log search: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
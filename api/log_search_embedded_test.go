@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var logSearchTestTime = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func logSearchTestEntries() []logSearchEntry {
+	return []logSearchEntry{
+		{Time: logSearchTestTime, APIName: "PutObject", Bucket: "photos", Object: "2025/a.jpg", ResponseStatusCode: 200},
+		{Time: logSearchTestTime.Add(time.Minute), APIName: "GetObject", Bucket: "photos", Object: "2025/a.jpg", ResponseStatusCode: 200},
+		{Time: logSearchTestTime.Add(2 * time.Minute), APIName: "GetObject", Bucket: "docs", Object: "report.pdf", ResponseStatusCode: 404},
+		// out of order
+		{Time: logSearchTestTime.Add(30 * time.Second), APIName: "PutObject", Bucket: "photos", Object: "2025/b.jpg", ResponseStatusCode: 200},
+	}
+}
+
+func newLogSearchTestStore(t *testing.T, dir string, retention time.Duration, maxEntries int) *logSearchStore {
+	store, err := newLogSearchStore(dir, retention, maxEntries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.now = func() time.Time { return logSearchTestTime.Add(time.Hour) }
+	return store
+}
+
+func logSearchObjects(results []map[string]interface{}) []string {
+	objects := []string{}
+	for _, result := range results {
+		objects = append(objects, result["api_name"].(string)+" "+result["object"].(string))
+	}
+	return objects
+}
+
+func TestLogSearchStoreSearch(t *testing.T) {
+	store := newLogSearchTestStore(t, "", 0, 0)
+	assert.NoError(t, store.Add(logSearchTestEntries()))
+
+	tests := []struct {
+		name      string
+		fps       []string
+		order     string
+		timeStart string
+		timeEnd   string
+		pageSize  int32
+		pageNo    int32
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name:     "newest first",
+			order:    "timeDesc",
+			pageSize: 10,
+			want:     []string{"GetObject report.pdf", "GetObject 2025/a.jpg", "PutObject 2025/b.jpg", "PutObject 2025/a.jpg"},
+		},
+		{
+			name:     "oldest first",
+			order:    "timeAsc",
+			pageSize: 10,
+			want:     []string{"PutObject 2025/a.jpg", "PutObject 2025/b.jpg", "GetObject 2025/a.jpg", "GetObject report.pdf"},
+		},
+		{
+			name:     "filters",
+			fps:      []string{"bucket:photos", "object:2025/*"},
+			order:    "timeAsc",
+			pageSize: 10,
+			want:     []string{"PutObject 2025/a.jpg", "PutObject 2025/b.jpg", "GetObject 2025/a.jpg"},
+		},
+		{
+			name:     "status code",
+			fps:      []string{"response_status_code:404"},
+			order:    "timeAsc",
+			pageSize: 10,
+			want:     []string{"GetObject report.pdf"},
+		},
+		{
+			name:      "time range",
+			order:     "timeAsc",
+			timeStart: "2025-03-01T12:00:30Z",
+			timeEnd:   "2025-03-01T12:01:00Z",
+			pageSize:  10,
+			want:      []string{"PutObject 2025/b.jpg", "GetObject 2025/a.jpg"},
+		},
+		{
+			name:     "second page",
+			order:    "timeDesc",
+			pageSize: 3,
+			pageNo:   1,
+			want:     []string{"PutObject 2025/a.jpg"},
+		},
+		{
+			name:     "past the last page",
+			order:    "timeDesc",
+			pageSize: 10,
+			pageNo:   1,
+			want:     []string{},
+		},
+		{
+			name:     "unknown filter field",
+			fps:      []string{"secret_key:value"},
+			pageSize: 10,
+			wantErr:  true,
+		},
+		{
+			name:      "invalid time",
+			timeStart: "yesterday",
+			pageSize:  10,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := newLogSearchQuery(tt.fps, tt.order, tt.timeStart, tt.timeEnd, tt.pageSize, tt.pageNo)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrBadRequest)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, logSearchObjects(store.Search(query)))
+		})
+	}
+}
+
+func TestLogSearchStoreRetention(t *testing.T) {
+	funcAssert := assert.New(t)
+	dir := t.TempDir()
+
+	// Test-1: only the most recent entries are kept
+	store := newLogSearchTestStore(t, dir, 0, 2)
+	funcAssert.NoError(store.Add(logSearchTestEntries()))
+	query, _ := newLogSearchQuery(nil, "timeAsc", "", "", 10, 0)
+	funcAssert.Equal([]string{"GetObject 2025/a.jpg", "GetObject report.pdf"}, logSearchObjects(store.Search(query)))
+
+	// Test-2: the entries survive a restart, the compacted file only holds the entries left
+	funcAssert.NoError(store.compact())
+	funcAssert.NoError(store.Close())
+	raw, err := os.ReadFile(store.path)
+	funcAssert.NoError(err)
+	funcAssert.Equal(2, strings.Count(string(raw), "\n"))
+	store = newLogSearchTestStore(t, dir, 0, 10)
+	funcAssert.Equal([]string{"GetObject 2025/a.jpg", "GetObject report.pdf"}, logSearchObjects(store.Search(query)))
+
+	// Test-3: the entries past the retention are pruned
+	store.now = func() time.Time { return logSearchTestTime.Add(time.Hour + 90*time.Second) }
+	store.retention = time.Hour
+	funcAssert.NoError(store.compact())
+	funcAssert.Equal([]string{"GetObject report.pdf"}, logSearchObjects(store.Search(query)))
+	funcAssert.NoError(store.Close())
+}
+
+func TestServeLogSearchIngest(t *testing.T) {
+	funcAssert := assert.New(t)
+	t.Setenv(ConsoleLogSearchIngestToken, "ingest-token")
+	store := newLogSearchTestStore(t, "", 0, 0)
+	store.now = time.Now
+	globalLogSearchStore = store
+	defer func() { globalLogSearchStore = nil }()
+
+	batch := `{"time":"2025-03-01T12:00:00Z","api":{"name":"PutObject","bucket":"photos","object":"a.jpg","status":"OK","statusCode":200,"rx":1024,"tx":0,"timeToResponseInNS":"1500"},"remotehost":"10.0.0.1","requestID":"17A","userAgent":"mc","accessKey":"admin"}
+{"time":"2025-03-01T12:00:01Z","api":{"name":"GetObject","bucket":"photos","object":"a.jpg","status":"OK","statusCode":200,"rx":0,"tx":1024,"timeToResponse":"2ms"},"requestID":"17B"}
+`
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		body          string
+		wantStatus    int
+	}{
+		{name: "missing token", method: http.MethodPost, body: batch, wantStatus: http.StatusUnauthorized},
+		{name: "invalid token", method: http.MethodPost, authorization: "Bearer other", body: batch, wantStatus: http.StatusUnauthorized},
+		{name: "invalid method", method: http.MethodGet, authorization: "ingest-token", wantStatus: http.StatusMethodNotAllowed},
+		{name: "invalid entry", method: http.MethodPost, authorization: "ingest-token", body: `{"time":`, wantStatus: http.StatusBadRequest},
+		{name: "batch", method: http.MethodPost, authorization: "Bearer ingest-token", body: batch, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/logs/ingest", strings.NewReader(tt.body))
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			serveLogSearchIngest(w, r)
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+
+	query, _ := newLogSearchQuery(nil, "timeAsc", "", "", 10, 0)
+	results := store.Search(query)
+	if funcAssert.Len(results, 2) {
+		funcAssert.Equal(map[string]interface{}{
+			"time":                    "2025-03-01T12:00:00Z",
+			"api_name":                "PutObject",
+			"bucket":                  "photos",
+			"object":                  "a.jpg",
+			"access_key":              "admin",
+			"time_to_response_ns":     int64(1500),
+			"remote_host":             "10.0.0.1",
+			"request_id":              "17A",
+			"user_agent":              "mc",
+			"response_status":         "OK",
+			"response_status_code":    200,
+			"request_content_length":  int64(1024),
+			"response_content_length": int64(0),
+		}, results[0])
+		funcAssert.Equal(int64(2*time.Millisecond), results[1]["time_to_response_ns"])
+	}
+}
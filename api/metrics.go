@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openstor/console/pkg/logger"
+	httpTarget "github.com/openstor/console/pkg/logger/target/http"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "console"
+
+// Routes of the websocket connections
+const (
+	metricsWSRouteConsole       = "console"
+	metricsWSRouteObjectManager = "objectManager"
+)
+
+// Login methods
+const (
+	metricsLoginCredentials = "credentials"
+	metricsLoginIDP         = "idp"
+)
+
+var (
+	metricsRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "requests_total",
+		Help:      "Total number of API requests by operation, method and status code",
+	}, []string{"operation", "method", "code"})
+	metricsRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "request_duration_seconds",
+		Help:      "Duration of the API requests by operation",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+	metricsAPIErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "api_errors_total",
+		Help:      "Total number of API errors by status code",
+	}, []string{"code"})
+	metricsWebSocketConnections = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "websocket_connections",
+		Help:      "Number of active websocket connections by route",
+	}, []string{"route"})
+	metricsLogins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "logins_total",
+		Help:      "Total number of logins by method and result",
+	}, []string{"method", "result"})
+	metricsUploadBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "upload_bytes_total",
+		Help:      "Total number of bytes uploaded through the console",
+	})
+	metricsDownloadBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "download_bytes_total",
+		Help:      "Total number of bytes downloaded through the console",
+	})
+)
+
+// metricsRegistry holds the console metrics along with the Go runtime and process ones
+var metricsRegistry = newMetricsRegistry()
+
+func newMetricsRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metricsRequests,
+		metricsRequestDuration,
+		metricsAPIErrors,
+		metricsWebSocketConnections,
+		metricsLogins,
+		metricsUploadBytes,
+		metricsDownloadBytes,
+		newAuditQueueCollector(),
+	)
+	return registry
+}
+
+// auditQueueCollector reports the backlog and the failed deliveries of every audit webhook
+type auditQueueCollector struct {
+	queueLength    *prometheus.Desc
+	failedRequests *prometheus.Desc
+	deadLetters    *prometheus.Desc
+}
+
+func newAuditQueueCollector() *auditQueueCollector {
+	return &auditQueueCollector{
+		queueLength: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "audit_webhook", "queue_length"),
+			"Number of audit log entries waiting to be sent by the audit webhook",
+			[]string{"target"}, nil,
+		),
+		failedRequests: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "audit_webhook", "failed_requests_total"),
+			"Total number of requests to the audit webhook that failed",
+			[]string{"target"}, nil,
+		),
+		deadLetters: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "audit_webhook", "dead_letters_total"),
+			"Total number of audit log entries rejected by the audit webhook and moved to the dead letter file",
+			[]string{"target"}, nil,
+		),
+	}
+}
+
+func (c *auditQueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.queueLength
+	ch <- c.failedRequests
+	ch <- c.deadLetters
+}
+
+func (c *auditQueueCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, target := range logger.AuditTargets() {
+		webhook, ok := target.(*httpTarget.Target)
+		if !ok {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.queueLength, prometheus.GaugeValue, float64(webhook.QueueLength()), target.String())
+		ch <- prometheus.MustNewConstMetric(c.failedRequests, prometheus.CounterValue, float64(webhook.FailedRequests()), target.String())
+		ch <- prometheus.MustNewConstMetric(c.deadLetters, prometheus.CounterValue, float64(webhook.DeadLetters()), target.String())
+	}
+}
+
+// MetricsMiddleware counts and times the API requests by swagger operation, it runs after routing so the matched
+// operation is known. Error responses are counted here, once each, whether the handler built them with
+// ErrorWithContext or not.
+func MetricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		operation := "unknown"
+		if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+			operation = route.Operation.ID
+		}
+		rw := logger.NewResponseWriter(w)
+		next.ServeHTTP(rw, r)
+		code := strconv.Itoa(rw.StatusCode)
+		metricsRequests.WithLabelValues(operation, r.Method, code).Inc()
+		if rw.StatusCode >= http.StatusBadRequest {
+			metricsAPIErrors.WithLabelValues(code).Inc()
+		}
+		metricsRequestDuration.WithLabelValues(operation).Observe(time.Since(rw.StartTime).Seconds())
+	})
+}
+
+// serveMetrics exposes the metrics in the Prometheus format, requiring the bearer token when one is configured
+func serveMetrics(w http.ResponseWriter, r *http.Request) {
+	if token := getMetricsAuthToken(); token != "" {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="console"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+	}
+	promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+}
+
+// recordLogin counts a login attempt
+func recordLogin(method string, err *CodedAPIError) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	metricsLogins.WithLabelValues(method, result).Inc()
+}
+
+// trackWebSocketConnection counts an active websocket connection until the returned function is called
+func trackWebSocketConnection(route string) func() {
+	metricsWebSocketConnections.WithLabelValues(route).Inc()
+	return func() {
+		metricsWebSocketConnections.WithLabelValues(route).Dec()
+	}
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	funcAssert := assert.New(t)
+	requests := metricsRequests.WithLabelValues("unknown", http.MethodGet, "418")
+	apiErrors := metricsAPIErrors.WithLabelValues("418")
+	before, errorsBefore := testutil.ToFloat64(requests), testutil.ToFloat64(apiErrors)
+
+	// Test-1: requests are counted and timed, error responses are counted once
+	handler := MetricsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/unknown", nil))
+
+	funcAssert.Equal(before+1, testutil.ToFloat64(requests))
+	funcAssert.Equal(errorsBefore+1, testutil.ToFloat64(apiErrors))
+	funcAssert.Equal(1, testutil.CollectAndCount(metricsRequestDuration, "console_request_duration_seconds"))
+
+	// Test-2: errors only logged by the handler aren't counted
+	logged := metricsAPIErrors.WithLabelValues("500")
+	loggedBefore := testutil.ToFloat64(logged)
+	handler = MetricsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ErrorWithContext(r.Context(), ErrDefault)
+		w.WriteHeader(http.StatusOK)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/unknown", nil))
+	funcAssert.Equal(loggedBefore, testutil.ToFloat64(logged))
+}
+
+func TestServeMetrics(t *testing.T) {
+	funcAssert := assert.New(t)
+	recordLogin(metricsLoginCredentials, &CodedAPIError{Code: 401})
+	MetricsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/unknown", nil))
+	untrack := trackWebSocketConnection(metricsWSRouteConsole)
+	funcAssert.Equal(float64(1), testutil.ToFloat64(metricsWebSocketConnections.WithLabelValues(metricsWSRouteConsole)))
+	untrack()
+	funcAssert.Equal(float64(0), testutil.ToFloat64(metricsWebSocketConnections.WithLabelValues(metricsWSRouteConsole)))
+
+	// Test-1: metrics are public without a token
+	rec := httptest.NewRecorder()
+	serveMetrics(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	funcAssert.Equal(http.StatusOK, rec.Code)
+	funcAssert.Contains(rec.Body.String(), `console_logins_total{method="credentials",result="failure"}`)
+
+	// Test-2: the bearer token is required once configured
+	t.Setenv(ConsoleMetricsAuthToken, "secret")
+	rec = httptest.NewRecorder()
+	serveMetrics(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	funcAssert.Equal(http.StatusUnauthorized, rec.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	rec = httptest.NewRecorder()
+	serveMetrics(rec, req)
+	funcAssert.Equal(http.StatusUnauthorized, rec.Code)
+
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	serveMetrics(rec, req)
+	funcAssert.Equal(http.StatusOK, rec.Code)
+	funcAssert.Contains(rec.Body.String(), `console_api_errors_total{code="404"}`)
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package bucket
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// GrantBucketAccessHandlerFunc turns a function with the right signature into a grant bucket access handler
+type GrantBucketAccessHandlerFunc func(GrantBucketAccessParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GrantBucketAccessHandlerFunc) Handle(params GrantBucketAccessParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GrantBucketAccessHandler interface for that can handle valid grant bucket access params
+type GrantBucketAccessHandler interface {
+	Handle(GrantBucketAccessParams, *models.Principal) middleware.Responder
+}
+
+// NewGrantBucketAccess creates a new http.Handler for the grant bucket access operation
+func NewGrantBucketAccess(ctx *middleware.Context, handler GrantBucketAccessHandler) *GrantBucketAccess {
+	return &GrantBucketAccess{Context: ctx, Handler: handler}
+}
+
+/*
+	GrantBucketAccess swagger:route PUT /bucket/{bucket}/access-grants Bucket grantBucketAccess
+
+Grant users and groups access to a prefix of the bucket
+*/
+type GrantBucketAccess struct {
+	Context *middleware.Context
+	Handler GrantBucketAccessHandler
+}
+
+func (o *GrantBucketAccess) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGrantBucketAccessParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
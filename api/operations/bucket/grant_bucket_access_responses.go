@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package bucket
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// GrantBucketAccessOKCode is the HTTP code returned for type GrantBucketAccessOK
+const GrantBucketAccessOKCode int = 200
+
+/*
+GrantBucketAccessOK A successful response.
+
+swagger:response grantBucketAccessOK
+*/
+type GrantBucketAccessOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BucketAccessGrant `json:"body,omitempty"`
+}
+
+// NewGrantBucketAccessOK creates GrantBucketAccessOK with default headers values
+func NewGrantBucketAccessOK() *GrantBucketAccessOK {
+
+	return &GrantBucketAccessOK{}
+}
+
+// WithPayload adds the payload to the grant bucket access o k response
+func (o *GrantBucketAccessOK) WithPayload(payload *models.BucketAccessGrant) *GrantBucketAccessOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the grant bucket access o k response
+func (o *GrantBucketAccessOK) SetPayload(payload *models.BucketAccessGrant) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GrantBucketAccessOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GrantBucketAccessDefault Generic error response.
+
+swagger:response grantBucketAccessDefault
+*/
+type GrantBucketAccessDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGrantBucketAccessDefault creates GrantBucketAccessDefault with default headers values
+func NewGrantBucketAccessDefault(code int) *GrantBucketAccessDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GrantBucketAccessDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the grant bucket access default response
+func (o *GrantBucketAccessDefault) WithStatusCode(code int) *GrantBucketAccessDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the grant bucket access default response
+func (o *GrantBucketAccessDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the grant bucket access default response
+func (o *GrantBucketAccessDefault) WithPayload(payload *models.APIError) *GrantBucketAccessDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the grant bucket access default response
+func (o *GrantBucketAccessDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GrantBucketAccessDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
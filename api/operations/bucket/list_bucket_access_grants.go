@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package bucket
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// ListBucketAccessGrantsHandlerFunc turns a function with the right signature into a list bucket access grants handler
+type ListBucketAccessGrantsHandlerFunc func(ListBucketAccessGrantsParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListBucketAccessGrantsHandlerFunc) Handle(params ListBucketAccessGrantsParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListBucketAccessGrantsHandler interface for that can handle valid list bucket access grants params
+type ListBucketAccessGrantsHandler interface {
+	Handle(ListBucketAccessGrantsParams, *models.Principal) middleware.Responder
+}
+
+// NewListBucketAccessGrants creates a new http.Handler for the list bucket access grants operation
+func NewListBucketAccessGrants(ctx *middleware.Context, handler ListBucketAccessGrantsHandler) *ListBucketAccessGrants {
+	return &ListBucketAccessGrants{Context: ctx, Handler: handler}
+}
+
+/*
+	ListBucketAccessGrants swagger:route GET /bucket/{bucket}/access-grants Bucket listBucketAccessGrants
+
+List the users and groups granted access to a prefix of the bucket
+*/
+type ListBucketAccessGrants struct {
+	Context *middleware.Context
+	Handler ListBucketAccessGrantsHandler
+}
+
+func (o *ListBucketAccessGrants) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListBucketAccessGrantsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
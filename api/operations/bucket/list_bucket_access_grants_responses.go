@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package bucket
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// ListBucketAccessGrantsOKCode is the HTTP code returned for type ListBucketAccessGrantsOK
+const ListBucketAccessGrantsOKCode int = 200
+
+/*
+ListBucketAccessGrantsOK A successful response.
+
+swagger:response listBucketAccessGrantsOK
+*/
+type ListBucketAccessGrantsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BucketAccessGrantsResponse `json:"body,omitempty"`
+}
+
+// NewListBucketAccessGrantsOK creates ListBucketAccessGrantsOK with default headers values
+func NewListBucketAccessGrantsOK() *ListBucketAccessGrantsOK {
+
+	return &ListBucketAccessGrantsOK{}
+}
+
+// WithPayload adds the payload to the list bucket access grants o k response
+func (o *ListBucketAccessGrantsOK) WithPayload(payload *models.BucketAccessGrantsResponse) *ListBucketAccessGrantsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list bucket access grants o k response
+func (o *ListBucketAccessGrantsOK) SetPayload(payload *models.BucketAccessGrantsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListBucketAccessGrantsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ListBucketAccessGrantsDefault Generic error response.
+
+swagger:response listBucketAccessGrantsDefault
+*/
+type ListBucketAccessGrantsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewListBucketAccessGrantsDefault creates ListBucketAccessGrantsDefault with default headers values
+func NewListBucketAccessGrantsDefault(code int) *ListBucketAccessGrantsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListBucketAccessGrantsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list bucket access grants default response
+func (o *ListBucketAccessGrantsDefault) WithStatusCode(code int) *ListBucketAccessGrantsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list bucket access grants default response
+func (o *ListBucketAccessGrantsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list bucket access grants default response
+func (o *ListBucketAccessGrantsDefault) WithPayload(payload *models.APIError) *ListBucketAccessGrantsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list bucket access grants default response
+func (o *ListBucketAccessGrantsDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListBucketAccessGrantsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package bucket
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// RevokeBucketAccessHandlerFunc turns a function with the right signature into a revoke bucket access handler
+type RevokeBucketAccessHandlerFunc func(RevokeBucketAccessParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RevokeBucketAccessHandlerFunc) Handle(params RevokeBucketAccessParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RevokeBucketAccessHandler interface for that can handle valid revoke bucket access params
+type RevokeBucketAccessHandler interface {
+	Handle(RevokeBucketAccessParams, *models.Principal) middleware.Responder
+}
+
+// NewRevokeBucketAccess creates a new http.Handler for the revoke bucket access operation
+func NewRevokeBucketAccess(ctx *middleware.Context, handler RevokeBucketAccessHandler) *RevokeBucketAccess {
+	return &RevokeBucketAccess{Context: ctx, Handler: handler}
+}
+
+/*
+	RevokeBucketAccess swagger:route POST /bucket/{bucket}/access-grants/revoke Bucket revokeBucketAccess
+
+Revoke the access of users and groups to a prefix of the bucket
+*/
+type RevokeBucketAccess struct {
+	Context *middleware.Context
+	Handler RevokeBucketAccessHandler
+}
+
+func (o *RevokeBucketAccess) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRevokeBucketAccessParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package bucket
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// RevokeBucketAccessNoContentCode is the HTTP code returned for type RevokeBucketAccessNoContent
+const RevokeBucketAccessNoContentCode int = 204
+
+/*
+RevokeBucketAccessNoContent A successful response.
+
+swagger:response revokeBucketAccessNoContent
+*/
+type RevokeBucketAccessNoContent struct {
+}
+
+// NewRevokeBucketAccessNoContent creates RevokeBucketAccessNoContent with default headers values
+func NewRevokeBucketAccessNoContent() *RevokeBucketAccessNoContent {
+
+	return &RevokeBucketAccessNoContent{}
+}
+
+// WriteResponse to the client
+func (o *RevokeBucketAccessNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+RevokeBucketAccessDefault Generic error response.
+
+swagger:response revokeBucketAccessDefault
+*/
+type RevokeBucketAccessDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewRevokeBucketAccessDefault creates RevokeBucketAccessDefault with default headers values
+func NewRevokeBucketAccessDefault(code int) *RevokeBucketAccessDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RevokeBucketAccessDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the revoke bucket access default response
+func (o *RevokeBucketAccessDefault) WithStatusCode(code int) *RevokeBucketAccessDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the revoke bucket access default response
+func (o *RevokeBucketAccessDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the revoke bucket access default response
+func (o *RevokeBucketAccessDefault) WithPayload(payload *models.APIError) *RevokeBucketAccessDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the revoke bucket access default response
+func (o *RevokeBucketAccessDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RevokeBucketAccessDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
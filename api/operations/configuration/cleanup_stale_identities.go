@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// CleanupStaleIdentitiesHandlerFunc turns a function with the right signature into a cleanup stale identities handler
+type CleanupStaleIdentitiesHandlerFunc func(CleanupStaleIdentitiesParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CleanupStaleIdentitiesHandlerFunc) Handle(params CleanupStaleIdentitiesParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CleanupStaleIdentitiesHandler interface for that can handle valid cleanup stale identities params
+type CleanupStaleIdentitiesHandler interface {
+	Handle(CleanupStaleIdentitiesParams, *models.Principal) middleware.Responder
+}
+
+// NewCleanupStaleIdentities creates a new http.Handler for the cleanup stale identities operation
+func NewCleanupStaleIdentities(ctx *middleware.Context, handler CleanupStaleIdentitiesHandler) *CleanupStaleIdentities {
+	return &CleanupStaleIdentities{Context: ctx, Handler: handler}
+}
+
+/*
+	CleanupStaleIdentities swagger:route POST /iam/stale-report/cleanup Configuration cleanupStaleIdentities
+
+Remove the identities flagged by the stale identity report
+*/
+type CleanupStaleIdentities struct {
+	Context *middleware.Context
+	Handler CleanupStaleIdentitiesHandler
+}
+
+func (o *CleanupStaleIdentities) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCleanupStaleIdentitiesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
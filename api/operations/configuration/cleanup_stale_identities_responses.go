@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// CleanupStaleIdentitiesOKCode is the HTTP code returned for type CleanupStaleIdentitiesOK
+const CleanupStaleIdentitiesOKCode int = 200
+
+/*
+CleanupStaleIdentitiesOK A successful response.
+
+swagger:response cleanupStaleIdentitiesOK
+*/
+type CleanupStaleIdentitiesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.StaleIdentityCleanupResponse `json:"body,omitempty"`
+}
+
+// NewCleanupStaleIdentitiesOK creates CleanupStaleIdentitiesOK with default headers values
+func NewCleanupStaleIdentitiesOK() *CleanupStaleIdentitiesOK {
+
+	return &CleanupStaleIdentitiesOK{}
+}
+
+// WithPayload adds the payload to the cleanup stale identities o k response
+func (o *CleanupStaleIdentitiesOK) WithPayload(payload *models.StaleIdentityCleanupResponse) *CleanupStaleIdentitiesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cleanup stale identities o k response
+func (o *CleanupStaleIdentitiesOK) SetPayload(payload *models.StaleIdentityCleanupResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CleanupStaleIdentitiesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CleanupStaleIdentitiesDefault Generic error response.
+
+swagger:response cleanupStaleIdentitiesDefault
+*/
+type CleanupStaleIdentitiesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewCleanupStaleIdentitiesDefault creates CleanupStaleIdentitiesDefault with default headers values
+func NewCleanupStaleIdentitiesDefault(code int) *CleanupStaleIdentitiesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CleanupStaleIdentitiesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the cleanup stale identities default response
+func (o *CleanupStaleIdentitiesDefault) WithStatusCode(code int) *CleanupStaleIdentitiesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the cleanup stale identities default response
+func (o *CleanupStaleIdentitiesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the cleanup stale identities default response
+func (o *CleanupStaleIdentitiesDefault) WithPayload(payload *models.APIError) *CleanupStaleIdentitiesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cleanup stale identities default response
+func (o *CleanupStaleIdentitiesDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CleanupStaleIdentitiesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
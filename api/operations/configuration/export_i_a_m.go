@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// ExportIAMHandlerFunc turns a function with the right signature into a export i a m handler
+type ExportIAMHandlerFunc func(ExportIAMParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ExportIAMHandlerFunc) Handle(params ExportIAMParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ExportIAMHandler interface for that can handle valid export i a m params
+type ExportIAMHandler interface {
+	Handle(ExportIAMParams, *models.Principal) middleware.Responder
+}
+
+// NewExportIAM creates a new http.Handler for the export i a m operation
+func NewExportIAM(ctx *middleware.Context, handler ExportIAMHandler) *ExportIAM {
+	return &ExportIAM{Context: ctx, Handler: handler}
+}
+
+/*
+	ExportIAM swagger:route GET /iam/export Configuration exportIAM
+
+Export the canned policies, users, groups, policy mappings and service accounts
+*/
+type ExportIAM struct {
+	Context *middleware.Context
+	Handler ExportIAMHandler
+}
+
+func (o *ExportIAM) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewExportIAMParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// ExportIAMOKCode is the HTTP code returned for type ExportIAMOK
+const ExportIAMOKCode int = 200
+
+/*
+ExportIAMOK A successful response.
+
+swagger:response exportIAMOK
+*/
+type ExportIAMOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.IamBundle `json:"body,omitempty"`
+}
+
+// NewExportIAMOK creates ExportIAMOK with default headers values
+func NewExportIAMOK() *ExportIAMOK {
+
+	return &ExportIAMOK{}
+}
+
+// WithPayload adds the payload to the export i a m o k response
+func (o *ExportIAMOK) WithPayload(payload *models.IamBundle) *ExportIAMOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export i a m o k response
+func (o *ExportIAMOK) SetPayload(payload *models.IamBundle) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportIAMOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ExportIAMDefault Generic error response.
+
+swagger:response exportIAMDefault
+*/
+type ExportIAMDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewExportIAMDefault creates ExportIAMDefault with default headers values
+func NewExportIAMDefault(code int) *ExportIAMDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ExportIAMDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the export i a m default response
+func (o *ExportIAMDefault) WithStatusCode(code int) *ExportIAMDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the export i a m default response
+func (o *ExportIAMDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the export i a m default response
+func (o *ExportIAMDefault) WithPayload(payload *models.APIError) *ExportIAMDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export i a m default response
+func (o *ExportIAMDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportIAMDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
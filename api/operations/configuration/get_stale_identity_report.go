@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// GetStaleIdentityReportHandlerFunc turns a function with the right signature into a get stale identity report handler
+type GetStaleIdentityReportHandlerFunc func(GetStaleIdentityReportParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetStaleIdentityReportHandlerFunc) Handle(params GetStaleIdentityReportParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetStaleIdentityReportHandler interface for that can handle valid get stale identity report params
+type GetStaleIdentityReportHandler interface {
+	Handle(GetStaleIdentityReportParams, *models.Principal) middleware.Responder
+}
+
+// NewGetStaleIdentityReport creates a new http.Handler for the get stale identity report operation
+func NewGetStaleIdentityReport(ctx *middleware.Context, handler GetStaleIdentityReportHandler) *GetStaleIdentityReport {
+	return &GetStaleIdentityReport{Context: ctx, Handler: handler}
+}
+
+/*
+	GetStaleIdentityReport swagger:route GET /iam/stale-report Configuration getStaleIdentityReport
+
+Report the unused users, groups, policies and service accounts
+*/
+type GetStaleIdentityReport struct {
+	Context *middleware.Context
+	Handler GetStaleIdentityReportHandler
+}
+
+func (o *GetStaleIdentityReport) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetStaleIdentityReportParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
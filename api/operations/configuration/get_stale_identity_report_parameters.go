@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetStaleIdentityReportParams creates a new GetStaleIdentityReportParams object
+//
+// There are no default values defined in the spec.
+func NewGetStaleIdentityReportParams() GetStaleIdentityReportParams {
+
+	return GetStaleIdentityReportParams{}
+}
+
+// GetStaleIdentityReportParams contains all the bound params for the get stale identity report operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetStaleIdentityReport
+type GetStaleIdentityReportParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: query
+	*/
+	DisabledDays *int32
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetStaleIdentityReportParams() beforehand.
+func (o *GetStaleIdentityReportParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qDisabledDays, qhkDisabledDays, _ := qs.GetOK("disabledDays")
+	if err := o.bindDisabledDays(qDisabledDays, qhkDisabledDays, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDisabledDays binds and validates parameter DisabledDays from query.
+func (o *GetStaleIdentityReportParams) bindDisabledDays(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("disabledDays", "query", "int32", raw)
+	}
+	o.DisabledDays = &value
+
+	return nil
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// GetStaleIdentityReportOKCode is the HTTP code returned for type GetStaleIdentityReportOK
+const GetStaleIdentityReportOKCode int = 200
+
+/*
+GetStaleIdentityReportOK A successful response.
+
+swagger:response getStaleIdentityReportOK
+*/
+type GetStaleIdentityReportOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.StaleIdentityReport `json:"body,omitempty"`
+}
+
+// NewGetStaleIdentityReportOK creates GetStaleIdentityReportOK with default headers values
+func NewGetStaleIdentityReportOK() *GetStaleIdentityReportOK {
+
+	return &GetStaleIdentityReportOK{}
+}
+
+// WithPayload adds the payload to the get stale identity report o k response
+func (o *GetStaleIdentityReportOK) WithPayload(payload *models.StaleIdentityReport) *GetStaleIdentityReportOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get stale identity report o k response
+func (o *GetStaleIdentityReportOK) SetPayload(payload *models.StaleIdentityReport) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetStaleIdentityReportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetStaleIdentityReportDefault Generic error response.
+
+swagger:response getStaleIdentityReportDefault
+*/
+type GetStaleIdentityReportDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGetStaleIdentityReportDefault creates GetStaleIdentityReportDefault with default headers values
+func NewGetStaleIdentityReportDefault(code int) *GetStaleIdentityReportDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetStaleIdentityReportDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get stale identity report default response
+func (o *GetStaleIdentityReportDefault) WithStatusCode(code int) *GetStaleIdentityReportDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get stale identity report default response
+func (o *GetStaleIdentityReportDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get stale identity report default response
+func (o *GetStaleIdentityReportDefault) WithPayload(payload *models.APIError) *GetStaleIdentityReportDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get stale identity report default response
+func (o *GetStaleIdentityReportDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetStaleIdentityReportDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
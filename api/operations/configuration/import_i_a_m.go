@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// ImportIAMHandlerFunc turns a function with the right signature into a import i a m handler
+type ImportIAMHandlerFunc func(ImportIAMParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ImportIAMHandlerFunc) Handle(params ImportIAMParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ImportIAMHandler interface for that can handle valid import i a m params
+type ImportIAMHandler interface {
+	Handle(ImportIAMParams, *models.Principal) middleware.Responder
+}
+
+// NewImportIAM creates a new http.Handler for the import i a m operation
+func NewImportIAM(ctx *middleware.Context, handler ImportIAMHandler) *ImportIAM {
+	return &ImportIAM{Context: ctx, Handler: handler}
+}
+
+/*
+	ImportIAM swagger:route POST /iam/import Configuration importIAM
+
+Import an IAM bundle previously exported
+*/
+type ImportIAM struct {
+	Context *middleware.Context
+	Handler ImportIAMHandler
+}
+
+func (o *ImportIAM) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewImportIAMParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package configuration
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// ImportIAMOKCode is the HTTP code returned for type ImportIAMOK
+const ImportIAMOKCode int = 200
+
+/*
+ImportIAMOK A successful response.
+
+swagger:response importIAMOK
+*/
+type ImportIAMOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.IamImportResponse `json:"body,omitempty"`
+}
+
+// NewImportIAMOK creates ImportIAMOK with default headers values
+func NewImportIAMOK() *ImportIAMOK {
+
+	return &ImportIAMOK{}
+}
+
+// WithPayload adds the payload to the import i a m o k response
+func (o *ImportIAMOK) WithPayload(payload *models.IamImportResponse) *ImportIAMOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import i a m o k response
+func (o *ImportIAMOK) SetPayload(payload *models.IamImportResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportIAMOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ImportIAMDefault Generic error response.
+
+swagger:response importIAMDefault
+*/
+type ImportIAMDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewImportIAMDefault creates ImportIAMDefault with default headers values
+func NewImportIAMDefault(code int) *ImportIAMDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ImportIAMDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the import i a m default response
+func (o *ImportIAMDefault) WithStatusCode(code int) *ImportIAMDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the import i a m default response
+func (o *ImportIAMDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the import i a m default response
+func (o *ImportIAMDefault) WithPayload(payload *models.APIError) *ImportIAMDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import i a m default response
+func (o *ImportIAMDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportIAMDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -89,12 +89,18 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		SystemArnListHandler: system.ArnListHandlerFunc(func(params system.ArnListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation system.ArnList has not yet been implemented")
 		}),
+		IdpAttachLDAPPoliciesHandler: idp.AttachLDAPPoliciesHandlerFunc(func(params idp.AttachLDAPPoliciesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.AttachLDAPPolicies has not yet been implemented")
+		}),
 		BucketBucketInfoHandler: bucket.BucketInfoHandlerFunc(func(params bucket.BucketInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.BucketInfo has not yet been implemented")
 		}),
 		BucketBucketSetPolicyHandler: bucket.BucketSetPolicyHandlerFunc(func(params bucket.BucketSetPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.BucketSetPolicy has not yet been implemented")
 		}),
+		UserBulkProvisionUsersHandler: user.BulkProvisionUsersHandlerFunc(func(params user.BulkProvisionUsersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.BulkProvisionUsers has not yet been implemented")
+		}),
 		UserBulkUpdateUsersGroupsHandler: user.BulkUpdateUsersGroupsHandlerFunc(func(params user.BulkUpdateUsersGroupsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.BulkUpdateUsersGroups has not yet been implemented")
 		}),
@@ -104,12 +110,18 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserCheckUserServiceAccountsHandler: user.CheckUserServiceAccountsHandlerFunc(func(params user.CheckUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.CheckUserServiceAccounts has not yet been implemented")
 		}),
+		ConfigurationCleanupStaleIdentitiesHandler: configuration.CleanupStaleIdentitiesHandlerFunc(func(params configuration.CleanupStaleIdentitiesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.CleanupStaleIdentities has not yet been implemented")
+		}),
 		ConfigurationConfigInfoHandler: configuration.ConfigInfoHandlerFunc(func(params configuration.ConfigInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ConfigInfo has not yet been implemented")
 		}),
 		UserCreateAUserServiceAccountHandler: user.CreateAUserServiceAccountHandlerFunc(func(params user.CreateAUserServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.CreateAUserServiceAccount has not yet been implemented")
 		}),
+		SystemCreateAlertSilenceHandler: system.CreateAlertSilenceHandlerFunc(func(params system.CreateAlertSilenceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.CreateAlertSilence has not yet been implemented")
+		}),
 		BucketCreateBucketEventHandler: bucket.CreateBucketEventHandlerFunc(func(params bucket.CreateBucketEventParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.CreateBucketEvent has not yet been implemented")
 		}),
@@ -125,12 +137,18 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountCreateServiceAccountCredsHandler: service_account.CreateServiceAccountCredsHandlerFunc(func(params service_account.CreateServiceAccountCredsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.CreateServiceAccountCreds has not yet been implemented")
 		}),
+		SystemCustomWidgetDetailsHandler: system.CustomWidgetDetailsHandlerFunc(func(params system.CustomWidgetDetailsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.CustomWidgetDetails has not yet been implemented")
+		}),
 		SystemDashboardWidgetDetailsHandler: system.DashboardWidgetDetailsHandlerFunc(func(params system.DashboardWidgetDetailsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation system.DashboardWidgetDetails has not yet been implemented")
 		}),
 		BucketDeleteAccessRuleWithBucketHandler: bucket.DeleteAccessRuleWithBucketHandlerFunc(func(params bucket.DeleteAccessRuleWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteAccessRuleWithBucket has not yet been implemented")
 		}),
+		SystemDeleteAlertSilenceHandler: system.DeleteAlertSilenceHandlerFunc(func(params system.DeleteAlertSilenceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.DeleteAlertSilence has not yet been implemented")
+		}),
 		BucketDeleteAllReplicationRulesHandler: bucket.DeleteAllReplicationRulesHandlerFunc(func(params bucket.DeleteAllReplicationRulesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteAllReplicationRules has not yet been implemented")
 		}),
@@ -167,6 +185,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountDeleteServiceAccountHandler: service_account.DeleteServiceAccountHandlerFunc(func(params service_account.DeleteServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.DeleteServiceAccount has not yet been implemented")
 		}),
+		IdpDetachLDAPPoliciesHandler: idp.DetachLDAPPoliciesHandlerFunc(func(params idp.DetachLDAPPoliciesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.DetachLDAPPolicies has not yet been implemented")
+		}),
 		BucketDisableBucketEncryptionHandler: bucket.DisableBucketEncryptionHandlerFunc(func(params bucket.DisableBucketEncryptionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DisableBucketEncryption has not yet been implemented")
 		}),
@@ -185,6 +206,12 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ConfigurationExportConfigHandler: configuration.ExportConfigHandlerFunc(func(params configuration.ExportConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ExportConfig has not yet been implemented")
 		}),
+		ConfigurationExportIAMHandler: configuration.ExportIAMHandlerFunc(func(params configuration.ExportIAMParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ExportIAM has not yet been implemented")
+		}),
+		UserExportUserEffectivePermissionsHandler: user.ExportUserEffectivePermissionsHandlerFunc(func(params user.ExportUserEffectivePermissionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ExportUserEffectivePermissions has not yet been implemented")
+		}),
 		BucketGetBucketEncryptionInfoHandler: bucket.GetBucketEncryptionInfoHandlerFunc(func(params bucket.GetBucketEncryptionInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketEncryptionInfo has not yet been implemented")
 		}),
@@ -227,15 +254,27 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountGetServiceAccountHandler: service_account.GetServiceAccountHandlerFunc(func(params service_account.GetServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.GetServiceAccount has not yet been implemented")
 		}),
+		ConfigurationGetStaleIdentityReportHandler: configuration.GetStaleIdentityReportHandlerFunc(func(params configuration.GetStaleIdentityReportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.GetStaleIdentityReport has not yet been implemented")
+		}),
+		UserGetUserEffectivePermissionsHandler: user.GetUserEffectivePermissionsHandlerFunc(func(params user.GetUserEffectivePermissionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.GetUserEffectivePermissions has not yet been implemented")
+		}),
 		UserGetUserInfoHandler: user.GetUserInfoHandlerFunc(func(params user.GetUserInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.GetUserInfo has not yet been implemented")
 		}),
 		PolicyGetUserPolicyHandler: policy.GetUserPolicyHandlerFunc(func(params policy.GetUserPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation policy.GetUserPolicy has not yet been implemented")
 		}),
+		BucketGrantBucketAccessHandler: bucket.GrantBucketAccessHandlerFunc(func(params bucket.GrantBucketAccessParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GrantBucketAccess has not yet been implemented")
+		}),
 		GroupGroupInfoHandler: group.GroupInfoHandlerFunc(func(params group.GroupInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation group.GroupInfo has not yet been implemented")
 		}),
+		ConfigurationImportIAMHandler: configuration.ImportIAMHandlerFunc(func(params configuration.ImportIAMParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ImportIAM has not yet been implemented")
+		}),
 		InspectInspectHandler: inspect.InspectHandlerFunc(func(params inspect.InspectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation inspect.Inspect has not yet been implemented")
 		}),
@@ -260,12 +299,21 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		KmsKMSVersionHandler: k_m_s.KMSVersionHandlerFunc(func(params k_m_s.KMSVersionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation k_m_s.KMSVersion has not yet been implemented")
 		}),
+		PolicyLintPolicyHandler: policy.LintPolicyHandlerFunc(func(params policy.LintPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.LintPolicy has not yet been implemented")
+		}),
 		UserListAUserServiceAccountsHandler: user.ListAUserServiceAccountsHandlerFunc(func(params user.ListAUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.ListAUserServiceAccounts has not yet been implemented")
 		}),
 		BucketListAccessRulesWithBucketHandler: bucket.ListAccessRulesWithBucketHandlerFunc(func(params bucket.ListAccessRulesWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListAccessRulesWithBucket has not yet been implemented")
 		}),
+		SystemListAlertsHandler: system.ListAlertsHandlerFunc(func(params system.ListAlertsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListAlerts has not yet been implemented")
+		}),
+		BucketListBucketAccessGrantsHandler: bucket.ListBucketAccessGrantsHandlerFunc(func(params bucket.ListBucketAccessGrantsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ListBucketAccessGrants has not yet been implemented")
+		}),
 		BucketListBucketEventsHandler: bucket.ListBucketEventsHandlerFunc(func(params bucket.ListBucketEventsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListBucketEvents has not yet been implemented")
 		}),
@@ -278,6 +326,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		IdpListConfigurationsHandler: idp.ListConfigurationsHandlerFunc(func(params idp.ListConfigurationsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.ListConfigurations has not yet been implemented")
 		}),
+		SystemListCustomWidgetsHandler: system.ListCustomWidgetsHandlerFunc(func(params system.ListCustomWidgetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListCustomWidgets has not yet been implemented")
+		}),
 		BucketListExternalBucketsHandler: bucket.ListExternalBucketsHandlerFunc(func(params bucket.ListExternalBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListExternalBuckets has not yet been implemented")
 		}),
@@ -299,12 +350,18 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketListPoliciesWithBucketHandler: bucket.ListPoliciesWithBucketHandlerFunc(func(params bucket.ListPoliciesWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListPoliciesWithBucket has not yet been implemented")
 		}),
+		PolicyListPolicyHistoryHandler: policy.ListPolicyHistoryHandlerFunc(func(params policy.ListPolicyHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ListPolicyHistory has not yet been implemented")
+		}),
 		ReleaseListReleasesHandler: release.ListReleasesHandlerFunc(func(params release.ListReleasesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation release.ListReleases has not yet been implemented")
 		}),
 		BucketListRemoteBucketsHandler: bucket.ListRemoteBucketsHandlerFunc(func(params bucket.ListRemoteBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListRemoteBuckets has not yet been implemented")
 		}),
+		ServiceAccountListServiceAccountNotificationsHandler: service_account.ListServiceAccountNotificationsHandlerFunc(func(params service_account.ListServiceAccountNotificationsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service_account.ListServiceAccountNotifications has not yet been implemented")
+		}),
 		ServiceAccountListUserServiceAccountsHandler: service_account.ListUserServiceAccountsHandlerFunc(func(params service_account.ListUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.ListUserServiceAccounts has not yet been implemented")
 		}),
@@ -380,6 +437,15 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceRestartServiceHandler: service.RestartServiceHandlerFunc(func(params service.RestartServiceParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service.RestartService has not yet been implemented")
 		}),
+		BucketRevokeBucketAccessHandler: bucket.RevokeBucketAccessHandlerFunc(func(params bucket.RevokeBucketAccessParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.RevokeBucketAccess has not yet been implemented")
+		}),
+		PolicyRollbackPolicyHandler: policy.RollbackPolicyHandlerFunc(func(params policy.RollbackPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.RollbackPolicy has not yet been implemented")
+		}),
+		IdpSearchLDAPEntitiesHandler: idp.SearchLDAPEntitiesHandlerFunc(func(params idp.SearchLDAPEntitiesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.SearchLDAPEntities has not yet been implemented")
+		}),
 		AuthSessionCheckHandler: auth.SessionCheckHandlerFunc(func(params auth.SessionCheckParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation auth.SessionCheck has not yet been implemented")
 		}),
@@ -410,6 +476,12 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ObjectShareObjectHandler: object.ShareObjectHandlerFunc(func(params object.ShareObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.ShareObject has not yet been implemented")
 		}),
+		PolicySimulatePolicyHandler: policy.SimulatePolicyHandlerFunc(func(params policy.SimulatePolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.SimulatePolicy has not yet been implemented")
+		}),
+		SystemTestCustomWidgetHandler: system.TestCustomWidgetHandlerFunc(func(params system.TestCustomWidgetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.TestCustomWidget has not yet been implemented")
+		}),
 		IdpUpdateConfigurationHandler: idp.UpdateConfigurationHandlerFunc(func(params idp.UpdateConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.UpdateConfiguration has not yet been implemented")
 		}),
@@ -422,6 +494,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountUpdateServiceAccountHandler: service_account.UpdateServiceAccountHandlerFunc(func(params service_account.UpdateServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.UpdateServiceAccount has not yet been implemented")
 		}),
+		UserUpdateUserExpiryHandler: user.UpdateUserExpiryHandlerFunc(func(params user.UpdateUserExpiryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.UpdateUserExpiry has not yet been implemented")
+		}),
 		UserUpdateUserGroupsHandler: user.UpdateUserGroupsHandlerFunc(func(params user.UpdateUserGroupsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.UpdateUserGroups has not yet been implemented")
 		}),
@@ -507,20 +582,28 @@ type ConsoleAPI struct {
 	SystemAdminInfoHandler system.AdminInfoHandler
 	// SystemArnListHandler sets the operation handler for the arn list operation
 	SystemArnListHandler system.ArnListHandler
+	// IdpAttachLDAPPoliciesHandler sets the operation handler for the attach l d a p policies operation
+	IdpAttachLDAPPoliciesHandler idp.AttachLDAPPoliciesHandler
 	// BucketBucketInfoHandler sets the operation handler for the bucket info operation
 	BucketBucketInfoHandler bucket.BucketInfoHandler
 	// BucketBucketSetPolicyHandler sets the operation handler for the bucket set policy operation
 	BucketBucketSetPolicyHandler bucket.BucketSetPolicyHandler
+	// UserBulkProvisionUsersHandler sets the operation handler for the bulk provision users operation
+	UserBulkProvisionUsersHandler user.BulkProvisionUsersHandler
 	// UserBulkUpdateUsersGroupsHandler sets the operation handler for the bulk update users groups operation
 	UserBulkUpdateUsersGroupsHandler user.BulkUpdateUsersGroupsHandler
 	// AccountChangeUserPasswordHandler sets the operation handler for the change user password operation
 	AccountChangeUserPasswordHandler account.ChangeUserPasswordHandler
 	// UserCheckUserServiceAccountsHandler sets the operation handler for the check user service accounts operation
 	UserCheckUserServiceAccountsHandler user.CheckUserServiceAccountsHandler
+	// ConfigurationCleanupStaleIdentitiesHandler sets the operation handler for the cleanup stale identities operation
+	ConfigurationCleanupStaleIdentitiesHandler configuration.CleanupStaleIdentitiesHandler
 	// ConfigurationConfigInfoHandler sets the operation handler for the config info operation
 	ConfigurationConfigInfoHandler configuration.ConfigInfoHandler
 	// UserCreateAUserServiceAccountHandler sets the operation handler for the create a user service account operation
 	UserCreateAUserServiceAccountHandler user.CreateAUserServiceAccountHandler
+	// SystemCreateAlertSilenceHandler sets the operation handler for the create alert silence operation
+	SystemCreateAlertSilenceHandler system.CreateAlertSilenceHandler
 	// BucketCreateBucketEventHandler sets the operation handler for the create bucket event operation
 	BucketCreateBucketEventHandler bucket.CreateBucketEventHandler
 	// IdpCreateConfigurationHandler sets the operation handler for the create configuration operation
@@ -531,10 +614,14 @@ type ConsoleAPI struct {
 	UserCreateServiceAccountCredentialsHandler user.CreateServiceAccountCredentialsHandler
 	// ServiceAccountCreateServiceAccountCredsHandler sets the operation handler for the create service account creds operation
 	ServiceAccountCreateServiceAccountCredsHandler service_account.CreateServiceAccountCredsHandler
+	// SystemCustomWidgetDetailsHandler sets the operation handler for the custom widget details operation
+	SystemCustomWidgetDetailsHandler system.CustomWidgetDetailsHandler
 	// SystemDashboardWidgetDetailsHandler sets the operation handler for the dashboard widget details operation
 	SystemDashboardWidgetDetailsHandler system.DashboardWidgetDetailsHandler
 	// BucketDeleteAccessRuleWithBucketHandler sets the operation handler for the delete access rule with bucket operation
 	BucketDeleteAccessRuleWithBucketHandler bucket.DeleteAccessRuleWithBucketHandler
+	// SystemDeleteAlertSilenceHandler sets the operation handler for the delete alert silence operation
+	SystemDeleteAlertSilenceHandler system.DeleteAlertSilenceHandler
 	// BucketDeleteAllReplicationRulesHandler sets the operation handler for the delete all replication rules operation
 	BucketDeleteAllReplicationRulesHandler bucket.DeleteAllReplicationRulesHandler
 	// BucketDeleteBucketHandler sets the operation handler for the delete bucket operation
@@ -559,6 +646,8 @@ type ConsoleAPI struct {
 	BucketDeleteSelectedReplicationRulesHandler bucket.DeleteSelectedReplicationRulesHandler
 	// ServiceAccountDeleteServiceAccountHandler sets the operation handler for the delete service account operation
 	ServiceAccountDeleteServiceAccountHandler service_account.DeleteServiceAccountHandler
+	// IdpDetachLDAPPoliciesHandler sets the operation handler for the detach l d a p policies operation
+	IdpDetachLDAPPoliciesHandler idp.DetachLDAPPoliciesHandler
 	// BucketDisableBucketEncryptionHandler sets the operation handler for the disable bucket encryption operation
 	BucketDisableBucketEncryptionHandler bucket.DisableBucketEncryptionHandler
 	// ObjectDownloadObjectHandler sets the operation handler for the download object operation
@@ -571,6 +660,10 @@ type ConsoleAPI struct {
 	BucketEnableBucketEncryptionHandler bucket.EnableBucketEncryptionHandler
 	// ConfigurationExportConfigHandler sets the operation handler for the export config operation
 	ConfigurationExportConfigHandler configuration.ExportConfigHandler
+	// ConfigurationExportIAMHandler sets the operation handler for the export i a m operation
+	ConfigurationExportIAMHandler configuration.ExportIAMHandler
+	// UserExportUserEffectivePermissionsHandler sets the operation handler for the export user effective permissions operation
+	UserExportUserEffectivePermissionsHandler user.ExportUserEffectivePermissionsHandler
 	// BucketGetBucketEncryptionInfoHandler sets the operation handler for the get bucket encryption info operation
 	BucketGetBucketEncryptionInfoHandler bucket.GetBucketEncryptionInfoHandler
 	// BucketGetBucketObjectLockingStatusHandler sets the operation handler for the get bucket object locking status operation
@@ -599,12 +692,20 @@ type ConsoleAPI struct {
 	PolicyGetSAUserPolicyHandler policy.GetSAUserPolicyHandler
 	// ServiceAccountGetServiceAccountHandler sets the operation handler for the get service account operation
 	ServiceAccountGetServiceAccountHandler service_account.GetServiceAccountHandler
+	// ConfigurationGetStaleIdentityReportHandler sets the operation handler for the get stale identity report operation
+	ConfigurationGetStaleIdentityReportHandler configuration.GetStaleIdentityReportHandler
+	// UserGetUserEffectivePermissionsHandler sets the operation handler for the get user effective permissions operation
+	UserGetUserEffectivePermissionsHandler user.GetUserEffectivePermissionsHandler
 	// UserGetUserInfoHandler sets the operation handler for the get user info operation
 	UserGetUserInfoHandler user.GetUserInfoHandler
 	// PolicyGetUserPolicyHandler sets the operation handler for the get user policy operation
 	PolicyGetUserPolicyHandler policy.GetUserPolicyHandler
+	// BucketGrantBucketAccessHandler sets the operation handler for the grant bucket access operation
+	BucketGrantBucketAccessHandler bucket.GrantBucketAccessHandler
 	// GroupGroupInfoHandler sets the operation handler for the group info operation
 	GroupGroupInfoHandler group.GroupInfoHandler
+	// ConfigurationImportIAMHandler sets the operation handler for the import i a m operation
+	ConfigurationImportIAMHandler configuration.ImportIAMHandler
 	// InspectInspectHandler sets the operation handler for the inspect operation
 	InspectInspectHandler inspect.InspectHandler
 	// KmsKMSAPIsHandler sets the operation handler for the k m s a p is operation
@@ -621,10 +722,16 @@ type ConsoleAPI struct {
 	KmsKMSStatusHandler k_m_s.KMSStatusHandler
 	// KmsKMSVersionHandler sets the operation handler for the k m s version operation
 	KmsKMSVersionHandler k_m_s.KMSVersionHandler
+	// PolicyLintPolicyHandler sets the operation handler for the lint policy operation
+	PolicyLintPolicyHandler policy.LintPolicyHandler
 	// UserListAUserServiceAccountsHandler sets the operation handler for the list a user service accounts operation
 	UserListAUserServiceAccountsHandler user.ListAUserServiceAccountsHandler
 	// BucketListAccessRulesWithBucketHandler sets the operation handler for the list access rules with bucket operation
 	BucketListAccessRulesWithBucketHandler bucket.ListAccessRulesWithBucketHandler
+	// SystemListAlertsHandler sets the operation handler for the list alerts operation
+	SystemListAlertsHandler system.ListAlertsHandler
+	// BucketListBucketAccessGrantsHandler sets the operation handler for the list bucket access grants operation
+	BucketListBucketAccessGrantsHandler bucket.ListBucketAccessGrantsHandler
 	// BucketListBucketEventsHandler sets the operation handler for the list bucket events operation
 	BucketListBucketEventsHandler bucket.ListBucketEventsHandler
 	// BucketListBucketsHandler sets the operation handler for the list buckets operation
@@ -633,6 +740,8 @@ type ConsoleAPI struct {
 	ConfigurationListConfigHandler configuration.ListConfigHandler
 	// IdpListConfigurationsHandler sets the operation handler for the list configurations operation
 	IdpListConfigurationsHandler idp.ListConfigurationsHandler
+	// SystemListCustomWidgetsHandler sets the operation handler for the list custom widgets operation
+	SystemListCustomWidgetsHandler system.ListCustomWidgetsHandler
 	// BucketListExternalBucketsHandler sets the operation handler for the list external buckets operation
 	BucketListExternalBucketsHandler bucket.ListExternalBucketsHandler
 	// GroupListGroupsHandler sets the operation handler for the list groups operation
@@ -647,10 +756,14 @@ type ConsoleAPI struct {
 	PolicyListPoliciesHandler policy.ListPoliciesHandler
 	// BucketListPoliciesWithBucketHandler sets the operation handler for the list policies with bucket operation
 	BucketListPoliciesWithBucketHandler bucket.ListPoliciesWithBucketHandler
+	// PolicyListPolicyHistoryHandler sets the operation handler for the list policy history operation
+	PolicyListPolicyHistoryHandler policy.ListPolicyHistoryHandler
 	// ReleaseListReleasesHandler sets the operation handler for the list releases operation
 	ReleaseListReleasesHandler release.ListReleasesHandler
 	// BucketListRemoteBucketsHandler sets the operation handler for the list remote buckets operation
 	BucketListRemoteBucketsHandler bucket.ListRemoteBucketsHandler
+	// ServiceAccountListServiceAccountNotificationsHandler sets the operation handler for the list service account notifications operation
+	ServiceAccountListServiceAccountNotificationsHandler service_account.ListServiceAccountNotificationsHandler
 	// ServiceAccountListUserServiceAccountsHandler sets the operation handler for the list user service accounts operation
 	ServiceAccountListUserServiceAccountsHandler service_account.ListUserServiceAccountsHandler
 	// UserListUsersHandler sets the operation handler for the list users operation
@@ -701,6 +814,12 @@ type ConsoleAPI struct {
 	ConfigurationResetConfigHandler configuration.ResetConfigHandler
 	// ServiceRestartServiceHandler sets the operation handler for the restart service operation
 	ServiceRestartServiceHandler service.RestartServiceHandler
+	// BucketRevokeBucketAccessHandler sets the operation handler for the revoke bucket access operation
+	BucketRevokeBucketAccessHandler bucket.RevokeBucketAccessHandler
+	// PolicyRollbackPolicyHandler sets the operation handler for the rollback policy operation
+	PolicyRollbackPolicyHandler policy.RollbackPolicyHandler
+	// IdpSearchLDAPEntitiesHandler sets the operation handler for the search l d a p entities operation
+	IdpSearchLDAPEntitiesHandler idp.SearchLDAPEntitiesHandler
 	// AuthSessionCheckHandler sets the operation handler for the session check operation
 	AuthSessionCheckHandler auth.SessionCheckHandler
 	// BucketSetAccessRuleWithBucketHandler sets the operation handler for the set access rule with bucket operation
@@ -721,6 +840,10 @@ type ConsoleAPI struct {
 	PolicySetPolicyMultipleHandler policy.SetPolicyMultipleHandler
 	// ObjectShareObjectHandler sets the operation handler for the share object operation
 	ObjectShareObjectHandler object.ShareObjectHandler
+	// PolicySimulatePolicyHandler sets the operation handler for the simulate policy operation
+	PolicySimulatePolicyHandler policy.SimulatePolicyHandler
+	// SystemTestCustomWidgetHandler sets the operation handler for the test custom widget operation
+	SystemTestCustomWidgetHandler system.TestCustomWidgetHandler
 	// IdpUpdateConfigurationHandler sets the operation handler for the update configuration operation
 	IdpUpdateConfigurationHandler idp.UpdateConfigurationHandler
 	// GroupUpdateGroupHandler sets the operation handler for the update group operation
@@ -729,6 +852,8 @@ type ConsoleAPI struct {
 	BucketUpdateMultiBucketReplicationHandler bucket.UpdateMultiBucketReplicationHandler
 	// ServiceAccountUpdateServiceAccountHandler sets the operation handler for the update service account operation
 	ServiceAccountUpdateServiceAccountHandler service_account.UpdateServiceAccountHandler
+	// UserUpdateUserExpiryHandler sets the operation handler for the update user expiry operation
+	UserUpdateUserExpiryHandler user.UpdateUserExpiryHandler
 	// UserUpdateUserGroupsHandler sets the operation handler for the update user groups operation
 	UserUpdateUserGroupsHandler user.UpdateUserGroupsHandler
 	// UserUpdateUserInfoHandler sets the operation handler for the update user info operation
@@ -847,12 +972,18 @@ func (o *ConsoleAPI) Validate() error {
 	if o.SystemArnListHandler == nil {
 		unregistered = append(unregistered, "system.ArnListHandler")
 	}
+	if o.IdpAttachLDAPPoliciesHandler == nil {
+		unregistered = append(unregistered, "idp.AttachLDAPPoliciesHandler")
+	}
 	if o.BucketBucketInfoHandler == nil {
 		unregistered = append(unregistered, "bucket.BucketInfoHandler")
 	}
 	if o.BucketBucketSetPolicyHandler == nil {
 		unregistered = append(unregistered, "bucket.BucketSetPolicyHandler")
 	}
+	if o.UserBulkProvisionUsersHandler == nil {
+		unregistered = append(unregistered, "user.BulkProvisionUsersHandler")
+	}
 	if o.UserBulkUpdateUsersGroupsHandler == nil {
 		unregistered = append(unregistered, "user.BulkUpdateUsersGroupsHandler")
 	}
@@ -862,12 +993,18 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserCheckUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "user.CheckUserServiceAccountsHandler")
 	}
+	if o.ConfigurationCleanupStaleIdentitiesHandler == nil {
+		unregistered = append(unregistered, "configuration.CleanupStaleIdentitiesHandler")
+	}
 	if o.ConfigurationConfigInfoHandler == nil {
 		unregistered = append(unregistered, "configuration.ConfigInfoHandler")
 	}
 	if o.UserCreateAUserServiceAccountHandler == nil {
 		unregistered = append(unregistered, "user.CreateAUserServiceAccountHandler")
 	}
+	if o.SystemCreateAlertSilenceHandler == nil {
+		unregistered = append(unregistered, "system.CreateAlertSilenceHandler")
+	}
 	if o.BucketCreateBucketEventHandler == nil {
 		unregistered = append(unregistered, "bucket.CreateBucketEventHandler")
 	}
@@ -883,12 +1020,18 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountCreateServiceAccountCredsHandler == nil {
 		unregistered = append(unregistered, "service_account.CreateServiceAccountCredsHandler")
 	}
+	if o.SystemCustomWidgetDetailsHandler == nil {
+		unregistered = append(unregistered, "system.CustomWidgetDetailsHandler")
+	}
 	if o.SystemDashboardWidgetDetailsHandler == nil {
 		unregistered = append(unregistered, "system.DashboardWidgetDetailsHandler")
 	}
 	if o.BucketDeleteAccessRuleWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteAccessRuleWithBucketHandler")
 	}
+	if o.SystemDeleteAlertSilenceHandler == nil {
+		unregistered = append(unregistered, "system.DeleteAlertSilenceHandler")
+	}
 	if o.BucketDeleteAllReplicationRulesHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteAllReplicationRulesHandler")
 	}
@@ -925,6 +1068,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountDeleteServiceAccountHandler == nil {
 		unregistered = append(unregistered, "service_account.DeleteServiceAccountHandler")
 	}
+	if o.IdpDetachLDAPPoliciesHandler == nil {
+		unregistered = append(unregistered, "idp.DetachLDAPPoliciesHandler")
+	}
 	if o.BucketDisableBucketEncryptionHandler == nil {
 		unregistered = append(unregistered, "bucket.DisableBucketEncryptionHandler")
 	}
@@ -943,6 +1089,12 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ConfigurationExportConfigHandler == nil {
 		unregistered = append(unregistered, "configuration.ExportConfigHandler")
 	}
+	if o.ConfigurationExportIAMHandler == nil {
+		unregistered = append(unregistered, "configuration.ExportIAMHandler")
+	}
+	if o.UserExportUserEffectivePermissionsHandler == nil {
+		unregistered = append(unregistered, "user.ExportUserEffectivePermissionsHandler")
+	}
 	if o.BucketGetBucketEncryptionInfoHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketEncryptionInfoHandler")
 	}
@@ -985,15 +1137,27 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountGetServiceAccountHandler == nil {
 		unregistered = append(unregistered, "service_account.GetServiceAccountHandler")
 	}
+	if o.ConfigurationGetStaleIdentityReportHandler == nil {
+		unregistered = append(unregistered, "configuration.GetStaleIdentityReportHandler")
+	}
+	if o.UserGetUserEffectivePermissionsHandler == nil {
+		unregistered = append(unregistered, "user.GetUserEffectivePermissionsHandler")
+	}
 	if o.UserGetUserInfoHandler == nil {
 		unregistered = append(unregistered, "user.GetUserInfoHandler")
 	}
 	if o.PolicyGetUserPolicyHandler == nil {
 		unregistered = append(unregistered, "policy.GetUserPolicyHandler")
 	}
+	if o.BucketGrantBucketAccessHandler == nil {
+		unregistered = append(unregistered, "bucket.GrantBucketAccessHandler")
+	}
 	if o.GroupGroupInfoHandler == nil {
 		unregistered = append(unregistered, "group.GroupInfoHandler")
 	}
+	if o.ConfigurationImportIAMHandler == nil {
+		unregistered = append(unregistered, "configuration.ImportIAMHandler")
+	}
 	if o.InspectInspectHandler == nil {
 		unregistered = append(unregistered, "inspect.InspectHandler")
 	}
@@ -1018,12 +1182,21 @@ func (o *ConsoleAPI) Validate() error {
 	if o.KmsKMSVersionHandler == nil {
 		unregistered = append(unregistered, "k_m_s.KMSVersionHandler")
 	}
+	if o.PolicyLintPolicyHandler == nil {
+		unregistered = append(unregistered, "policy.LintPolicyHandler")
+	}
 	if o.UserListAUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "user.ListAUserServiceAccountsHandler")
 	}
 	if o.BucketListAccessRulesWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.ListAccessRulesWithBucketHandler")
 	}
+	if o.SystemListAlertsHandler == nil {
+		unregistered = append(unregistered, "system.ListAlertsHandler")
+	}
+	if o.BucketListBucketAccessGrantsHandler == nil {
+		unregistered = append(unregistered, "bucket.ListBucketAccessGrantsHandler")
+	}
 	if o.BucketListBucketEventsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListBucketEventsHandler")
 	}
@@ -1036,6 +1209,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.IdpListConfigurationsHandler == nil {
 		unregistered = append(unregistered, "idp.ListConfigurationsHandler")
 	}
+	if o.SystemListCustomWidgetsHandler == nil {
+		unregistered = append(unregistered, "system.ListCustomWidgetsHandler")
+	}
 	if o.BucketListExternalBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListExternalBucketsHandler")
 	}
@@ -1057,12 +1233,18 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketListPoliciesWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.ListPoliciesWithBucketHandler")
 	}
+	if o.PolicyListPolicyHistoryHandler == nil {
+		unregistered = append(unregistered, "policy.ListPolicyHistoryHandler")
+	}
 	if o.ReleaseListReleasesHandler == nil {
 		unregistered = append(unregistered, "release.ListReleasesHandler")
 	}
 	if o.BucketListRemoteBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListRemoteBucketsHandler")
 	}
+	if o.ServiceAccountListServiceAccountNotificationsHandler == nil {
+		unregistered = append(unregistered, "service_account.ListServiceAccountNotificationsHandler")
+	}
 	if o.ServiceAccountListUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "service_account.ListUserServiceAccountsHandler")
 	}
@@ -1138,6 +1320,15 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceRestartServiceHandler == nil {
 		unregistered = append(unregistered, "service.RestartServiceHandler")
 	}
+	if o.BucketRevokeBucketAccessHandler == nil {
+		unregistered = append(unregistered, "bucket.RevokeBucketAccessHandler")
+	}
+	if o.PolicyRollbackPolicyHandler == nil {
+		unregistered = append(unregistered, "policy.RollbackPolicyHandler")
+	}
+	if o.IdpSearchLDAPEntitiesHandler == nil {
+		unregistered = append(unregistered, "idp.SearchLDAPEntitiesHandler")
+	}
 	if o.AuthSessionCheckHandler == nil {
 		unregistered = append(unregistered, "auth.SessionCheckHandler")
 	}
@@ -1168,6 +1359,12 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ObjectShareObjectHandler == nil {
 		unregistered = append(unregistered, "object.ShareObjectHandler")
 	}
+	if o.PolicySimulatePolicyHandler == nil {
+		unregistered = append(unregistered, "policy.SimulatePolicyHandler")
+	}
+	if o.SystemTestCustomWidgetHandler == nil {
+		unregistered = append(unregistered, "system.TestCustomWidgetHandler")
+	}
 	if o.IdpUpdateConfigurationHandler == nil {
 		unregistered = append(unregistered, "idp.UpdateConfigurationHandler")
 	}
@@ -1180,6 +1377,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountUpdateServiceAccountHandler == nil {
 		unregistered = append(unregistered, "service_account.UpdateServiceAccountHandler")
 	}
+	if o.UserUpdateUserExpiryHandler == nil {
+		unregistered = append(unregistered, "user.UpdateUserExpiryHandler")
+	}
 	if o.UserUpdateUserGroupsHandler == nil {
 		unregistered = append(unregistered, "user.UpdateUserGroupsHandler")
 	}
@@ -1326,6 +1526,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/admin/arns"] = system.NewArnList(o.context, o.SystemArnListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/ldap-entities/policies/attach"] = idp.NewAttachLDAPPolicies(o.context, o.IdpAttachLDAPPoliciesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1334,6 +1538,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/buckets/{name}/set-policy"] = bucket.NewBucketSetPolicy(o.context, o.BucketBucketSetPolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/provision"] = user.NewBulkProvisionUsers(o.context, o.UserBulkProvisionUsersHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1346,6 +1554,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/users/service-accounts"] = user.NewCheckUserServiceAccounts(o.context, o.UserCheckUserServiceAccountsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/iam/stale-report/cleanup"] = configuration.NewCleanupStaleIdentities(o.context, o.ConfigurationCleanupStaleIdentitiesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1357,6 +1569,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/admin/alerts/silences"] = system.NewCreateAlertSilence(o.context, o.SystemCreateAlertSilenceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/buckets/{bucket_name}/events"] = bucket.NewCreateBucketEvent(o.context, o.BucketCreateBucketEventHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1377,6 +1593,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/info/custom-widgets/{namespace}/{widgetId}"] = system.NewCustomWidgetDetails(o.context, o.SystemCustomWidgetDetailsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/info/widgets/{widgetId}"] = system.NewDashboardWidgetDetails(o.context, o.SystemDashboardWidgetDetailsHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1385,6 +1605,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/admin/alerts/silences/{id}"] = system.NewDeleteAlertSilence(o.context, o.SystemDeleteAlertSilenceHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/buckets/{bucket_name}/delete-all-replication-rules"] = bucket.NewDeleteAllReplicationRules(o.context, o.BucketDeleteAllReplicationRulesHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1433,6 +1657,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/ldap-entities/policies/detach"] = idp.NewDetachLDAPPolicies(o.context, o.IdpDetachLDAPPoliciesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/buckets/{bucket_name}/encryption/disable"] = bucket.NewDisableBucketEncryption(o.context, o.BucketDisableBucketEncryptionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1457,6 +1685,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/iam/export"] = configuration.NewExportIAM(o.context, o.ConfigurationExportIAMHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/{name}/effective-permissions/csv"] = user.NewExportUserEffectivePermissions(o.context, o.UserExportUserEffectivePermissionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/encryption/info"] = bucket.NewGetBucketEncryptionInfo(o.context, o.BucketGetBucketEncryptionInfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1513,15 +1749,31 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/iam/stale-report"] = configuration.NewGetStaleIdentityReport(o.context, o.ConfigurationGetStaleIdentityReportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/{name}/effective-permissions"] = user.NewGetUserEffectivePermissions(o.context, o.UserGetUserEffectivePermissionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/user/{name}"] = user.NewGetUserInfo(o.context, o.UserGetUserInfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/user/policy"] = policy.NewGetUserPolicy(o.context, o.PolicyGetUserPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/bucket/{bucket}/access-grants"] = bucket.NewGrantBucketAccess(o.context, o.BucketGrantBucketAccessHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/group/{name}"] = group.NewGroupInfo(o.context, o.GroupGroupInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/iam/import"] = configuration.NewImportIAM(o.context, o.ConfigurationImportIAMHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1554,6 +1806,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/kms/version"] = k_m_s.NewKMSVersion(o.context, o.KmsKMSVersionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policy/lint"] = policy.NewLintPolicy(o.context, o.PolicyLintPolicyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1565,6 +1821,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/alerts"] = system.NewListAlerts(o.context, o.SystemListAlertsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/bucket/{bucket}/access-grants"] = bucket.NewListBucketAccessGrants(o.context, o.BucketListBucketAccessGrantsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/events"] = bucket.NewListBucketEvents(o.context, o.BucketListBucketEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1578,6 +1842,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/idp/{type}"] = idp.NewListConfigurations(o.context, o.IdpListConfigurationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/info/custom-widgets"] = system.NewListCustomWidgets(o.context, o.SystemListCustomWidgetsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1609,6 +1877,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/policy/{name}/history"] = policy.NewListPolicyHistory(o.context, o.PolicyListPolicyHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/releases"] = release.NewListReleases(o.context, o.ReleaseListReleasesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1617,6 +1889,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/service-account-notifications"] = service_account.NewListServiceAccountNotifications(o.context, o.ServiceAccountListServiceAccountNotificationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/service-accounts"] = service_account.NewListUserServiceAccounts(o.context, o.ServiceAccountListUserServiceAccountsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1714,6 +1990,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/service/restart"] = service.NewRestartService(o.context, o.ServiceRestartServiceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/bucket/{bucket}/access-grants/revoke"] = bucket.NewRevokeBucketAccess(o.context, o.BucketRevokeBucketAccessHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policy/{name}/history/{version}/rollback"] = policy.NewRollbackPolicy(o.context, o.PolicyRollbackPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/ldap-entities/search"] = idp.NewSearchLDAPEntities(o.context, o.IdpSearchLDAPEntitiesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1754,6 +2042,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects/share"] = object.NewShareObject(o.context, o.ObjectShareObjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policy/simulate"] = policy.NewSimulatePolicy(o.context, o.PolicySimulatePolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/info/custom-widgets/test"] = system.NewTestCustomWidget(o.context, o.SystemTestCustomWidgetHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1773,6 +2069,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/user/{name}/expiry"] = user.NewUpdateUserExpiry(o.context, o.UserUpdateUserExpiryHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/user/{name}/groups"] = user.NewUpdateUserGroups(o.context, o.UserUpdateUserGroupsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
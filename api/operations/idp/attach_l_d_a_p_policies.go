@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package idp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// AttachLDAPPoliciesHandlerFunc turns a function with the right signature into a attach l d a p policies handler
+type AttachLDAPPoliciesHandlerFunc func(AttachLDAPPoliciesParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AttachLDAPPoliciesHandlerFunc) Handle(params AttachLDAPPoliciesParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AttachLDAPPoliciesHandler interface for that can handle valid attach l d a p policies params
+type AttachLDAPPoliciesHandler interface {
+	Handle(AttachLDAPPoliciesParams, *models.Principal) middleware.Responder
+}
+
+// NewAttachLDAPPolicies creates a new http.Handler for the attach l d a p policies operation
+func NewAttachLDAPPolicies(ctx *middleware.Context, handler AttachLDAPPoliciesHandler) *AttachLDAPPolicies {
+	return &AttachLDAPPolicies{Context: ctx, Handler: handler}
+}
+
+/*
+	AttachLDAPPolicies swagger:route POST /ldap-entities/policies/attach idp attachLDAPPolicies
+
+Attach policies to LDAP user and group DNs
+*/
+type AttachLDAPPolicies struct {
+	Context *middleware.Context
+	Handler AttachLDAPPoliciesHandler
+}
+
+func (o *AttachLDAPPolicies) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAttachLDAPPoliciesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
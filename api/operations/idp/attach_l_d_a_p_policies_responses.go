@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package idp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// AttachLDAPPoliciesOKCode is the HTTP code returned for type AttachLDAPPoliciesOK
+const AttachLDAPPoliciesOKCode int = 200
+
+/*
+AttachLDAPPoliciesOK A successful response.
+
+swagger:response attachLDAPPoliciesOK
+*/
+type AttachLDAPPoliciesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.LdapPolicyAssociationResponse `json:"body,omitempty"`
+}
+
+// NewAttachLDAPPoliciesOK creates AttachLDAPPoliciesOK with default headers values
+func NewAttachLDAPPoliciesOK() *AttachLDAPPoliciesOK {
+
+	return &AttachLDAPPoliciesOK{}
+}
+
+// WithPayload adds the payload to the attach l d a p policies o k response
+func (o *AttachLDAPPoliciesOK) WithPayload(payload *models.LdapPolicyAssociationResponse) *AttachLDAPPoliciesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the attach l d a p policies o k response
+func (o *AttachLDAPPoliciesOK) SetPayload(payload *models.LdapPolicyAssociationResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AttachLDAPPoliciesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+AttachLDAPPoliciesDefault Generic error response.
+
+swagger:response attachLDAPPoliciesDefault
+*/
+type AttachLDAPPoliciesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewAttachLDAPPoliciesDefault creates AttachLDAPPoliciesDefault with default headers values
+func NewAttachLDAPPoliciesDefault(code int) *AttachLDAPPoliciesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AttachLDAPPoliciesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the attach l d a p policies default response
+func (o *AttachLDAPPoliciesDefault) WithStatusCode(code int) *AttachLDAPPoliciesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the attach l d a p policies default response
+func (o *AttachLDAPPoliciesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the attach l d a p policies default response
+func (o *AttachLDAPPoliciesDefault) WithPayload(payload *models.APIError) *AttachLDAPPoliciesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the attach l d a p policies default response
+func (o *AttachLDAPPoliciesDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AttachLDAPPoliciesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
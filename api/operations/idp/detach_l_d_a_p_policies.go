@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package idp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// DetachLDAPPoliciesHandlerFunc turns a function with the right signature into a detach l d a p policies handler
+type DetachLDAPPoliciesHandlerFunc func(DetachLDAPPoliciesParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DetachLDAPPoliciesHandlerFunc) Handle(params DetachLDAPPoliciesParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DetachLDAPPoliciesHandler interface for that can handle valid detach l d a p policies params
+type DetachLDAPPoliciesHandler interface {
+	Handle(DetachLDAPPoliciesParams, *models.Principal) middleware.Responder
+}
+
+// NewDetachLDAPPolicies creates a new http.Handler for the detach l d a p policies operation
+func NewDetachLDAPPolicies(ctx *middleware.Context, handler DetachLDAPPoliciesHandler) *DetachLDAPPolicies {
+	return &DetachLDAPPolicies{Context: ctx, Handler: handler}
+}
+
+/*
+	DetachLDAPPolicies swagger:route POST /ldap-entities/policies/detach idp detachLDAPPolicies
+
+Detach policies from LDAP user and group DNs
+*/
+type DetachLDAPPolicies struct {
+	Context *middleware.Context
+	Handler DetachLDAPPoliciesHandler
+}
+
+func (o *DetachLDAPPolicies) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDetachLDAPPoliciesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
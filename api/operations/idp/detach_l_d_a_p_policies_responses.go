@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package idp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// DetachLDAPPoliciesOKCode is the HTTP code returned for type DetachLDAPPoliciesOK
+const DetachLDAPPoliciesOKCode int = 200
+
+/*
+DetachLDAPPoliciesOK A successful response.
+
+swagger:response detachLDAPPoliciesOK
+*/
+type DetachLDAPPoliciesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.LdapPolicyAssociationResponse `json:"body,omitempty"`
+}
+
+// NewDetachLDAPPoliciesOK creates DetachLDAPPoliciesOK with default headers values
+func NewDetachLDAPPoliciesOK() *DetachLDAPPoliciesOK {
+
+	return &DetachLDAPPoliciesOK{}
+}
+
+// WithPayload adds the payload to the detach l d a p policies o k response
+func (o *DetachLDAPPoliciesOK) WithPayload(payload *models.LdapPolicyAssociationResponse) *DetachLDAPPoliciesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the detach l d a p policies o k response
+func (o *DetachLDAPPoliciesOK) SetPayload(payload *models.LdapPolicyAssociationResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DetachLDAPPoliciesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+DetachLDAPPoliciesDefault Generic error response.
+
+swagger:response detachLDAPPoliciesDefault
+*/
+type DetachLDAPPoliciesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewDetachLDAPPoliciesDefault creates DetachLDAPPoliciesDefault with default headers values
+func NewDetachLDAPPoliciesDefault(code int) *DetachLDAPPoliciesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DetachLDAPPoliciesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the detach l d a p policies default response
+func (o *DetachLDAPPoliciesDefault) WithStatusCode(code int) *DetachLDAPPoliciesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the detach l d a p policies default response
+func (o *DetachLDAPPoliciesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the detach l d a p policies default response
+func (o *DetachLDAPPoliciesDefault) WithPayload(payload *models.APIError) *DetachLDAPPoliciesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the detach l d a p policies default response
+func (o *DetachLDAPPoliciesDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DetachLDAPPoliciesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
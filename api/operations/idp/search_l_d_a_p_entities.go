@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package idp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// SearchLDAPEntitiesHandlerFunc turns a function with the right signature into a search l d a p entities handler
+type SearchLDAPEntitiesHandlerFunc func(SearchLDAPEntitiesParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SearchLDAPEntitiesHandlerFunc) Handle(params SearchLDAPEntitiesParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SearchLDAPEntitiesHandler interface for that can handle valid search l d a p entities params
+type SearchLDAPEntitiesHandler interface {
+	Handle(SearchLDAPEntitiesParams, *models.Principal) middleware.Responder
+}
+
+// NewSearchLDAPEntities creates a new http.Handler for the search l d a p entities operation
+func NewSearchLDAPEntities(ctx *middleware.Context, handler SearchLDAPEntitiesHandler) *SearchLDAPEntities {
+	return &SearchLDAPEntities{Context: ctx, Handler: handler}
+}
+
+/*
+	SearchLDAPEntities swagger:route GET /ldap-entities/search idp searchLDAPEntities
+
+Search the LDAP directory for user and group DNs with the lookup bind
+*/
+type SearchLDAPEntities struct {
+	Context *middleware.Context
+	Handler SearchLDAPEntitiesHandler
+}
+
+func (o *SearchLDAPEntities) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSearchLDAPEntitiesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package idp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// SearchLDAPEntitiesOKCode is the HTTP code returned for type SearchLDAPEntitiesOK
+const SearchLDAPEntitiesOKCode int = 200
+
+/*
+SearchLDAPEntitiesOK A successful response.
+
+swagger:response searchLDAPEntitiesOK
+*/
+type SearchLDAPEntitiesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.LdapEntitiesSearchResponse `json:"body,omitempty"`
+}
+
+// NewSearchLDAPEntitiesOK creates SearchLDAPEntitiesOK with default headers values
+func NewSearchLDAPEntitiesOK() *SearchLDAPEntitiesOK {
+
+	return &SearchLDAPEntitiesOK{}
+}
+
+// WithPayload adds the payload to the search l d a p entities o k response
+func (o *SearchLDAPEntitiesOK) WithPayload(payload *models.LdapEntitiesSearchResponse) *SearchLDAPEntitiesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search l d a p entities o k response
+func (o *SearchLDAPEntitiesOK) SetPayload(payload *models.LdapEntitiesSearchResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchLDAPEntitiesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+SearchLDAPEntitiesDefault Generic error response.
+
+swagger:response searchLDAPEntitiesDefault
+*/
+type SearchLDAPEntitiesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewSearchLDAPEntitiesDefault creates SearchLDAPEntitiesDefault with default headers values
+func NewSearchLDAPEntitiesDefault(code int) *SearchLDAPEntitiesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SearchLDAPEntitiesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the search l d a p entities default response
+func (o *SearchLDAPEntitiesDefault) WithStatusCode(code int) *SearchLDAPEntitiesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the search l d a p entities default response
+func (o *SearchLDAPEntitiesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the search l d a p entities default response
+func (o *SearchLDAPEntitiesDefault) WithPayload(payload *models.APIError) *SearchLDAPEntitiesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search l d a p entities default response
+func (o *SearchLDAPEntitiesDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchLDAPEntitiesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
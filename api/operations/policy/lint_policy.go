@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// LintPolicyHandlerFunc turns a function with the right signature into a lint policy handler
+type LintPolicyHandlerFunc func(LintPolicyParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn LintPolicyHandlerFunc) Handle(params LintPolicyParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// LintPolicyHandler interface for that can handle valid lint policy params
+type LintPolicyHandler interface {
+	Handle(LintPolicyParams, *models.Principal) middleware.Responder
+}
+
+// NewLintPolicy creates a new http.Handler for the lint policy operation
+func NewLintPolicy(ctx *middleware.Context, handler LintPolicyHandler) *LintPolicy {
+	return &LintPolicy{Context: ctx, Handler: handler}
+}
+
+/*
+	LintPolicy swagger:route POST /policy/lint Policy lintPolicy
+
+Lint a policy document for risky or broken constructs
+*/
+type LintPolicy struct {
+	Context *middleware.Context
+	Handler LintPolicyHandler
+}
+
+func (o *LintPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewLintPolicyParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// LintPolicyOKCode is the HTTP code returned for type LintPolicyOK
+const LintPolicyOKCode int = 200
+
+/*
+LintPolicyOK A successful response.
+
+swagger:response lintPolicyOK
+*/
+type LintPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.LintPolicyResponse `json:"body,omitempty"`
+}
+
+// NewLintPolicyOK creates LintPolicyOK with default headers values
+func NewLintPolicyOK() *LintPolicyOK {
+
+	return &LintPolicyOK{}
+}
+
+// WithPayload adds the payload to the lint policy o k response
+func (o *LintPolicyOK) WithPayload(payload *models.LintPolicyResponse) *LintPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the lint policy o k response
+func (o *LintPolicyOK) SetPayload(payload *models.LintPolicyResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LintPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+LintPolicyDefault Generic error response.
+
+swagger:response lintPolicyDefault
+*/
+type LintPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewLintPolicyDefault creates LintPolicyDefault with default headers values
+func NewLintPolicyDefault(code int) *LintPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &LintPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the lint policy default response
+func (o *LintPolicyDefault) WithStatusCode(code int) *LintPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the lint policy default response
+func (o *LintPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the lint policy default response
+func (o *LintPolicyDefault) WithPayload(payload *models.APIError) *LintPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the lint policy default response
+func (o *LintPolicyDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LintPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
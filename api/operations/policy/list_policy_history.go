@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// ListPolicyHistoryHandlerFunc turns a function with the right signature into a list policy history handler
+type ListPolicyHistoryHandlerFunc func(ListPolicyHistoryParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListPolicyHistoryHandlerFunc) Handle(params ListPolicyHistoryParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListPolicyHistoryHandler interface for that can handle valid list policy history params
+type ListPolicyHistoryHandler interface {
+	Handle(ListPolicyHistoryParams, *models.Principal) middleware.Responder
+}
+
+// NewListPolicyHistory creates a new http.Handler for the list policy history operation
+func NewListPolicyHistory(ctx *middleware.Context, handler ListPolicyHistoryHandler) *ListPolicyHistory {
+	return &ListPolicyHistory{Context: ctx, Handler: handler}
+}
+
+/*
+	ListPolicyHistory swagger:route GET /policy/{name}/history Policy listPolicyHistory
+
+Lists the changes of a policy along with the differences between versions
+*/
+type ListPolicyHistory struct {
+	Context *middleware.Context
+	Handler ListPolicyHistoryHandler
+}
+
+func (o *ListPolicyHistory) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListPolicyHistoryParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// ListPolicyHistoryOKCode is the HTTP code returned for type ListPolicyHistoryOK
+const ListPolicyHistoryOKCode int = 200
+
+/*
+ListPolicyHistoryOK A successful response.
+
+swagger:response listPolicyHistoryOK
+*/
+type ListPolicyHistoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PolicyHistoryResponse `json:"body,omitempty"`
+}
+
+// NewListPolicyHistoryOK creates ListPolicyHistoryOK with default headers values
+func NewListPolicyHistoryOK() *ListPolicyHistoryOK {
+
+	return &ListPolicyHistoryOK{}
+}
+
+// WithPayload adds the payload to the list policy history o k response
+func (o *ListPolicyHistoryOK) WithPayload(payload *models.PolicyHistoryResponse) *ListPolicyHistoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list policy history o k response
+func (o *ListPolicyHistoryOK) SetPayload(payload *models.PolicyHistoryResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPolicyHistoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ListPolicyHistoryDefault Generic error response.
+
+swagger:response listPolicyHistoryDefault
+*/
+type ListPolicyHistoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewListPolicyHistoryDefault creates ListPolicyHistoryDefault with default headers values
+func NewListPolicyHistoryDefault(code int) *ListPolicyHistoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListPolicyHistoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list policy history default response
+func (o *ListPolicyHistoryDefault) WithStatusCode(code int) *ListPolicyHistoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list policy history default response
+func (o *ListPolicyHistoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list policy history default response
+func (o *ListPolicyHistoryDefault) WithPayload(payload *models.APIError) *ListPolicyHistoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list policy history default response
+func (o *ListPolicyHistoryDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPolicyHistoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
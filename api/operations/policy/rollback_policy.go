@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// RollbackPolicyHandlerFunc turns a function with the right signature into a rollback policy handler
+type RollbackPolicyHandlerFunc func(RollbackPolicyParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RollbackPolicyHandlerFunc) Handle(params RollbackPolicyParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RollbackPolicyHandler interface for that can handle valid rollback policy params
+type RollbackPolicyHandler interface {
+	Handle(RollbackPolicyParams, *models.Principal) middleware.Responder
+}
+
+// NewRollbackPolicy creates a new http.Handler for the rollback policy operation
+func NewRollbackPolicy(ctx *middleware.Context, handler RollbackPolicyHandler) *RollbackPolicy {
+	return &RollbackPolicy{Context: ctx, Handler: handler}
+}
+
+/*
+	RollbackPolicy swagger:route POST /policy/{name}/history/{version}/rollback Policy rollbackPolicy
+
+Reverts a change of a policy restoring the document it replaced
+*/
+type RollbackPolicy struct {
+	Context *middleware.Context
+	Handler RollbackPolicyHandler
+}
+
+func (o *RollbackPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRollbackPolicyParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
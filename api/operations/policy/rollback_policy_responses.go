@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// RollbackPolicyOKCode is the HTTP code returned for type RollbackPolicyOK
+const RollbackPolicyOKCode int = 200
+
+/*
+RollbackPolicyOK A successful response.
+
+swagger:response rollbackPolicyOK
+*/
+type RollbackPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Policy `json:"body,omitempty"`
+}
+
+// NewRollbackPolicyOK creates RollbackPolicyOK with default headers values
+func NewRollbackPolicyOK() *RollbackPolicyOK {
+
+	return &RollbackPolicyOK{}
+}
+
+// WithPayload adds the payload to the rollback policy o k response
+func (o *RollbackPolicyOK) WithPayload(payload *models.Policy) *RollbackPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rollback policy o k response
+func (o *RollbackPolicyOK) SetPayload(payload *models.Policy) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RollbackPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+RollbackPolicyDefault Generic error response.
+
+swagger:response rollbackPolicyDefault
+*/
+type RollbackPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewRollbackPolicyDefault creates RollbackPolicyDefault with default headers values
+func NewRollbackPolicyDefault(code int) *RollbackPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RollbackPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the rollback policy default response
+func (o *RollbackPolicyDefault) WithStatusCode(code int) *RollbackPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the rollback policy default response
+func (o *RollbackPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the rollback policy default response
+func (o *RollbackPolicyDefault) WithPayload(payload *models.APIError) *RollbackPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rollback policy default response
+func (o *RollbackPolicyDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RollbackPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
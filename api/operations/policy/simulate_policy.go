@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// SimulatePolicyHandlerFunc turns a function with the right signature into a simulate policy handler
+type SimulatePolicyHandlerFunc func(SimulatePolicyParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SimulatePolicyHandlerFunc) Handle(params SimulatePolicyParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SimulatePolicyHandler interface for that can handle valid simulate policy params
+type SimulatePolicyHandler interface {
+	Handle(SimulatePolicyParams, *models.Principal) middleware.Responder
+}
+
+// NewSimulatePolicy creates a new http.Handler for the simulate policy operation
+func NewSimulatePolicy(ctx *middleware.Context, handler SimulatePolicyHandler) *SimulatePolicy {
+	return &SimulatePolicy{Context: ctx, Handler: handler}
+}
+
+/*
+	SimulatePolicy swagger:route POST /policy/simulate Policy simulatePolicy
+
+Simulate the policy evaluation of a user, group or service account
+*/
+type SimulatePolicy struct {
+	Context *middleware.Context
+	Handler SimulatePolicyHandler
+}
+
+func (o *SimulatePolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSimulatePolicyParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// SimulatePolicyOKCode is the HTTP code returned for type SimulatePolicyOK
+const SimulatePolicyOKCode int = 200
+
+/*
+SimulatePolicyOK A successful response.
+
+swagger:response simulatePolicyOK
+*/
+type SimulatePolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.SimulatePolicyResponse `json:"body,omitempty"`
+}
+
+// NewSimulatePolicyOK creates SimulatePolicyOK with default headers values
+func NewSimulatePolicyOK() *SimulatePolicyOK {
+
+	return &SimulatePolicyOK{}
+}
+
+// WithPayload adds the payload to the simulate policy o k response
+func (o *SimulatePolicyOK) WithPayload(payload *models.SimulatePolicyResponse) *SimulatePolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the simulate policy o k response
+func (o *SimulatePolicyOK) SetPayload(payload *models.SimulatePolicyResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SimulatePolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+SimulatePolicyDefault Generic error response.
+
+swagger:response simulatePolicyDefault
+*/
+type SimulatePolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewSimulatePolicyDefault creates SimulatePolicyDefault with default headers values
+func NewSimulatePolicyDefault(code int) *SimulatePolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SimulatePolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the simulate policy default response
+func (o *SimulatePolicyDefault) WithStatusCode(code int) *SimulatePolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the simulate policy default response
+func (o *SimulatePolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the simulate policy default response
+func (o *SimulatePolicyDefault) WithPayload(payload *models.APIError) *SimulatePolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the simulate policy default response
+func (o *SimulatePolicyDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SimulatePolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package service_account
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// ListServiceAccountNotificationsHandlerFunc turns a function with the right signature into a list service account notifications handler
+type ListServiceAccountNotificationsHandlerFunc func(ListServiceAccountNotificationsParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListServiceAccountNotificationsHandlerFunc) Handle(params ListServiceAccountNotificationsParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListServiceAccountNotificationsHandler interface for that can handle valid list service account notifications params
+type ListServiceAccountNotificationsHandler interface {
+	Handle(ListServiceAccountNotificationsParams, *models.Principal) middleware.Responder
+}
+
+// NewListServiceAccountNotifications creates a new http.Handler for the list service account notifications operation
+func NewListServiceAccountNotifications(ctx *middleware.Context, handler ListServiceAccountNotificationsHandler) *ListServiceAccountNotifications {
+	return &ListServiceAccountNotifications{Context: ctx, Handler: handler}
+}
+
+/*
+	ListServiceAccountNotifications swagger:route GET /service-account-notifications ServiceAccount listServiceAccountNotifications
+
+List the expiry and rotation notifications of the service accounts
+*/
+type ListServiceAccountNotifications struct {
+	Context *middleware.Context
+	Handler ListServiceAccountNotificationsHandler
+}
+
+func (o *ListServiceAccountNotifications) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListServiceAccountNotificationsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
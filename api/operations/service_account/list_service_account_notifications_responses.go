@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package service_account
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// ListServiceAccountNotificationsOKCode is the HTTP code returned for type ListServiceAccountNotificationsOK
+const ListServiceAccountNotificationsOKCode int = 200
+
+/*
+ListServiceAccountNotificationsOK A successful response.
+
+swagger:response listServiceAccountNotificationsOK
+*/
+type ListServiceAccountNotificationsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ServiceAccountNotifications `json:"body,omitempty"`
+}
+
+// NewListServiceAccountNotificationsOK creates ListServiceAccountNotificationsOK with default headers values
+func NewListServiceAccountNotificationsOK() *ListServiceAccountNotificationsOK {
+
+	return &ListServiceAccountNotificationsOK{}
+}
+
+// WithPayload adds the payload to the list service account notifications o k response
+func (o *ListServiceAccountNotificationsOK) WithPayload(payload *models.ServiceAccountNotifications) *ListServiceAccountNotificationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list service account notifications o k response
+func (o *ListServiceAccountNotificationsOK) SetPayload(payload *models.ServiceAccountNotifications) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListServiceAccountNotificationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ListServiceAccountNotificationsDefault Generic error response.
+
+swagger:response listServiceAccountNotificationsDefault
+*/
+type ListServiceAccountNotificationsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewListServiceAccountNotificationsDefault creates ListServiceAccountNotificationsDefault with default headers values
+func NewListServiceAccountNotificationsDefault(code int) *ListServiceAccountNotificationsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListServiceAccountNotificationsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list service account notifications default response
+func (o *ListServiceAccountNotificationsDefault) WithStatusCode(code int) *ListServiceAccountNotificationsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list service account notifications default response
+func (o *ListServiceAccountNotificationsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list service account notifications default response
+func (o *ListServiceAccountNotificationsDefault) WithPayload(payload *models.APIError) *ListServiceAccountNotificationsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list service account notifications default response
+func (o *ListServiceAccountNotificationsDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListServiceAccountNotificationsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
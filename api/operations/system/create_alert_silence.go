@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// CreateAlertSilenceHandlerFunc turns a function with the right signature into a create alert silence handler
+type CreateAlertSilenceHandlerFunc func(CreateAlertSilenceParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateAlertSilenceHandlerFunc) Handle(params CreateAlertSilenceParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateAlertSilenceHandler interface for that can handle valid create alert silence params
+type CreateAlertSilenceHandler interface {
+	Handle(CreateAlertSilenceParams, *models.Principal) middleware.Responder
+}
+
+// NewCreateAlertSilence creates a new http.Handler for the create alert silence operation
+func NewCreateAlertSilence(ctx *middleware.Context, handler CreateAlertSilenceHandler) *CreateAlertSilence {
+	return &CreateAlertSilence{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateAlertSilence swagger:route POST /admin/alerts/silences System createAlertSilence
+
+Silences the alerts matching a rule and a subject
+*/
+type CreateAlertSilence struct {
+	Context *middleware.Context
+	Handler CreateAlertSilenceHandler
+}
+
+func (o *CreateAlertSilence) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateAlertSilenceParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
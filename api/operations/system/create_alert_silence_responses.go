@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// CreateAlertSilenceCreatedCode is the HTTP code returned for type CreateAlertSilenceCreated
+const CreateAlertSilenceCreatedCode int = 201
+
+/*
+CreateAlertSilenceCreated A successful response.
+
+swagger:response createAlertSilenceCreated
+*/
+type CreateAlertSilenceCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AlertSilence `json:"body,omitempty"`
+}
+
+// NewCreateAlertSilenceCreated creates CreateAlertSilenceCreated with default headers values
+func NewCreateAlertSilenceCreated() *CreateAlertSilenceCreated {
+
+	return &CreateAlertSilenceCreated{}
+}
+
+// WithPayload adds the payload to the create alert silence created response
+func (o *CreateAlertSilenceCreated) WithPayload(payload *models.AlertSilence) *CreateAlertSilenceCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create alert silence created response
+func (o *CreateAlertSilenceCreated) SetPayload(payload *models.AlertSilence) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateAlertSilenceCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CreateAlertSilenceDefault Generic error response.
+
+swagger:response createAlertSilenceDefault
+*/
+type CreateAlertSilenceDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewCreateAlertSilenceDefault creates CreateAlertSilenceDefault with default headers values
+func NewCreateAlertSilenceDefault(code int) *CreateAlertSilenceDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateAlertSilenceDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create alert silence default response
+func (o *CreateAlertSilenceDefault) WithStatusCode(code int) *CreateAlertSilenceDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create alert silence default response
+func (o *CreateAlertSilenceDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create alert silence default response
+func (o *CreateAlertSilenceDefault) WithPayload(payload *models.APIError) *CreateAlertSilenceDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create alert silence default response
+func (o *CreateAlertSilenceDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateAlertSilenceDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
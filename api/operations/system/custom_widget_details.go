@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// CustomWidgetDetailsHandlerFunc turns a function with the right signature into a custom widget details handler
+type CustomWidgetDetailsHandlerFunc func(CustomWidgetDetailsParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CustomWidgetDetailsHandlerFunc) Handle(params CustomWidgetDetailsParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CustomWidgetDetailsHandler interface for that can handle valid custom widget details params
+type CustomWidgetDetailsHandler interface {
+	Handle(CustomWidgetDetailsParams, *models.Principal) middleware.Responder
+}
+
+// NewCustomWidgetDetails creates a new http.Handler for the custom widget details operation
+func NewCustomWidgetDetails(ctx *middleware.Context, handler CustomWidgetDetailsHandler) *CustomWidgetDetails {
+	return &CustomWidgetDetails{Context: ctx, Handler: handler}
+}
+
+/*
+	CustomWidgetDetails swagger:route GET /admin/info/custom-widgets/{namespace}/{widgetId} System customWidgetDetails
+
+Returns the results of a user-defined dashboard widget
+*/
+type CustomWidgetDetails struct {
+	Context *middleware.Context
+	Handler CustomWidgetDetailsHandler
+}
+
+func (o *CustomWidgetDetails) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCustomWidgetDetailsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// CustomWidgetDetailsOKCode is the HTTP code returned for type CustomWidgetDetailsOK
+const CustomWidgetDetailsOKCode int = 200
+
+/*
+CustomWidgetDetailsOK A successful response.
+
+swagger:response customWidgetDetailsOK
+*/
+type CustomWidgetDetailsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.WidgetDetails `json:"body,omitempty"`
+}
+
+// NewCustomWidgetDetailsOK creates CustomWidgetDetailsOK with default headers values
+func NewCustomWidgetDetailsOK() *CustomWidgetDetailsOK {
+
+	return &CustomWidgetDetailsOK{}
+}
+
+// WithPayload adds the payload to the custom widget details o k response
+func (o *CustomWidgetDetailsOK) WithPayload(payload *models.WidgetDetails) *CustomWidgetDetailsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the custom widget details o k response
+func (o *CustomWidgetDetailsOK) SetPayload(payload *models.WidgetDetails) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CustomWidgetDetailsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CustomWidgetDetailsDefault Generic error response.
+
+swagger:response customWidgetDetailsDefault
+*/
+type CustomWidgetDetailsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewCustomWidgetDetailsDefault creates CustomWidgetDetailsDefault with default headers values
+func NewCustomWidgetDetailsDefault(code int) *CustomWidgetDetailsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CustomWidgetDetailsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the custom widget details default response
+func (o *CustomWidgetDetailsDefault) WithStatusCode(code int) *CustomWidgetDetailsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the custom widget details default response
+func (o *CustomWidgetDetailsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the custom widget details default response
+func (o *CustomWidgetDetailsDefault) WithPayload(payload *models.APIError) *CustomWidgetDetailsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the custom widget details default response
+func (o *CustomWidgetDetailsDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CustomWidgetDetailsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// DeleteAlertSilenceHandlerFunc turns a function with the right signature into a delete alert silence handler
+type DeleteAlertSilenceHandlerFunc func(DeleteAlertSilenceParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteAlertSilenceHandlerFunc) Handle(params DeleteAlertSilenceParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeleteAlertSilenceHandler interface for that can handle valid delete alert silence params
+type DeleteAlertSilenceHandler interface {
+	Handle(DeleteAlertSilenceParams, *models.Principal) middleware.Responder
+}
+
+// NewDeleteAlertSilence creates a new http.Handler for the delete alert silence operation
+func NewDeleteAlertSilence(ctx *middleware.Context, handler DeleteAlertSilenceHandler) *DeleteAlertSilence {
+	return &DeleteAlertSilence{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteAlertSilence swagger:route DELETE /admin/alerts/silences/{id} System deleteAlertSilence
+
+Removes an alert silence
+*/
+type DeleteAlertSilence struct {
+	Context *middleware.Context
+	Handler DeleteAlertSilenceHandler
+}
+
+func (o *DeleteAlertSilence) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteAlertSilenceParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// DeleteAlertSilenceNoContentCode is the HTTP code returned for type DeleteAlertSilenceNoContent
+const DeleteAlertSilenceNoContentCode int = 204
+
+/*
+DeleteAlertSilenceNoContent A successful response.
+
+swagger:response deleteAlertSilenceNoContent
+*/
+type DeleteAlertSilenceNoContent struct {
+}
+
+// NewDeleteAlertSilenceNoContent creates DeleteAlertSilenceNoContent with default headers values
+func NewDeleteAlertSilenceNoContent() *DeleteAlertSilenceNoContent {
+
+	return &DeleteAlertSilenceNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteAlertSilenceNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+DeleteAlertSilenceDefault Generic error response.
+
+swagger:response deleteAlertSilenceDefault
+*/
+type DeleteAlertSilenceDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewDeleteAlertSilenceDefault creates DeleteAlertSilenceDefault with default headers values
+func NewDeleteAlertSilenceDefault(code int) *DeleteAlertSilenceDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteAlertSilenceDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete alert silence default response
+func (o *DeleteAlertSilenceDefault) WithStatusCode(code int) *DeleteAlertSilenceDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete alert silence default response
+func (o *DeleteAlertSilenceDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete alert silence default response
+func (o *DeleteAlertSilenceDefault) WithPayload(payload *models.APIError) *DeleteAlertSilenceDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete alert silence default response
+func (o *DeleteAlertSilenceDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAlertSilenceDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// ListAlertsHandlerFunc turns a function with the right signature into a list alerts handler
+type ListAlertsHandlerFunc func(ListAlertsParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListAlertsHandlerFunc) Handle(params ListAlertsParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListAlertsHandler interface for that can handle valid list alerts params
+type ListAlertsHandler interface {
+	Handle(ListAlertsParams, *models.Principal) middleware.Responder
+}
+
+// NewListAlerts creates a new http.Handler for the list alerts operation
+func NewListAlerts(ctx *middleware.Context, handler ListAlertsHandler) *ListAlerts {
+	return &ListAlerts{Context: ctx, Handler: handler}
+}
+
+/*
+	ListAlerts swagger:route GET /admin/alerts System listAlerts
+
+Returns the firing and recently resolved alerts, the alert rules and the silences
+*/
+type ListAlerts struct {
+	Context *middleware.Context
+	Handler ListAlertsHandler
+}
+
+func (o *ListAlerts) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListAlertsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
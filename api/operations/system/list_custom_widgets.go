@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// ListCustomWidgetsHandlerFunc turns a function with the right signature into a list custom widgets handler
+type ListCustomWidgetsHandlerFunc func(ListCustomWidgetsParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListCustomWidgetsHandlerFunc) Handle(params ListCustomWidgetsParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListCustomWidgetsHandler interface for that can handle valid list custom widgets params
+type ListCustomWidgetsHandler interface {
+	Handle(ListCustomWidgetsParams, *models.Principal) middleware.Responder
+}
+
+// NewListCustomWidgets creates a new http.Handler for the list custom widgets operation
+func NewListCustomWidgets(ctx *middleware.Context, handler ListCustomWidgetsHandler) *ListCustomWidgets {
+	return &ListCustomWidgets{Context: ctx, Handler: handler}
+}
+
+/*
+	ListCustomWidgets swagger:route GET /admin/info/custom-widgets System listCustomWidgets
+
+Returns the user-defined dashboard widgets
+*/
+type ListCustomWidgets struct {
+	Context *middleware.Context
+	Handler ListCustomWidgetsHandler
+}
+
+func (o *ListCustomWidgets) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListCustomWidgetsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// NewListCustomWidgetsParams creates a new ListCustomWidgetsParams object
+//
+// There are no default values defined in the spec.
+func NewListCustomWidgetsParams() ListCustomWidgetsParams {
+
+	return ListCustomWidgetsParams{}
+}
+
+// ListCustomWidgetsParams contains all the bound params for the list custom widgets operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters ListCustomWidgets
+type ListCustomWidgetsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListCustomWidgetsParams() beforehand.
+func (o *ListCustomWidgetsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// ListCustomWidgetsOKCode is the HTTP code returned for type ListCustomWidgetsOK
+const ListCustomWidgetsOKCode int = 200
+
+/*
+ListCustomWidgetsOK A successful response.
+
+swagger:response listCustomWidgetsOK
+*/
+type ListCustomWidgetsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.CustomWidgetsResponse `json:"body,omitempty"`
+}
+
+// NewListCustomWidgetsOK creates ListCustomWidgetsOK with default headers values
+func NewListCustomWidgetsOK() *ListCustomWidgetsOK {
+
+	return &ListCustomWidgetsOK{}
+}
+
+// WithPayload adds the payload to the list custom widgets o k response
+func (o *ListCustomWidgetsOK) WithPayload(payload *models.CustomWidgetsResponse) *ListCustomWidgetsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list custom widgets o k response
+func (o *ListCustomWidgetsOK) SetPayload(payload *models.CustomWidgetsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListCustomWidgetsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ListCustomWidgetsDefault Generic error response.
+
+swagger:response listCustomWidgetsDefault
+*/
+type ListCustomWidgetsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewListCustomWidgetsDefault creates ListCustomWidgetsDefault with default headers values
+func NewListCustomWidgetsDefault(code int) *ListCustomWidgetsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListCustomWidgetsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list custom widgets default response
+func (o *ListCustomWidgetsDefault) WithStatusCode(code int) *ListCustomWidgetsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list custom widgets default response
+func (o *ListCustomWidgetsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list custom widgets default response
+func (o *ListCustomWidgetsDefault) WithPayload(payload *models.APIError) *ListCustomWidgetsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list custom widgets default response
+func (o *ListCustomWidgetsDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListCustomWidgetsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// TestCustomWidgetHandlerFunc turns a function with the right signature into a test custom widget handler
+type TestCustomWidgetHandlerFunc func(TestCustomWidgetParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn TestCustomWidgetHandlerFunc) Handle(params TestCustomWidgetParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// TestCustomWidgetHandler interface for that can handle valid test custom widget params
+type TestCustomWidgetHandler interface {
+	Handle(TestCustomWidgetParams, *models.Principal) middleware.Responder
+}
+
+// NewTestCustomWidget creates a new http.Handler for the test custom widget operation
+func NewTestCustomWidget(ctx *middleware.Context, handler TestCustomWidgetHandler) *TestCustomWidget {
+	return &TestCustomWidget{Context: ctx, Handler: handler}
+}
+
+/*
+	TestCustomWidget swagger:route POST /admin/info/custom-widgets/test System testCustomWidget
+
+Validates a dashboard widget definition and returns the results of its queries
+*/
+type TestCustomWidget struct {
+	Context *middleware.Context
+	Handler TestCustomWidgetHandler
+}
+
+func (o *TestCustomWidget) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewTestCustomWidgetParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package system
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// TestCustomWidgetOKCode is the HTTP code returned for type TestCustomWidgetOK
+const TestCustomWidgetOKCode int = 200
+
+/*
+TestCustomWidgetOK A successful response.
+
+swagger:response testCustomWidgetOK
+*/
+type TestCustomWidgetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.WidgetDetails `json:"body,omitempty"`
+}
+
+// NewTestCustomWidgetOK creates TestCustomWidgetOK with default headers values
+func NewTestCustomWidgetOK() *TestCustomWidgetOK {
+
+	return &TestCustomWidgetOK{}
+}
+
+// WithPayload adds the payload to the test custom widget o k response
+func (o *TestCustomWidgetOK) WithPayload(payload *models.WidgetDetails) *TestCustomWidgetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the test custom widget o k response
+func (o *TestCustomWidgetOK) SetPayload(payload *models.WidgetDetails) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TestCustomWidgetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+TestCustomWidgetDefault Generic error response.
+
+swagger:response testCustomWidgetDefault
+*/
+type TestCustomWidgetDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewTestCustomWidgetDefault creates TestCustomWidgetDefault with default headers values
+func NewTestCustomWidgetDefault(code int) *TestCustomWidgetDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TestCustomWidgetDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the test custom widget default response
+func (o *TestCustomWidgetDefault) WithStatusCode(code int) *TestCustomWidgetDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the test custom widget default response
+func (o *TestCustomWidgetDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the test custom widget default response
+func (o *TestCustomWidgetDefault) WithPayload(payload *models.APIError) *TestCustomWidgetDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the test custom widget default response
+func (o *TestCustomWidgetDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TestCustomWidgetDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
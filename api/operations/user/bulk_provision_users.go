@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package user
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// BulkProvisionUsersHandlerFunc turns a function with the right signature into a bulk provision users handler
+type BulkProvisionUsersHandlerFunc func(BulkProvisionUsersParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn BulkProvisionUsersHandlerFunc) Handle(params BulkProvisionUsersParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// BulkProvisionUsersHandler interface for that can handle valid bulk provision users params
+type BulkProvisionUsersHandler interface {
+	Handle(BulkProvisionUsersParams, *models.Principal) middleware.Responder
+}
+
+// NewBulkProvisionUsers creates a new http.Handler for the bulk provision users operation
+func NewBulkProvisionUsers(ctx *middleware.Context, handler BulkProvisionUsersHandler) *BulkProvisionUsers {
+	return &BulkProvisionUsers{Context: ctx, Handler: handler}
+}
+
+/*
+	BulkProvisionUsers swagger:route POST /users/provision User bulkProvisionUsers
+
+Validates and creates a list of users, optionally generating their secret keys
+*/
+type BulkProvisionUsers struct {
+	Context *middleware.Context
+	Handler BulkProvisionUsersHandler
+}
+
+func (o *BulkProvisionUsers) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewBulkProvisionUsersParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
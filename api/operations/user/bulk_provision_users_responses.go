@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package user
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// BulkProvisionUsersOKCode is the HTTP code returned for type BulkProvisionUsersOK
+const BulkProvisionUsersOKCode int = 200
+
+/*
+BulkProvisionUsersOK A successful response.
+
+swagger:response bulkProvisionUsersOK
+*/
+type BulkProvisionUsersOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BulkProvisionUsersResponse `json:"body,omitempty"`
+}
+
+// NewBulkProvisionUsersOK creates BulkProvisionUsersOK with default headers values
+func NewBulkProvisionUsersOK() *BulkProvisionUsersOK {
+
+	return &BulkProvisionUsersOK{}
+}
+
+// WithPayload adds the payload to the bulk provision users o k response
+func (o *BulkProvisionUsersOK) WithPayload(payload *models.BulkProvisionUsersResponse) *BulkProvisionUsersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the bulk provision users o k response
+func (o *BulkProvisionUsersOK) SetPayload(payload *models.BulkProvisionUsersResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BulkProvisionUsersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+BulkProvisionUsersDefault Generic error response.
+
+swagger:response bulkProvisionUsersDefault
+*/
+type BulkProvisionUsersDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewBulkProvisionUsersDefault creates BulkProvisionUsersDefault with default headers values
+func NewBulkProvisionUsersDefault(code int) *BulkProvisionUsersDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &BulkProvisionUsersDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the bulk provision users default response
+func (o *BulkProvisionUsersDefault) WithStatusCode(code int) *BulkProvisionUsersDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the bulk provision users default response
+func (o *BulkProvisionUsersDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the bulk provision users default response
+func (o *BulkProvisionUsersDefault) WithPayload(payload *models.APIError) *BulkProvisionUsersDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the bulk provision users default response
+func (o *BulkProvisionUsersDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BulkProvisionUsersDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
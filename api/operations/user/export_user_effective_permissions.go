@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package user
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// ExportUserEffectivePermissionsHandlerFunc turns a function with the right signature into a export user effective permissions handler
+type ExportUserEffectivePermissionsHandlerFunc func(ExportUserEffectivePermissionsParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ExportUserEffectivePermissionsHandlerFunc) Handle(params ExportUserEffectivePermissionsParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ExportUserEffectivePermissionsHandler interface for that can handle valid export user effective permissions params
+type ExportUserEffectivePermissionsHandler interface {
+	Handle(ExportUserEffectivePermissionsParams, *models.Principal) middleware.Responder
+}
+
+// NewExportUserEffectivePermissions creates a new http.Handler for the export user effective permissions operation
+func NewExportUserEffectivePermissions(ctx *middleware.Context, handler ExportUserEffectivePermissionsHandler) *ExportUserEffectivePermissions {
+	return &ExportUserEffectivePermissions{Context: ctx, Handler: handler}
+}
+
+/*
+	ExportUserEffectivePermissions swagger:route GET /user/{name}/effective-permissions/csv User exportUserEffectivePermissions
+
+exports the permissions a user effectively holds on every bucket as CSV
+*/
+type ExportUserEffectivePermissions struct {
+	Context *middleware.Context
+	Handler ExportUserEffectivePermissionsHandler
+}
+
+func (o *ExportUserEffectivePermissions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewExportUserEffectivePermissionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
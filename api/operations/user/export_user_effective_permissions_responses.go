@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package user
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// ExportUserEffectivePermissionsOKCode is the HTTP code returned for type ExportUserEffectivePermissionsOK
+const ExportUserEffectivePermissionsOKCode int = 200
+
+/*
+ExportUserEffectivePermissionsOK A successful response.
+
+swagger:response exportUserEffectivePermissionsOK
+*/
+type ExportUserEffectivePermissionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewExportUserEffectivePermissionsOK creates ExportUserEffectivePermissionsOK with default headers values
+func NewExportUserEffectivePermissionsOK() *ExportUserEffectivePermissionsOK {
+
+	return &ExportUserEffectivePermissionsOK{}
+}
+
+// WithPayload adds the payload to the export user effective permissions o k response
+func (o *ExportUserEffectivePermissionsOK) WithPayload(payload io.ReadCloser) *ExportUserEffectivePermissionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export user effective permissions o k response
+func (o *ExportUserEffectivePermissionsOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportUserEffectivePermissionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+ExportUserEffectivePermissionsDefault Generic error response.
+
+swagger:response exportUserEffectivePermissionsDefault
+*/
+type ExportUserEffectivePermissionsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewExportUserEffectivePermissionsDefault creates ExportUserEffectivePermissionsDefault with default headers values
+func NewExportUserEffectivePermissionsDefault(code int) *ExportUserEffectivePermissionsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ExportUserEffectivePermissionsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the export user effective permissions default response
+func (o *ExportUserEffectivePermissionsDefault) WithStatusCode(code int) *ExportUserEffectivePermissionsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the export user effective permissions default response
+func (o *ExportUserEffectivePermissionsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the export user effective permissions default response
+func (o *ExportUserEffectivePermissionsDefault) WithPayload(payload *models.APIError) *ExportUserEffectivePermissionsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export user effective permissions default response
+func (o *ExportUserEffectivePermissionsDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportUserEffectivePermissionsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package user
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// GetUserEffectivePermissionsHandlerFunc turns a function with the right signature into a get user effective permissions handler
+type GetUserEffectivePermissionsHandlerFunc func(GetUserEffectivePermissionsParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetUserEffectivePermissionsHandlerFunc) Handle(params GetUserEffectivePermissionsParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetUserEffectivePermissionsHandler interface for that can handle valid get user effective permissions params
+type GetUserEffectivePermissionsHandler interface {
+	Handle(GetUserEffectivePermissionsParams, *models.Principal) middleware.Responder
+}
+
+// NewGetUserEffectivePermissions creates a new http.Handler for the get user effective permissions operation
+func NewGetUserEffectivePermissions(ctx *middleware.Context, handler GetUserEffectivePermissionsHandler) *GetUserEffectivePermissions {
+	return &GetUserEffectivePermissions{Context: ctx, Handler: handler}
+}
+
+/*
+	GetUserEffectivePermissions swagger:route GET /user/{name}/effective-permissions User getUserEffectivePermissions
+
+returns the permissions a user effectively holds on every bucket
+*/
+type GetUserEffectivePermissions struct {
+	Context *middleware.Context
+	Handler GetUserEffectivePermissionsHandler
+}
+
+func (o *GetUserEffectivePermissions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetUserEffectivePermissionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
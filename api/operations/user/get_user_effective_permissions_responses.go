@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package user
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// GetUserEffectivePermissionsOKCode is the HTTP code returned for type GetUserEffectivePermissionsOK
+const GetUserEffectivePermissionsOKCode int = 200
+
+/*
+GetUserEffectivePermissionsOK A successful response.
+
+swagger:response getUserEffectivePermissionsOK
+*/
+type GetUserEffectivePermissionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.EffectivePermissionsResponse `json:"body,omitempty"`
+}
+
+// NewGetUserEffectivePermissionsOK creates GetUserEffectivePermissionsOK with default headers values
+func NewGetUserEffectivePermissionsOK() *GetUserEffectivePermissionsOK {
+
+	return &GetUserEffectivePermissionsOK{}
+}
+
+// WithPayload adds the payload to the get user effective permissions o k response
+func (o *GetUserEffectivePermissionsOK) WithPayload(payload *models.EffectivePermissionsResponse) *GetUserEffectivePermissionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user effective permissions o k response
+func (o *GetUserEffectivePermissionsOK) SetPayload(payload *models.EffectivePermissionsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserEffectivePermissionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetUserEffectivePermissionsDefault Generic error response.
+
+swagger:response getUserEffectivePermissionsDefault
+*/
+type GetUserEffectivePermissionsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewGetUserEffectivePermissionsDefault creates GetUserEffectivePermissionsDefault with default headers values
+func NewGetUserEffectivePermissionsDefault(code int) *GetUserEffectivePermissionsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetUserEffectivePermissionsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get user effective permissions default response
+func (o *GetUserEffectivePermissionsDefault) WithStatusCode(code int) *GetUserEffectivePermissionsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get user effective permissions default response
+func (o *GetUserEffectivePermissionsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get user effective permissions default response
+func (o *GetUserEffectivePermissionsDefault) WithPayload(payload *models.APIError) *GetUserEffectivePermissionsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user effective permissions default response
+func (o *GetUserEffectivePermissionsDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserEffectivePermissionsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package user
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/openstor/console/models"
+)
+
+// UpdateUserExpiryHandlerFunc turns a function with the right signature into a update user expiry handler
+type UpdateUserExpiryHandlerFunc func(UpdateUserExpiryParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateUserExpiryHandlerFunc) Handle(params UpdateUserExpiryParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UpdateUserExpiryHandler interface for that can handle valid update user expiry params
+type UpdateUserExpiryHandler interface {
+	Handle(UpdateUserExpiryParams, *models.Principal) middleware.Responder
+}
+
+// NewUpdateUserExpiry creates a new http.Handler for the update user expiry operation
+func NewUpdateUserExpiry(ctx *middleware.Context, handler UpdateUserExpiryHandler) *UpdateUserExpiry {
+	return &UpdateUserExpiry{Context: ctx, Handler: handler}
+}
+
+/*
+	UpdateUserExpiry swagger:route PUT /user/{name}/expiry User updateUserExpiry
+
+Set or clear the expiry of a user
+*/
+type UpdateUserExpiry struct {
+	Context *middleware.Context
+	Handler UpdateUserExpiryHandler
+}
+
+func (o *UpdateUserExpiry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUpdateUserExpiryParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package user
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openstor/console/models"
+)
+
+// UpdateUserExpiryNoContentCode is the HTTP code returned for type UpdateUserExpiryNoContent
+const UpdateUserExpiryNoContentCode int = 204
+
+/*
+UpdateUserExpiryNoContent A successful response.
+
+swagger:response updateUserExpiryNoContent
+*/
+type UpdateUserExpiryNoContent struct {
+}
+
+// NewUpdateUserExpiryNoContent creates UpdateUserExpiryNoContent with default headers values
+func NewUpdateUserExpiryNoContent() *UpdateUserExpiryNoContent {
+
+	return &UpdateUserExpiryNoContent{}
+}
+
+// WriteResponse to the client
+func (o *UpdateUserExpiryNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*
+UpdateUserExpiryDefault Generic error response.
+
+swagger:response updateUserExpiryDefault
+*/
+type UpdateUserExpiryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewUpdateUserExpiryDefault creates UpdateUserExpiryDefault with default headers values
+func NewUpdateUserExpiryDefault(code int) *UpdateUserExpiryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateUserExpiryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update user expiry default response
+func (o *UpdateUserExpiryDefault) WithStatusCode(code int) *UpdateUserExpiryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update user expiry default response
+func (o *UpdateUserExpiryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update user expiry default response
+func (o *UpdateUserExpiryDefault) WithPayload(payload *models.APIError) *UpdateUserExpiryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user expiry default response
+func (o *UpdateUserExpiryDefault) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserExpiryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
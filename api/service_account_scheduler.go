@@ -0,0 +1,495 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	saApi "github.com/openstor/console/api/operations/service_account"
+	"github.com/openstor/console/models"
+	"github.com/openstor/openstor-go/v7"
+)
+
+const (
+	// serviceAccountSchedulerObject is the object of the system bucket keeping the scheduler state
+	serviceAccountSchedulerObject = "service-accounts/scheduler.json"
+	// serviceAccountMaxNotifications limits the notifications kept for the console
+	serviceAccountMaxNotifications = 500
+	// webhookTimeout bounds the delivery of a notification to a webhook
+	webhookTimeout = 10 * time.Second
+)
+
+// globalServiceAccountScheduler is the scheduler started by this console instance, nil when it isn't configured
+var globalServiceAccountScheduler *serviceAccountScheduler
+
+type serviceAccountSchedulerConfig struct {
+	// interval between two scans of the service accounts
+	interval time.Duration
+	// expiryWarning is how long before an access key expires a notification is raised
+	expiryWarning time.Duration
+	// maxAge is the age above which an access key should be rotated, 0 disables the check
+	maxAge time.Duration
+	// autoRotate replaces the expiring and aged access keys with new ones
+	autoRotate bool
+	// rotationGrace is how long a rotated access key keeps working before it is deleted
+	rotationGrace time.Duration
+	// rotationLifetime is the expiration given to the access keys replacing expiring ones
+	rotationLifetime time.Duration
+	webhookURL       string
+	webhookAuthToken string
+}
+
+// serviceAccountRotation is an access key replaced by a new one and waiting for its deletion. The secret key of
+// the new access key isn't kept, it is only delivered with the notification of the rotation. The old access key is
+// only deleted once the secret key was delivered, to the webhook or to the owner listing the notifications.
+type serviceAccountRotation struct {
+	OldAccessKey string    `json:"oldAccessKey"`
+	NewAccessKey string    `json:"newAccessKey"`
+	ParentUser   string    `json:"parentUser"`
+	DeleteAfter  time.Time `json:"deleteAfter"`
+	Delivered    bool      `json:"delivered"`
+}
+
+// serviceAccountSchedulerState is what the scheduler remembers between scans. The server doesn't keep the
+// creation date of the access keys, so their age is counted from the first scan that saw them.
+type serviceAccountSchedulerState struct {
+	FirstSeen map[string]time.Time `json:"firstSeen"`
+	// Notified keeps the last notification kind raised for an access key to raise it only once
+	Notified      map[string]string                    `json:"notified"`
+	Rotations     []*serviceAccountRotation            `json:"rotations"`
+	Notifications []*models.ServiceAccountNotification `json:"notifications"`
+}
+
+func newServiceAccountSchedulerState() *serviceAccountSchedulerState {
+	return &serviceAccountSchedulerState{FirstSeen: map[string]time.Time{}, Notified: map[string]string{}}
+}
+
+// serviceAccountSchedulerStore persists the scheduler state
+type serviceAccountSchedulerStore interface {
+	load(ctx context.Context) (*serviceAccountSchedulerState, error)
+	save(ctx context.Context, state *serviceAccountSchedulerState) error
+}
+
+// bucketServiceAccountSchedulerStore keeps the scheduler state as a signed JSON object in the system bucket, so
+// the users allowed to write the bucket can't schedule the deletion of access keys
+type bucketServiceAccountSchedulerStore struct {
+	client *openstor.Client
+	bucket string
+}
+
+func (s *bucketServiceAccountSchedulerStore) load(ctx context.Context) (*serviceAccountSchedulerState, error) {
+	state := newServiceAccountSchedulerState()
+	data, _, err := getSignedSystemObject(ctx, s.client, s.bucket, serviceAccountSchedulerObject)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the service account scheduler state: %w", err)
+	}
+	// nothing was saved yet
+	if data == nil {
+		return state, nil
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("unable to read the service account scheduler state: %w", err)
+	}
+	if state.FirstSeen == nil {
+		state.FirstSeen = map[string]time.Time{}
+	}
+	if state.Notified == nil {
+		state.Notified = map[string]string{}
+	}
+	return state, nil
+}
+
+// save writes the state whatever its version, only the leader saves it
+func (s *bucketServiceAccountSchedulerStore) save(ctx context.Context, state *serviceAccountSchedulerState) error {
+	rawState, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	_, err = putSignedSystemObject(ctx, s.client, s.bucket, serviceAccountSchedulerObject, rawState, systemObjectAnyVersion)
+	return err
+}
+
+// serviceAccountScheduler periodically scans the service accounts of every user, notifies about access keys
+// approaching their expiration or too old, and optionally rotates them. Only the leader scans the service
+// accounts, the other consoles list the notifications it saved.
+type serviceAccountScheduler struct {
+	client MinioAdmin
+	store  serviceAccountSchedulerStore
+	config serviceAccountSchedulerConfig
+	// notify delivers the notifications outside the console, e.g. to a webhook, nil when there is none
+	notify func(ctx context.Context, notification *models.ServiceAccountNotification) error
+	// isLeader tells whether this console scans the service accounts, nil when it is the only console
+	isLeader func() bool
+	now      func() time.Time
+
+	mu    sync.Mutex
+	state *serviceAccountSchedulerState
+	// secretKeys are the secret keys of the access keys this console created by rotation, by access key, until
+	// their owner gets them once
+	secretKeys map[string]string
+	// delivered are the access keys whose secret key their owner got, until the rotation is over
+	delivered map[string]bool
+}
+
+// startServiceAccountScheduler starts the scheduler when it is enabled and the background credentials are set,
+// it returns the function stopping it
+func startServiceAccountScheduler() func() {
+	background := globalBackgroundService
+	if background == nil || !getServiceAccountSchedulerEnabled() {
+		return func() {}
+	}
+	if !systemObjectKeyConfigured() {
+		LogError("service account scheduler: %v", errSystemObjectKeyNotSet)
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	config := getServiceAccountSchedulerConfig()
+	scheduler := &serviceAccountScheduler{
+		client:   background.admin,
+		store:    &bucketServiceAccountSchedulerStore{client: background.client, bucket: getSystemBucket()},
+		config:   config,
+		isLeader: background.isLeader,
+		now:      time.Now,
+	}
+	if config.webhookURL != "" {
+		scheduler.notify = func(ctx context.Context, notification *models.ServiceAccountNotification) error {
+			return postWebhook(ctx, config.webhookURL, config.webhookAuthToken, notification)
+		}
+	}
+	globalServiceAccountScheduler = scheduler
+	go scheduler.run(ctx)
+	return cancel
+}
+
+func (s *serviceAccountScheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.scan(ctx); err != nil && ctx.Err() == nil {
+			LogError("service account scheduler: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// scan checks the access keys of every user, rotates the ones due for rotation and deletes the rotated access
+// keys once their grace period is over
+func (s *serviceAccountScheduler) scan(ctx context.Context) error {
+	state, err := s.store.load(ctx)
+	if err != nil {
+		return err
+	}
+	if s.isLeader != nil && !s.isLeader() {
+		s.mu.Lock()
+		s.state = state
+		s.mu.Unlock()
+		return nil
+	}
+	users, err := s.client.listUsers(ctx)
+	if err != nil {
+		return err
+	}
+	now := s.now().UTC()
+
+	rotating := map[string]bool{}
+	for _, r := range state.Rotations {
+		rotating[r.OldAccessKey] = true
+		rotating[r.NewAccessKey] = true
+	}
+	seen := map[string]bool{}
+	for _, user := range sortedKeys(users) {
+		accounts, err := s.client.listServiceAccounts(ctx, user)
+		if err != nil {
+			return err
+		}
+		for _, account := range accounts.Accounts {
+			seen[account.AccessKey] = true
+			firstSeen, ok := state.FirstSeen[account.AccessKey]
+			if !ok {
+				firstSeen = now
+				state.FirstSeen[account.AccessKey] = now
+			}
+			notification := &models.ServiceAccountNotification{AccessKey: account.AccessKey, ParentUser: user}
+			if account.Expiration != nil && !account.Expiration.IsZero() {
+				notification.Expiration = account.Expiration.UTC().Format(time.RFC3339)
+			}
+			switch {
+			case account.Expiration != nil && !account.Expiration.IsZero() && !account.Expiration.After(now):
+				notification.Kind = models.ServiceAccountNotificationKindExpired
+				notification.Message = fmt.Sprintf("access key %s of %s expired on %s", account.AccessKey, user, notification.Expiration)
+			case account.Expiration != nil && !account.Expiration.IsZero() && account.Expiration.Sub(now) <= s.config.expiryWarning:
+				notification.Kind = models.ServiceAccountNotificationKindExpiring
+				notification.Message = fmt.Sprintf("access key %s of %s expires on %s", account.AccessKey, user, notification.Expiration)
+			case s.config.maxAge > 0 && now.Sub(firstSeen) >= s.config.maxAge:
+				notification.Kind = models.ServiceAccountNotificationKindAged
+				notification.Message = fmt.Sprintf("access key %s of %s is older than %s", account.AccessKey, user, s.config.maxAge)
+			default:
+				continue
+			}
+			if state.Notified[account.AccessKey] != notification.Kind {
+				state.Notified[account.AccessKey] = notification.Kind
+				s.raise(ctx, state, notification, now)
+			}
+			if s.config.autoRotate && notification.Kind != models.ServiceAccountNotificationKindExpired && !rotating[account.AccessKey] {
+				s.rotate(ctx, state, account.AccessKey, user, account.Expiration != nil && !account.Expiration.IsZero(), now)
+			}
+		}
+	}
+
+	// delete the rotated access keys once the secret key of the new one was delivered and their grace period is over
+	var pending []*serviceAccountRotation
+	for _, r := range state.Rotations {
+		s.mu.Lock()
+		_, undelivered := s.secretKeys[r.NewAccessKey]
+		r.Delivered = r.Delivered || s.delivered[r.NewAccessKey]
+		s.mu.Unlock()
+		if !r.Delivered && !undelivered {
+			// the secret key was lost before being delivered, e.g. the console rotating the access key restarted,
+			// nobody can use the new access key
+			if !s.rollback(ctx, state, r, seen, now) {
+				pending = append(pending, r)
+			}
+			continue
+		}
+		if !r.Delivered || now.Before(r.DeleteAfter) {
+			pending = append(pending, r)
+			continue
+		}
+		notification := &models.ServiceAccountNotification{AccessKey: r.OldAccessKey, ParentUser: r.ParentUser, NewAccessKey: r.NewAccessKey}
+		if err := s.client.deleteServiceAccount(ctx, r.OldAccessKey); err != nil && seen[r.OldAccessKey] {
+			// keep trying on the next scan
+			pending = append(pending, r)
+			LogError("service account scheduler: unable to delete rotated access key %s: %v", r.OldAccessKey, err)
+			continue
+		}
+		notification.Kind = models.ServiceAccountNotificationKindRetired
+		notification.Message = fmt.Sprintf("rotated access key %s of %s was deleted, %s replaces it", r.OldAccessKey, r.ParentUser, r.NewAccessKey)
+		s.raise(ctx, state, notification, now)
+		delete(seen, r.OldAccessKey)
+		s.mu.Lock()
+		delete(s.secretKeys, r.NewAccessKey)
+		delete(s.delivered, r.NewAccessKey)
+		s.mu.Unlock()
+	}
+	state.Rotations = pending
+
+	// forget the access keys deleted since the last scan
+	for accessKey := range state.FirstSeen {
+		if !seen[accessKey] {
+			delete(state.FirstSeen, accessKey)
+			delete(state.Notified, accessKey)
+		}
+	}
+	if len(state.Notifications) > serviceAccountMaxNotifications {
+		state.Notifications = state.Notifications[len(state.Notifications)-serviceAccountMaxNotifications:]
+	}
+
+	if err := s.store.save(ctx, state); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.state = state
+	s.mu.Unlock()
+	return nil
+}
+
+// rotate creates the access key replacing an expiring or aged one with the same policy, the old access key is
+// deleted once the grace period is over
+func (s *serviceAccountScheduler) rotate(ctx context.Context, state *serviceAccountSchedulerState, accessKey, user string, expires bool, now time.Time) {
+	notification := &models.ServiceAccountNotification{AccessKey: accessKey, ParentUser: user}
+	info, err := s.client.infoServiceAccount(ctx, accessKey)
+	var creds *models.ServiceAccountCreds
+	if err == nil {
+		policy := ""
+		if !info.ImpliedPolicy {
+			policy = info.Policy
+		}
+		var expiry *time.Time
+		if expires {
+			expiration := now.Add(s.config.rotationLifetime)
+			expiry = &expiration
+		}
+		creds, err = createAUserServiceAccountCreds(ctx, s.client, policy, user, "", "", info.Name, info.Description, expiry, "")
+	}
+	if err != nil {
+		notification.Kind = models.ServiceAccountNotificationKindRotationFailed
+		notification.Message = fmt.Sprintf("unable to rotate access key %s of %s: %v", accessKey, user, err)
+		s.raise(ctx, state, notification, now)
+		return
+	}
+	rotation := &serviceAccountRotation{
+		OldAccessKey: accessKey,
+		NewAccessKey: creds.AccessKey,
+		ParentUser:   user,
+		DeleteAfter:  now.Add(s.config.rotationGrace),
+	}
+	state.Rotations = append(state.Rotations, rotation)
+	state.FirstSeen[creds.AccessKey] = now
+	s.mu.Lock()
+	if s.secretKeys == nil {
+		s.secretKeys = map[string]string{}
+	}
+	s.secretKeys[creds.AccessKey] = creds.SecretKey
+	s.mu.Unlock()
+	notification.Kind = models.ServiceAccountNotificationKindRotated
+	notification.NewAccessKey = creds.AccessKey
+	notification.SecretKey = creds.SecretKey
+	notification.DeleteAfter = rotation.DeleteAfter.Format(time.RFC3339)
+	notification.Message = fmt.Sprintf("access key %s of %s was rotated, %s replaces it and the old one is deleted after %s once its owner got the new secret key",
+		accessKey, user, creds.AccessKey, notification.DeleteAfter)
+	rotation.Delivered = s.raise(ctx, state, notification, now)
+}
+
+// rollback deletes the access key created by a rotation whose secret key was lost, the old access key is kept
+// and rotated again by the next scan. It returns false when the access key couldn't be deleted.
+func (s *serviceAccountScheduler) rollback(ctx context.Context, state *serviceAccountSchedulerState, r *serviceAccountRotation, seen map[string]bool, now time.Time) bool {
+	if err := s.client.deleteServiceAccount(ctx, r.NewAccessKey); err != nil && seen[r.NewAccessKey] {
+		// keep trying on the next scan
+		LogError("service account scheduler: unable to delete access key %s whose secret key was lost: %v", r.NewAccessKey, err)
+		return false
+	}
+	delete(seen, r.NewAccessKey)
+	s.raise(ctx, state, &models.ServiceAccountNotification{
+		Kind:       models.ServiceAccountNotificationKindRotationFailed,
+		AccessKey:  r.OldAccessKey,
+		ParentUser: r.ParentUser,
+		Message: fmt.Sprintf("the secret key of access key %s replacing %s of %s was lost before being delivered, %s was deleted and %s is kept",
+			r.NewAccessKey, r.OldAccessKey, r.ParentUser, r.NewAccessKey, r.OldAccessKey),
+	}, now)
+	return true
+}
+
+// raise keeps the notification for the console and delivers it to the webhook, failing to deliver it is logged.
+// The secret key of a rotated access key is only delivered to the webhook, it isn't kept. It returns whether the
+// webhook got the notification.
+func (s *serviceAccountScheduler) raise(ctx context.Context, state *serviceAccountSchedulerState, notification *models.ServiceAccountNotification, now time.Time) bool {
+	notification.Time = now.Format(time.RFC3339)
+	notification.ID = fmt.Sprintf("%d-%s-%s", now.UnixNano(), notification.Kind, notification.AccessKey)
+	kept := *notification
+	kept.SecretKey = ""
+	state.Notifications = append(state.Notifications, &kept)
+	if s.notify == nil {
+		return false
+	}
+	if err := s.notify(ctx, notification); err != nil {
+		LogError("service account scheduler: unable to deliver notification %s: %v", notification.ID, err)
+		return false
+	}
+	return true
+}
+
+// notifications returns the notifications raised by the last scan. The secret key of an access key this console
+// created by rotation is included once for its owner, then forgotten.
+func (s *serviceAccountScheduler) notifications(owner string) []*models.ServiceAccountNotification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.state == nil {
+		return nil
+	}
+	var notifications []*models.ServiceAccountNotification
+	for _, n := range s.state.Notifications {
+		notification := *n
+		if notification.Kind == models.ServiceAccountNotificationKindRotated && notification.ParentUser == owner {
+			if secretKey, ok := s.secretKeys[notification.NewAccessKey]; ok {
+				notification.SecretKey = secretKey
+				delete(s.secretKeys, notification.NewAccessKey)
+				if s.delivered == nil {
+					s.delivered = map[string]bool{}
+				}
+				s.delivered[notification.NewAccessKey] = true
+			}
+		}
+		notifications = append(notifications, &notification)
+	}
+	return notifications
+}
+
+// postWebhook sends the notification as JSON to the webhook, if any
+func postWebhook(ctx context.Context, url, authToken string, notification interface{}) error {
+	if url == "" {
+		return nil
+	}
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+authToken)
+	}
+	resp, err := GetConsoleHTTPClient("").Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// getListServiceAccountNotificationsResponse returns the notifications about the service accounts the session
+// can list, the secret key of rotated access keys is only returned once to their owner
+func getListServiceAccountNotificationsResponse(session *models.Principal, params saApi.ListServiceAccountNotificationsParams) (*models.ServiceAccountNotifications, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	response := &models.ServiceAccountNotifications{Notifications: []*models.ServiceAccountNotification{}}
+	if globalServiceAccountScheduler == nil {
+		return response, nil
+	}
+	userAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO user Admin Client interface implementation
+	// defining the client to be used
+	userAdminClient := AdminClient{Client: userAdmin}
+	identity := getSessionIdentity(session)
+	response.Notifications = filterServiceAccountNotifications(ctx, userAdminClient, identity, globalServiceAccountScheduler.notifications(identity))
+	return response, nil
+}
+
+// filterServiceAccountNotifications keeps the notifications of the users whose service accounts the session is
+// allowed to list, newest first
+func filterServiceAccountNotifications(ctx context.Context, client MinioAdmin, account string, notifications []*models.ServiceAccountNotification) []*models.ServiceAccountNotification {
+	allowed := map[string]bool{account: true}
+	filtered := []*models.ServiceAccountNotification{}
+	for _, n := range notifications {
+		visible, ok := allowed[n.ParentUser]
+		if !ok {
+			_, err := client.listServiceAccounts(ctx, n.ParentUser)
+			visible = err == nil
+			allowed[n.ParentUser] = visible
+		}
+		if !visible {
+			continue
+		}
+		if n.ParentUser != account {
+			n.SecretKey = ""
+		}
+		filtered = append(filtered, n)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Time > filtered[j].Time
+	})
+	return filtered
+}
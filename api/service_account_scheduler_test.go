@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryServiceAccountSchedulerStore keeps the scheduler state in memory for tests
+type memoryServiceAccountSchedulerStore struct {
+	state *serviceAccountSchedulerState
+}
+
+func (s *memoryServiceAccountSchedulerStore) load(_ context.Context) (*serviceAccountSchedulerState, error) {
+	if s.state == nil {
+		return newServiceAccountSchedulerState(), nil
+	}
+	return s.state, nil
+}
+
+func (s *memoryServiceAccountSchedulerStore) save(_ context.Context, state *serviceAccountSchedulerState) error {
+	s.state = state
+	return nil
+}
+
+func TestServiceAccountScheduler(t *testing.T) {
+	funcAssert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	expiring := now.Add(48 * time.Hour)
+	later := now.Add(365 * 24 * time.Hour)
+
+	accounts := map[string][]madmin.ServiceAccountInfo{
+		"alice": {
+			{AccessKey: "expiringkey", ParentUser: "alice", Expiration: &expiring},
+			{AccessKey: "validkey", ParentUser: "alice", Expiration: &later},
+		},
+		"bob": {{AccessKey: "oldkey", ParentUser: "bob"}},
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"alice": {}, "bob": {}}, nil
+	}
+	minioListServiceAccountsMock = func(_ context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		return madmin.ListServiceAccountsResp{Accounts: accounts[user]}, nil
+	}
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{ImpliedPolicy: false, Policy: iamBundleReadPolicy, Name: "backup"}, nil
+	}
+	var created []string
+	minioAddServiceAccountMock = func(_ context.Context, policy string, user string, _ string, _ string, _ string, _ string, expiry *time.Time) (madmin.Credentials, error) {
+		funcAssert.Equal(iamBundleReadPolicy, policy)
+		if user == "alice" {
+			funcAssert.NotNil(expiry)
+		} else {
+			funcAssert.Nil(expiry)
+		}
+		accessKey := "new" + user
+		created = append(created, accessKey)
+		accounts[user] = append(accounts[user], madmin.ServiceAccountInfo{AccessKey: accessKey, ParentUser: user, Expiration: expiry})
+		return madmin.Credentials{AccessKey: accessKey, SecretKey: "secret" + user}, nil
+	}
+	var deleted []string
+	minioDeleteServiceAccountMock = func(_ context.Context, serviceAccount string) error {
+		deleted = append(deleted, serviceAccount)
+		return nil
+	}
+	var delivered []string
+	secretKeys := map[string]string{}
+	store := &memoryServiceAccountSchedulerStore{}
+	scheduler := &serviceAccountScheduler{
+		client: AdminClientMock{},
+		store:  store,
+		config: serviceAccountSchedulerConfig{
+			expiryWarning:    7 * 24 * time.Hour,
+			maxAge:           90 * 24 * time.Hour,
+			rotationGrace:    24 * time.Hour,
+			rotationLifetime: 90 * 24 * time.Hour,
+		},
+		notify: func(_ context.Context, notification *models.ServiceAccountNotification) error {
+			delivered = append(delivered, notification.Kind+":"+notification.AccessKey)
+			if notification.SecretKey != "" {
+				secretKeys[notification.NewAccessKey] = notification.SecretKey
+			}
+			return nil
+		},
+		now: func() time.Time { return now },
+	}
+
+	// Test-1: expiring access keys are notified once
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.Equal([]string{"expiring:expiringkey"}, delivered)
+	funcAssert.Empty(created)
+
+	// Test-2: access keys older than the maximum age are notified
+	now = now.Add(91 * 24 * time.Hour)
+	delivered = nil
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.ElementsMatch([]string{"expired:expiringkey", "aged:validkey", "aged:oldkey"}, delivered)
+
+	// Test-3: with auto rotation, new access keys are created and the old ones deleted after the grace period
+	scheduler.config.autoRotate = true
+	delivered = nil
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.Equal([]string{"newalice", "newbob"}, created)
+	funcAssert.ElementsMatch([]string{"rotated:validkey", "rotated:oldkey"}, delivered)
+	funcAssert.Empty(deleted)
+	funcAssert.Equal(map[string]string{"newalice": "secretalice", "newbob": "secretbob"}, secretKeys)
+	for _, n := range store.state.Notifications {
+		funcAssert.Empty(n.SecretKey)
+	}
+	rawState, err := json.Marshal(store.state)
+	funcAssert.NoError(err)
+	funcAssert.NotContains(string(rawState), "secretbob")
+	// the owner gets the secret key once
+	notifications := scheduler.notifications("bob")
+	funcAssert.Equal("secretbob", notifications[len(notifications)-1].SecretKey)
+	for _, n := range scheduler.notifications("bob") {
+		funcAssert.Empty(n.SecretKey)
+	}
+
+	// rotated access keys aren't rotated twice
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.Len(created, 2)
+
+	now = now.Add(25 * time.Hour)
+	delivered = nil
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.ElementsMatch([]string{"validkey", "oldkey"}, deleted)
+	funcAssert.ElementsMatch([]string{"retired:validkey", "retired:oldkey"}, delivered)
+	funcAssert.Empty(store.state.Rotations)
+	for _, n := range scheduler.notifications("alice") {
+		funcAssert.Empty(n.SecretKey)
+	}
+
+	// Test-4: failing to rotate an access key is notified
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{}, errors.New("error")
+	}
+	accounts["carol"] = []madmin.ServiceAccountInfo{{AccessKey: "carolkey", ParentUser: "carol", Expiration: &expiring}}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"carol": {}}, nil
+	}
+	now = expiring.Add(-time.Hour)
+	delivered = nil
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.Equal([]string{"expiring:carolkey", "rotationFailed:carolkey"}, delivered)
+
+	// Test-5: a console that isn't the leader lists the notifications saved without scanning the service accounts
+	follower := &serviceAccountScheduler{
+		client:   AdminClientMock{},
+		store:    store,
+		config:   scheduler.config,
+		notify:   scheduler.notify,
+		isLeader: func() bool { return false },
+		now:      func() time.Time { return now },
+	}
+	now = now.Add(2 * time.Hour)
+	delivered = nil
+	created = nil
+	funcAssert.NoError(follower.scan(ctx))
+	funcAssert.Empty(delivered)
+	funcAssert.Empty(created)
+	funcAssert.Len(follower.notifications("carol"), len(store.state.Notifications))
+}
+
+func TestFilterServiceAccountNotifications(t *testing.T) {
+	minioListServiceAccountsMock = func(_ context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		if user == "bob" {
+			return madmin.ListServiceAccountsResp{}, errors.New("access denied")
+		}
+		return madmin.ListServiceAccountsResp{}, nil
+	}
+	filtered := filterServiceAccountNotifications(context.Background(), AdminClientMock{}, "alice", []*models.ServiceAccountNotification{
+		{ParentUser: "alice", Kind: "rotated", SecretKey: "alicesecret", Time: "2025-06-01T00:00:00Z"},
+		{ParentUser: "bob", Kind: "rotated", SecretKey: "bobsecret", Time: "2025-06-02T00:00:00Z"},
+		{ParentUser: "carol", Kind: "rotated", SecretKey: "carolsecret", Time: "2025-06-03T00:00:00Z"},
+	})
+	assert.Len(t, filtered, 2)
+	assert.Equal(t, "carol", filtered[0].ParentUser)
+	assert.Empty(t, filtered[0].SecretKey)
+	assert.Equal(t, "alicesecret", filtered[1].SecretKey)
+}
+
+func TestServiceAccountSchedulerDelivery(t *testing.T) {
+	funcAssert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	accounts := map[string][]madmin.ServiceAccountInfo{"bob": {{AccessKey: "oldkey", ParentUser: "bob"}}}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"bob": {}}, nil
+	}
+	minioListServiceAccountsMock = func(_ context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		return madmin.ListServiceAccountsResp{Accounts: accounts[user]}, nil
+	}
+	minioInfoServiceAccountMock = func(_ context.Context, _ string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{ImpliedPolicy: true}, nil
+	}
+	created := 0
+	minioAddServiceAccountMock = func(_ context.Context, _ string, user string, _ string, _ string, _ string, _ string, _ *time.Time) (madmin.Credentials, error) {
+		created++
+		accessKey := fmt.Sprintf("newkey%d", created)
+		accounts[user] = append(accounts[user], madmin.ServiceAccountInfo{AccessKey: accessKey, ParentUser: user})
+		return madmin.Credentials{AccessKey: accessKey, SecretKey: "secret" + accessKey}, nil
+	}
+	var deleted []string
+	minioDeleteServiceAccountMock = func(_ context.Context, serviceAccount string) error {
+		deleted = append(deleted, serviceAccount)
+		var kept []madmin.ServiceAccountInfo
+		for _, account := range accounts["bob"] {
+			if account.AccessKey != serviceAccount {
+				kept = append(kept, account)
+			}
+		}
+		accounts["bob"] = kept
+		return nil
+	}
+	config := serviceAccountSchedulerConfig{maxAge: time.Hour, autoRotate: true, rotationGrace: time.Hour}
+	store := &memoryServiceAccountSchedulerStore{}
+	newScheduler := func(notify func(context.Context, *models.ServiceAccountNotification) error) *serviceAccountScheduler {
+		return &serviceAccountScheduler{
+			client: AdminClientMock{},
+			store:  store,
+			config: config,
+			notify: notify,
+			now:    func() time.Time { return now },
+		}
+	}
+
+	// Test-1: without a webhook, the old access key is kept until its owner got the new secret key
+	scheduler := newScheduler(nil)
+	funcAssert.NoError(scheduler.scan(ctx))
+	now = now.Add(2 * time.Hour)
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.Equal(1, created)
+	funcAssert.Empty(deleted)
+	funcAssert.Len(store.state.Rotations, 1)
+	notifications := scheduler.notifications("bob")
+	funcAssert.Equal("secretnewkey1", notifications[len(notifications)-1].SecretKey)
+	now = now.Add(2 * time.Hour)
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.Equal([]string{"oldkey"}, deleted)
+	funcAssert.Empty(store.state.Rotations)
+
+	// Test-2: the webhook failing to get the secret key doesn't deliver it
+	accounts["bob"] = []madmin.ServiceAccountInfo{{AccessKey: "oldkey", ParentUser: "bob"}}
+	store.state = nil
+	deleted = nil
+	scheduler = newScheduler(func(_ context.Context, _ *models.ServiceAccountNotification) error {
+		return errors.New("webhook returned 503 Service Unavailable")
+	})
+	funcAssert.NoError(scheduler.scan(ctx))
+	now = now.Add(2 * time.Hour)
+	funcAssert.NoError(scheduler.scan(ctx))
+	now = now.Add(2 * time.Hour)
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.Equal(2, created)
+	funcAssert.Empty(deleted)
+	funcAssert.False(store.state.Rotations[0].Delivered)
+
+	// Test-3: a console not having the secret key of an undelivered rotation, e.g. after a restart, deletes the new
+	// access key and keeps the old one
+	restarted := newScheduler(nil)
+	funcAssert.NoError(restarted.scan(ctx))
+	funcAssert.Equal([]string{"newkey2"}, deleted)
+	funcAssert.Equal(models.ServiceAccountNotificationKindRotationFailed, store.state.Notifications[len(store.state.Notifications)-1].Kind)
+	funcAssert.NoError(restarted.scan(ctx))
+	funcAssert.Equal(3, created)
+	funcAssert.Equal([]string{"newkey2"}, deleted)
+
+	// Test-4: the webhook getting the secret key delivers it
+	accounts["bob"] = []madmin.ServiceAccountInfo{{AccessKey: "oldkey", ParentUser: "bob"}}
+	store.state = nil
+	deleted = nil
+	scheduler = newScheduler(func(_ context.Context, _ *models.ServiceAccountNotification) error { return nil })
+	funcAssert.NoError(scheduler.scan(ctx))
+	now = now.Add(2 * time.Hour)
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.True(store.state.Rotations[0].Delivered)
+	now = now.Add(2 * time.Hour)
+	funcAssert.NoError(scheduler.scan(ctx))
+	funcAssert.Equal([]string{"oldkey"}, deleted)
+	funcAssert.Empty(store.state.Rotations)
+}
@@ -28,6 +28,14 @@ func registerServiceAccountsHandlers(api *operations.ConsoleAPI) {
 		}
 		return saApi.NewCreateServiceAccountCreated().WithPayload(creds)
 	})
+	// List the expiry and rotation notifications
+	api.ServiceAccountListServiceAccountNotificationsHandler = saApi.ListServiceAccountNotificationsHandlerFunc(func(params saApi.ListServiceAccountNotificationsParams, session *models.Principal) middleware.Responder {
+		notifications, err := getListServiceAccountNotificationsResponse(session, params)
+		if err != nil {
+			return saApi.NewListServiceAccountNotificationsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return saApi.NewListServiceAccountNotificationsOK().WithPayload(notifications)
+	})
 	// Create User Service Account
 	api.UserCreateAUserServiceAccountHandler = userApi.CreateAUserServiceAccountHandlerFunc(func(params userApi.CreateAUserServiceAccountParams, session *models.Principal) middleware.Responder {
 		creds, err := getCreateAUserServiceAccountResponse(session, params)
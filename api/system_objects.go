@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/openstor/console/pkg/auth/token"
+	"github.com/openstor/console/pkg/auth/utils"
+	"github.com/openstor/openstor-go/v7"
+	"github.com/openstor/pkg/v3/env"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// systemObjectSignatureMeta is the user metadata of the signed objects of the system bucket holding their signature
+const systemObjectSignatureMeta = "Console-Signature"
+
+// Errors reading and writing the signed objects of the system bucket
+var (
+	// errSystemObjectSignature is returned for an object written by someone else than a console
+	errSystemObjectSignature = errors.New("the signature of the object is invalid, it was not written by the console")
+	// errSystemObjectChanged is returned when the object changed since it was read
+	errSystemObjectChanged = errors.New("the object was changed by another console")
+	// errSystemObjectKeyNotSet is returned when the key signing the objects is the random one of this console,
+	// the objects it signs can't be read after a restart or by the other replicas
+	errSystemObjectKeyNotSet = fmt.Errorf("the objects of the system bucket can't be signed, %s and %s aren't set",
+		token.ConsolePBKDFPassphrase, token.ConsolePBKDFSalt)
+)
+
+// systemObjectKeyConfigured returns whether the console secret the objects of the system bucket are signed with is
+// set, it is random otherwise
+func systemObjectKeyConfigured() bool {
+	return env.Get(token.ConsolePBKDFPassphrase, "") != "" && env.Get(token.ConsolePBKDFSalt, "") != ""
+}
+
+// systemObjectKey is the key signing the objects of the system bucket. It is derived from the console secret
+// CONSOLE_PBKDF_PASSPHRASE and CONSOLE_PBKDF_SALT, so users allowed to write the system bucket can't forge them.
+var systemObjectKey = func() []byte {
+	return pbkdf2.Key([]byte(token.GetPBKDFPassphrase()), []byte("console-system-objects:"+token.GetPBKDFSalt()), 4096, 32, sha1.New)
+}
+
+func signSystemObject(data []byte) string {
+	return utils.ComputeHmac256(string(data), systemObjectKey())
+}
+
+func verifySystemObject(data []byte, signature string) bool {
+	return hmac.Equal([]byte(signSystemObject(data)), []byte(signature))
+}
+
+// getSignedSystemObject reads a signed object of the system bucket. A nil content and an empty ETag are returned
+// when the object or the bucket doesn't exist. The ETag is returned along with errSystemObjectSignature when the
+// signature is invalid, so the object can still be replaced.
+func getSignedSystemObject(ctx context.Context, client *openstor.Client, bucket, object string) ([]byte, string, error) {
+	if !systemObjectKeyConfigured() {
+		return nil, "", errSystemObjectKeyNotSet
+	}
+	reader, err := client.GetObject(ctx, bucket, object, openstor.GetObjectOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	defer reader.Close()
+	info, err := reader.Stat()
+	if err != nil {
+		switch openstor.ToErrorResponse(err).Code {
+		case "NoSuchBucket", "NoSuchKey":
+			return nil, "", nil
+		}
+		return nil, "", err
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, "", err
+	}
+	if !verifySystemObject(data, info.UserMetadata[systemObjectSignatureMeta]) {
+		return nil, info.ETag, errSystemObjectSignature
+	}
+	return data, info.ETag, nil
+}
+
+// systemObjectAnyVersion is the ETag replacing an object of the system bucket whatever its version
+const systemObjectAnyVersion = "*"
+
+// putSignedSystemObject signs and writes an object of the system bucket, creating the bucket when needed. The
+// object is only replaced when its ETag is still etag and only created when etag is empty, errSystemObjectChanged
+// is returned otherwise. systemObjectAnyVersion writes the object unconditionally. The ETag of the written object
+// is returned.
+func putSignedSystemObject(ctx context.Context, client *openstor.Client, bucket, object string, data []byte, etag string) (string, error) {
+	if !systemObjectKeyConfigured() {
+		return "", errSystemObjectKeyNotSet
+	}
+	exists, err := client.BucketExists(ctx, bucket)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, bucket, openstor.MakeBucketOptions{Region: GetMinIORegion()}); err != nil {
+			return "", err
+		}
+	}
+	opts := openstor.PutObjectOptions{
+		ContentType:  "application/json",
+		UserMetadata: map[string]string{systemObjectSignatureMeta: signSystemObject(data)},
+	}
+	switch etag {
+	case systemObjectAnyVersion:
+	case "":
+		opts.SetMatchETagExcept("*")
+	default:
+		opts.SetMatchETag(etag)
+	}
+	info, err := client.PutObject(ctx, bucket, object, bytes.NewReader(data), int64(len(data)), opts)
+	if err != nil {
+		if openstor.ToErrorResponse(err).Code == "PreconditionFailed" {
+			return "", errSystemObjectChanged
+		}
+		return "", err
+	}
+	return info.ETag, nil
+}
@@ -11,6 +11,8 @@ import (
 type ConsoleTransport struct {
 	Transport http.RoundTripper
 	ClientIP  string
+
+	tracing tracingTransport
 }
 
 func (t *ConsoleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -18,7 +20,8 @@ func (t *ConsoleTransport) RoundTrip(req *http.Request) (*http.Response, error)
 		// Do not set an empty x-forwarded-for
 		req.Header.Add(xForwardedFor, t.ClientIP)
 	}
-	return t.Transport.RoundTrip(req)
+	// trace the call and propagate the trace context to MinIO
+	return t.tracing.get(t.Transport).RoundTrip(req)
 }
 
 // PrepareSTSClientTransport :
@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openstor/console/pkg"
+	"github.com/openstor/pkg/v3/env"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Exporters of the spans
+const (
+	tracingExporterOTLP   = "otlp"
+	tracingExporterStdout = "stdout"
+	tracingExporterFile   = "file"
+)
+
+// tracingQueueSize is the number of spans waiting for the export before the newer ones are dropped
+const tracingQueueSize = 10000
+
+// tracingShutdownTimeout bounds the export of the remaining spans when the console stops
+const tracingShutdownTimeout = 10 * time.Second
+
+// otlpTracesPath is the path of the OTLP/HTTP traces endpoint
+const otlpTracesPath = "/v1/traces"
+
+// adminAPIPrefix is the path prefix of the MinIO admin API
+const adminAPIPrefix = "/minio/admin/"
+
+// tracerProvider records the spans of the console, it doesn't record anything when tracing is disabled
+var tracerProvider trace.TracerProvider = noop.NewTracerProvider()
+
+// tracingPropagator reads the W3C trace context of the callers and propagates it to MinIO
+var tracingPropagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// newTracingExporter returns the exporter configured by the environment, nil when tracing is disabled
+func newTracingExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	switch exporter := getTracingExporter(); exporter {
+	case "":
+		return nil, nil
+	case tracingExporterOTLP:
+		endpoint := strings.TrimSuffix(env.Get(ConsoleTracingOTLPEndpoint, ""), "/")
+		if endpoint == "" {
+			return nil, fmt.Errorf("%s is required by the %s exporter", ConsoleTracingOTLPEndpoint, exporter)
+		}
+		// `/v1/traces` is appended when the endpoint has no path
+		if !strings.HasSuffix(endpoint, otlpTracesPath) {
+			endpoint += otlpTracesPath
+		}
+		return otlptracehttp.New(ctx,
+			otlptracehttp.WithEndpointURL(endpoint),
+			otlptracehttp.WithHeaders(getTracingOTLPHeaders()),
+			otlptracehttp.WithHTTPClient(&http.Client{Transport: GlobalTransport}),
+		)
+	case tracingExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case tracingExporterFile:
+		path := env.Get(ConsoleTracingFile, "")
+		if path == "" {
+			return nil, fmt.Errorf("%s is required by the %s exporter", ConsoleTracingFile, exporter)
+		}
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &fileSpanExporter{SpanExporter: exporter, f: f}, nil
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %s", exporter)
+	}
+}
+
+// fileSpanExporter closes the file the spans are appended to along with the exporter
+type fileSpanExporter struct {
+	sdktrace.SpanExporter
+	f *os.File
+}
+
+func (e *fileSpanExporter) Shutdown(ctx context.Context) error {
+	return errors.Join(e.SpanExporter.Shutdown(ctx), e.f.Close())
+}
+
+// newTracingResource describes the console in the exported spans: its service name and version, its host, the
+// attributes of OTEL_RESOURCE_ATTRIBUTES and the ones of CONSOLE_TRACING_RESOURCE_ATTRIBUTES, the latter winning
+func newTracingResource(ctx context.Context) (*resource.Resource, error) {
+	var attributes []attribute.KeyValue
+	for key, value := range getTracingResourceAttributes() {
+		attributes = append(attributes, attribute.String(key, value))
+	}
+	res, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+		resource.WithAttributes(
+			semconv.ServiceName(env.Get(ConsoleTracingServiceName, "console")),
+			semconv.ServiceVersion(pkg.Version),
+		),
+		resource.WithFromEnv(),
+		resource.WithAttributes(attributes...),
+	)
+	// the resource is still usable when some of its attributes couldn't be detected
+	if err != nil && !errors.Is(err, resource.ErrPartialResource) {
+		return nil, err
+	}
+	return res, nil
+}
+
+// newTracingSampler samples the ratio of the traces started by the console configured by the environment and
+// follows the sampling decision of the callers for the traces they started
+func newTracingSampler() (sdktrace.Sampler, error) {
+	ratio, err := getTracingSampleRatio()
+	if err != nil {
+		return nil, err
+	}
+	return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio)), nil
+}
+
+// newTracerProvider returns the provider exporting the spans as configured by the environment, nil when tracing
+// is disabled
+func newTracerProvider(ctx context.Context) (*sdktrace.TracerProvider, error) {
+	sampler, err := newTracingSampler()
+	if err != nil {
+		return nil, err
+	}
+	res, err := newTracingResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exporter, err := newTracingExporter(ctx)
+	if err != nil || exporter == nil {
+		return nil, err
+	}
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter, sdktrace.WithMaxQueueSize(tracingQueueSize)),
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithResource(res),
+	), nil
+}
+
+// startTracing starts exporting the spans when an exporter is configured and returns the function flushing
+// the remaining spans
+func startTracing() func() {
+	if getTracingExporter() == "" {
+		return func() {}
+	}
+	provider, err := newTracerProvider(context.Background())
+	if err != nil {
+		LogError("unable to start tracing: %v", err)
+		return func() {}
+	}
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		LogError("tracing: %v", err)
+	}))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(tracingPropagator)
+	tracerProvider = provider
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		if err := provider.Shutdown(ctx); err != nil {
+			LogError("unable to close the tracing exporter: %v", err)
+		}
+	}
+}
+
+// TracingMiddleware records a span for every request, continuing the trace of the caller when it sends a
+// traceparent header
+func TracingMiddleware(next http.Handler) http.Handler {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		trace.SpanFromContext(r.Context()).SetAttributes(semconv.ClientAddress(getClientIP(r)))
+		next.ServeHTTP(w, r)
+	})
+	return otelhttp.NewHandler(handler, "console",
+		otelhttp.WithTracerProvider(tracerProvider),
+		otelhttp.WithPropagators(tracingPropagator),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+	)
+}
+
+// TraceOperationMiddleware names the span of the request after the matched swagger operation, it runs after
+// routing
+func TraceOperationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(route.Operation.ID)
+			span.SetAttributes(semconv.HTTPRoute(route.PathPattern))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// backendSpanName names the span of a call to MinIO, the admin API calls are named after their admin operation
+// and the S3 and STS ones after their method
+func backendSpanName(_ string, req *http.Request) string {
+	if !strings.HasPrefix(req.URL.Path, adminAPIPrefix) {
+		return "S3 " + req.Method
+	}
+	// /minio/admin/v3/<operation>
+	operation := strings.TrimPrefix(req.URL.Path, adminAPIPrefix)
+	if _, after, ok := strings.Cut(operation, "/"); ok {
+		operation = after
+	}
+	return "admin " + operation
+}
+
+// tracingTransport records the spans of the calls made through transport and propagates the trace context to
+// MinIO, it is created on the first call so it uses the tracer provider started with the console
+type tracingTransport struct {
+	once      sync.Once
+	transport http.RoundTripper
+}
+
+func (t *tracingTransport) get(transport http.RoundTripper) http.RoundTripper {
+	t.once.Do(func() {
+		t.transport = otelhttp.NewTransport(transport,
+			otelhttp.WithTracerProvider(tracerProvider),
+			otelhttp.WithPropagators(tracingPropagator),
+			otelhttp.WithSpanNameFormatter(backendSpanName),
+		)
+	})
+	return t.transport
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestTracingMiddleware(t *testing.T) {
+	funcAssert := assert.New(t)
+	var backendTraceParent string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		backendTraceParent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer backend.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	tracerProvider = provider
+	defer func() {
+		tracerProvider = noop.NewTracerProvider()
+	}()
+
+	handler := TracingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, backend.URL+"/minio/admin/v3/list-users", nil)
+		funcAssert.NoError(err)
+		client := &http.Client{Transport: &ConsoleTransport{Transport: http.DefaultTransport}}
+		resp, err := client.Do(req)
+		funcAssert.NoError(err)
+		resp.Body.Close()
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	funcAssert.NoError(provider.ForceFlush(context.Background()))
+
+	// the backend call continues the trace of the caller
+	carrier := propagation.MapCarrier{"traceparent": backendTraceParent}
+	sc := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+	funcAssert.True(sc.IsValid())
+	funcAssert.Equal("4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID().String())
+
+	spans := exporter.GetSpans()
+	if funcAssert.Len(spans, 2) {
+		// the backend span ends first
+		funcAssert.Equal("admin list-users", spans[0].Name)
+		funcAssert.Equal(trace.SpanKindClient, spans[0].SpanKind)
+		funcAssert.Equal(sc.SpanID(), spans[0].SpanContext.SpanID())
+		funcAssert.Equal(spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+		funcAssert.Equal("GET /api/v1/users", spans[1].Name)
+		funcAssert.Equal(trace.SpanKindServer, spans[1].SpanKind)
+		funcAssert.Equal("00f067aa0ba902b7", spans[1].Parent.SpanID().String())
+		funcAssert.Equal(codes.Error, spans[1].Status.Code)
+		funcAssert.Contains(spans[1].Attributes, attribute.String("client.address", "192.0.2.1"))
+	}
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	funcAssert := assert.New(t)
+	ctx := context.Background()
+
+	// Test-1: tracing is disabled without an exporter
+	provider, err := newTracerProvider(ctx)
+	funcAssert.NoError(err)
+	funcAssert.Nil(provider)
+
+	// Test-2: the resource describes the console with the configured attributes
+	t.Setenv(ConsoleTracingServiceName, "console-eu")
+	t.Setenv(ConsoleTracingResourceAttributes, "deployment.environment=production, team = storage")
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "team=platform,region=eu-west")
+	res, err := newTracingResource(ctx)
+	funcAssert.NoError(err)
+	funcAssert.Contains(res.Attributes(), attribute.String("service.name", "console-eu"))
+	funcAssert.Contains(res.Attributes(), attribute.String("deployment.environment", "production"))
+	funcAssert.Contains(res.Attributes(), attribute.String("team", "storage"))
+	funcAssert.Contains(res.Attributes(), attribute.String("region", "eu-west"))
+
+	// Test-3: the sample ratio is validated and the traces of the callers follow their sampling decision
+	t.Setenv(ConsoleTracingSampleRatio, "2")
+	_, err = newTracingSampler()
+	funcAssert.Error(err)
+	t.Setenv(ConsoleTracingSampleRatio, "0")
+	sampler, err := newTracingSampler()
+	funcAssert.NoError(err)
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	result := sampler.ShouldSample(sdktrace.SamplingParameters{ParentContext: ctx, TraceID: traceID})
+	funcAssert.Equal(sdktrace.Drop, result.Decision)
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	parent := trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled, Remote: true,
+	}))
+	result = sampler.ShouldSample(sdktrace.SamplingParameters{ParentContext: parent, TraceID: traceID})
+	funcAssert.Equal(sdktrace.RecordAndSample, result.Decision)
+
+	// Test-4: the exporters are configured by the environment
+	t.Setenv(ConsoleTracingSampleRatio, "1")
+	t.Setenv(ConsoleTracingExporter, tracingExporterOTLP)
+	_, err = newTracerProvider(ctx)
+	funcAssert.Error(err)
+	t.Setenv(ConsoleTracingExporter, tracingExporterFile)
+	t.Setenv(ConsoleTracingFile, t.TempDir()+"/spans.json")
+	provider, err = newTracerProvider(ctx)
+	funcAssert.NoError(err)
+	funcAssert.NoError(provider.Shutdown(ctx))
+	t.Setenv(ConsoleTracingExporter, "jaeger")
+	_, err = newTracerProvider(ctx)
+	funcAssert.Error(err)
+}
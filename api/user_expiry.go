@@ -0,0 +1,373 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	userApi "github.com/openstor/console/api/operations/user"
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	"github.com/openstor/openstor-go/v7"
+)
+
+const (
+	// userExpiryObject is the object of the system bucket keeping the expiry of the users
+	userExpiryObject = "users/expiry.json"
+	// userExpiryRetries bounds the attempts to change the expiry of the users changed concurrently by another console
+	userExpiryRetries = 3
+)
+
+// userExpiry is when a temporary user is disabled and, optionally, deleted
+type userExpiry struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+	// DeleteAt is zero when the user is kept once disabled
+	DeleteAt time.Time `json:"deleteAt"`
+	// Disabled is set once the reaper disabled the user, so it isn't disabled again if an admin enables it
+	Disabled bool `json:"disabled"`
+}
+
+type userExpiryState struct {
+	// Generation is incremented by every change, it is signed along with the expiry so an older copy of it can be
+	// told apart
+	Generation int64                  `json:"generation"`
+	Users      map[string]*userExpiry `json:"users"`
+}
+
+// Errors reading an expiry of the users older than the one a console already read or wrote
+var (
+	errUserExpiryMissing  = errors.New("the users expiry was deleted from the system bucket")
+	errUserExpiryReplayed = errors.New("the users expiry of the system bucket was replaced with an older copy")
+)
+
+// globalUserExpiryGenerations is the highest generation of the users expiry this console read or wrote
+var globalUserExpiryGenerations = &userExpiryGenerations{}
+
+// userExpiryGenerations tracks the highest generation of the users expiry a console read or wrote, to reject the
+// older signed copies written back to the system bucket and the deletion of the object
+type userExpiryGenerations struct {
+	mu     sync.Mutex
+	latest int64
+}
+
+// check returns an error when the expiry read is older than the last one seen, or missing once one was seen
+func (g *userExpiryGenerations) check(state *userExpiryState, exists bool) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	switch {
+	case !exists && g.latest > 0:
+		return errUserExpiryMissing
+	case state.Generation < g.latest:
+		return errUserExpiryReplayed
+	}
+	g.latest = state.Generation
+	return nil
+}
+
+// saved records the generation written by this console
+func (g *userExpiryGenerations) saved(generation int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if generation > g.latest {
+		g.latest = generation
+	}
+}
+
+// userExpiryStore persists the expiry of the users
+type userExpiryStore interface {
+	// load returns the expiry of the users along with the ETag to change it
+	load(ctx context.Context) (*userExpiryState, string, error)
+	// save replaces the expiry of the users unless it changed since it was loaded
+	save(ctx context.Context, state *userExpiryState, etag string) error
+}
+
+// bucketUserExpiryStore keeps the expiry of the users as a signed JSON object in the system bucket, so the users
+// allowed to write the bucket, temporary ones included, can't forge their expiry. They can still delete the object
+// or write back an older signed copy of it, a console rejects them once it read or wrote a newer generation, but
+// a console started after such a change can't tell.
+type bucketUserExpiryStore struct {
+	client      *openstor.Client
+	bucket      string
+	generations *userExpiryGenerations
+}
+
+func newUserExpiryStore(session *models.Principal, clientIP string) (userExpiryStore, error) {
+	mClient, err := newMinioClient(session, clientIP)
+	if err != nil {
+		return nil, err
+	}
+	return &bucketUserExpiryStore{client: mClient, bucket: getSystemBucket(), generations: globalUserExpiryGenerations}, nil
+}
+
+// load fails when the object wasn't written by a console or is older than the last one read, the expiry it holds
+// can't be trusted and dropping it would let the temporary users live forever
+func (s *bucketUserExpiryStore) load(ctx context.Context) (*userExpiryState, string, error) {
+	state := &userExpiryState{}
+	data, etag, err := getSignedSystemObject(ctx, s.client, s.bucket, userExpiryObject)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to read the users expiry: %w", err)
+	}
+	if data != nil {
+		if err := json.Unmarshal(data, state); err != nil {
+			return nil, "", fmt.Errorf("unable to read the users expiry: %w", err)
+		}
+	}
+	if err := s.generations.check(state, data != nil); err != nil {
+		return nil, "", fmt.Errorf("unable to read the users expiry: %w", err)
+	}
+	// no user has an expiry yet
+	if state.Users == nil {
+		state.Users = map[string]*userExpiry{}
+	}
+	return state, etag, nil
+}
+
+func (s *bucketUserExpiryStore) save(ctx context.Context, state *userExpiryState, etag string) error {
+	state.Generation++
+	rawState, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if _, err = putSignedSystemObject(ctx, s.client, s.bucket, userExpiryObject, rawState, etag); err != nil {
+		return err
+	}
+	s.generations.saved(state.Generation)
+	return nil
+}
+
+// updateUserExpiryState applies a change to the expiry of the users and saves it when the change reports it
+// changed something. The change is retried when another console changed the expiry since it was loaded.
+func updateUserExpiryState(ctx context.Context, store userExpiryStore, change func(state *userExpiryState) bool) error {
+	for attempt := 0; ; attempt++ {
+		state, etag, err := store.load(ctx)
+		if err != nil {
+			return err
+		}
+		if !change(state) {
+			return nil
+		}
+		err = store.save(ctx, state, etag)
+		if errors.Is(err, errSystemObjectChanged) && attempt < userExpiryRetries {
+			continue
+		}
+		return err
+	}
+}
+
+// parseUserExpiry validates the expiry date and retention of a user, a zero expiry means the user doesn't expire
+func parseUserExpiry(expiresAt, retention string, now time.Time) (*userExpiry, error) {
+	if expiresAt == "" {
+		if retention != "" {
+			return nil, errors.New("a retention requires an expiry date")
+		}
+		return nil, nil
+	}
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expiry date %s, it must be a RFC 3339 date", expiresAt)
+	}
+	if !expiry.After(now) {
+		return nil, errors.New("the expiry date must be in the future")
+	}
+	userExp := &userExpiry{ExpiresAt: expiry.UTC()}
+	if retention != "" {
+		duration, err := time.ParseDuration(retention)
+		if err != nil || duration < 0 {
+			return nil, fmt.Errorf("invalid retention %s", retention)
+		}
+		userExp.DeleteAt = userExp.ExpiresAt.Add(duration)
+	}
+	return userExp, nil
+}
+
+// setUserExpiry saves the expiry of a user, a nil expiry clears it
+func setUserExpiry(ctx context.Context, store userExpiryStore, accessKey string, expiry *userExpiry) error {
+	return updateUserExpiryState(ctx, store, func(state *userExpiryState) bool {
+		if expiry == nil {
+			if _, ok := state.Users[accessKey]; !ok {
+				return false
+			}
+			delete(state.Users, accessKey)
+			return true
+		}
+		state.Users[accessKey] = expiry
+		return true
+	})
+}
+
+// getUserExpiry returns the expiry of a user, nil when it doesn't expire
+func getUserExpiry(ctx context.Context, store userExpiryStore, accessKey string) (*userExpiry, error) {
+	state, _, err := store.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return state.Users[accessKey], nil
+}
+
+// addUserExpiry sets the expiry dates of a user to its output
+func addUserExpiry(user *models.User, expiry *userExpiry) {
+	if expiry == nil {
+		return
+	}
+	user.ExpiresAt = expiry.ExpiresAt.Format(time.RFC3339)
+	if !expiry.DeleteAt.IsZero() {
+		user.DeleteAt = expiry.DeleteAt.Format(time.RFC3339)
+	}
+}
+
+// getUpdateUserExpiryResponse validates the expiry and performs setUserExpiry()
+func getUpdateUserExpiryResponse(session *models.Principal, params userApi.UpdateUserExpiryParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body == nil {
+		return ErrorWithContext(ctx, ErrBadRequest, errors.New("an expiry is required"))
+	}
+	expiry, err := parseUserExpiry(params.Body.ExpiresAt, params.Body.Retention, time.Now())
+	if err != nil {
+		return ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	if _, err := getUserInfo(ctx, adminClient, params.Name); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	store, err := newUserExpiryStore(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if err := setUserExpiry(ctx, store, params.Name, expiry); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// userExpiryReaper disables the users once they expire and deletes them once their retention is over. The
+// expiry is kept in the system bucket, so the users that expired while the console was down are handled on
+// the first check after it starts. Only the leader reaps the users.
+type userExpiryReaper struct {
+	client   MinioAdmin
+	store    userExpiryStore
+	interval time.Duration
+	// isLeader tells whether this console reaps the users, nil when it is the only console
+	isLeader func() bool
+	now      func() time.Time
+}
+
+// startUserExpiryReaper starts the reaper when it is enabled and the background credentials are set, it returns
+// the function stopping it
+func startUserExpiryReaper() func() {
+	background := globalBackgroundService
+	if background == nil || !getUserReaperEnabled() {
+		return func() {}
+	}
+	if !systemObjectKeyConfigured() {
+		LogError("expired users reaper: %v", errSystemObjectKeyNotSet)
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	interval := getUserReaperInterval()
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	reaper := &userExpiryReaper{
+		client:   background.admin,
+		store:    &bucketUserExpiryStore{client: background.client, bucket: getSystemBucket(), generations: globalUserExpiryGenerations},
+		interval: interval,
+		isLeader: background.isLeader,
+		now:      time.Now,
+	}
+	go reaper.run(ctx)
+	return cancel
+}
+
+func (r *userExpiryReaper) run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		if err := r.reap(ctx); err != nil && ctx.Err() == nil {
+			LogError("expired users reaper: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reap disables the expired users and deletes the ones past their retention. Users that no longer exist are
+// forgotten. A failure on a user doesn't stop the others, it is retried on the next check. The outcome is saved
+// on top of the changes done meanwhile by the other consoles.
+func (r *userExpiryReaper) reap(ctx context.Context) error {
+	if r.isLeader != nil && !r.isLeader() {
+		return nil
+	}
+	state, _, err := r.store.load(ctx)
+	if err != nil {
+		return err
+	}
+	if len(state.Users) == 0 {
+		return nil
+	}
+	users, err := r.client.listUsers(ctx)
+	if err != nil {
+		return err
+	}
+	now := r.now()
+	forgotten := map[string]bool{}
+	disabled := map[string]*userExpiry{}
+	for _, accessKey := range sortedKeys(state.Users) {
+		expiry := state.Users[accessKey]
+		if _, ok := users[accessKey]; !ok {
+			forgotten[accessKey] = true
+			continue
+		}
+		if !expiry.DeleteAt.IsZero() && !now.Before(expiry.DeleteAt) {
+			if err := removeUser(ctx, r.client, accessKey); err != nil {
+				LogError("unable to delete the expired user %s: %v", accessKey, err)
+				continue
+			}
+			forgotten[accessKey] = true
+			continue
+		}
+		if !expiry.Disabled && !now.Before(expiry.ExpiresAt) {
+			if err := r.client.setUserStatus(ctx, accessKey, madmin.AccountDisabled); err != nil {
+				LogError("unable to disable the expired user %s: %v", accessKey, err)
+				continue
+			}
+			disabled[accessKey] = expiry
+		}
+	}
+	if len(forgotten) == 0 && len(disabled) == 0 {
+		return nil
+	}
+	return updateUserExpiryState(ctx, r.store, func(state *userExpiryState) bool {
+		changed := false
+		for accessKey := range forgotten {
+			if _, ok := state.Users[accessKey]; ok {
+				delete(state.Users, accessKey)
+				changed = true
+			}
+		}
+		// an expiry changed meanwhile is left for the next check
+		for accessKey, expiry := range disabled {
+			if current, ok := state.Users[accessKey]; ok && current.ExpiresAt.Equal(expiry.ExpiresAt) && !current.Disabled {
+				current.Disabled = true
+				changed = true
+			}
+		}
+		return changed
+	})
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openstor/console/models"
+	"github.com/openstor/madmin-go/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryUserExpiryStore keeps the expiry of the users serialized in memory for tests and saves it conditionally
+// on its ETag, as the system bucket does
+type memoryUserExpiryStore struct {
+	state *userExpiryState
+	saves int
+	// changed is called before the expiry is saved, to change it concurrently
+	changed func()
+}
+
+func (s *memoryUserExpiryStore) load(_ context.Context) (*userExpiryState, string, error) {
+	state := &userExpiryState{Users: map[string]*userExpiry{}}
+	if s.state == nil {
+		return state, "", nil
+	}
+	raw, err := json.Marshal(s.state)
+	if err != nil {
+		return nil, "", err
+	}
+	err = json.Unmarshal(raw, state)
+	return state, fmt.Sprintf("etag-%d", s.saves), err
+}
+
+func (s *memoryUserExpiryStore) save(_ context.Context, state *userExpiryState, etag string) error {
+	if s.changed != nil {
+		changed := s.changed
+		s.changed = nil
+		changed()
+	}
+	current := ""
+	if s.state != nil {
+		current = fmt.Sprintf("etag-%d", s.saves)
+	}
+	if etag != current {
+		return errSystemObjectChanged
+	}
+	s.state = state
+	s.saves++
+	return nil
+}
+
+func TestParseUserExpiry(t *testing.T) {
+	funcAssert := assert.New(t)
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	expiry, err := parseUserExpiry("2025-07-01T00:00:00+02:00", "720h", now)
+	funcAssert.NoError(err)
+	funcAssert.Equal(time.Date(2025, 6, 30, 22, 0, 0, 0, time.UTC), expiry.ExpiresAt)
+	funcAssert.Equal(time.Date(2025, 7, 30, 22, 0, 0, 0, time.UTC), expiry.DeleteAt)
+
+	expiry, err = parseUserExpiry("2025-07-01T00:00:00Z", "", now)
+	funcAssert.NoError(err)
+	funcAssert.True(expiry.DeleteAt.IsZero())
+
+	expiry, err = parseUserExpiry("", "", now)
+	funcAssert.NoError(err)
+	funcAssert.Nil(expiry)
+
+	_, err = parseUserExpiry("", "720h", now)
+	funcAssert.Error(err)
+	_, err = parseUserExpiry("2025-05-01T00:00:00Z", "", now)
+	funcAssert.Error(err)
+	_, err = parseUserExpiry("tomorrow", "", now)
+	funcAssert.Error(err)
+	_, err = parseUserExpiry("2025-07-01T00:00:00Z", "a month", now)
+	funcAssert.Error(err)
+}
+
+func TestUserExpiryReaper(t *testing.T) {
+	funcAssert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	store := &memoryUserExpiryStore{}
+
+	// Test-1: expiries are saved, cleared, and added to the user info
+	funcAssert.NoError(setUserExpiry(ctx, store, "auditor", &userExpiry{ExpiresAt: now.Add(time.Hour), DeleteAt: now.Add(48 * time.Hour)}))
+	funcAssert.NoError(setUserExpiry(ctx, store, "contractor", &userExpiry{ExpiresAt: now.Add(time.Hour)}))
+	funcAssert.NoError(setUserExpiry(ctx, store, "gone", &userExpiry{ExpiresAt: now.Add(time.Hour)}))
+	funcAssert.NoError(setUserExpiry(ctx, store, "intern", &userExpiry{ExpiresAt: now.Add(time.Hour)}))
+	funcAssert.NoError(setUserExpiry(ctx, store, "intern", nil))
+	expiry, err := getUserExpiry(ctx, store, "auditor")
+	funcAssert.NoError(err)
+	user := &models.User{AccessKey: "auditor"}
+	addUserExpiry(user, expiry)
+	funcAssert.Equal("2025-06-01T01:00:00Z", user.ExpiresAt)
+	funcAssert.Equal("2025-06-03T00:00:00Z", user.DeleteAt)
+
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"auditor": {}, "contractor": {}}, nil
+	}
+	var disabled, removed []string
+	minioSetUserStatusMock = func(accessKey string, status madmin.AccountStatus) error {
+		funcAssert.Equal(madmin.AccountDisabled, status)
+		disabled = append(disabled, accessKey)
+		return nil
+	}
+	minioRemoveUserMock = func(accessKey string) error {
+		removed = append(removed, accessKey)
+		return nil
+	}
+	reaper := &userExpiryReaper{client: AdminClientMock{}, store: store, now: func() time.Time { return now }}
+
+	// Test-2: users that no longer exist are forgotten, nothing else happens before the expiry
+	saves := store.saves
+	funcAssert.NoError(reaper.reap(ctx))
+	funcAssert.Empty(disabled)
+	funcAssert.NotContains(store.state.Users, "gone")
+	funcAssert.Equal(saves+1, store.saves)
+
+	// Test-3: expired users are disabled once
+	now = now.Add(2 * time.Hour)
+	funcAssert.NoError(reaper.reap(ctx))
+	funcAssert.NoError(reaper.reap(ctx))
+	funcAssert.Equal([]string{"auditor", "contractor"}, disabled)
+
+	// Test-4: users are deleted after their retention, the others are kept disabled
+	now = now.Add(48 * time.Hour)
+	funcAssert.NoError(reaper.reap(ctx))
+	funcAssert.Equal([]string{"auditor"}, removed)
+	funcAssert.NotContains(store.state.Users, "auditor")
+	funcAssert.Contains(store.state.Users, "contractor")
+
+	// Test-5: failures are retried on the next check
+	funcAssert.NoError(setUserExpiry(ctx, store, "contractor", &userExpiry{ExpiresAt: now.Add(-time.Minute), DeleteAt: now.Add(-time.Minute)}))
+	minioRemoveUserMock = func(_ string) error {
+		return errors.New("error")
+	}
+	funcAssert.NoError(reaper.reap(ctx))
+	funcAssert.Contains(store.state.Users, "contractor")
+
+	// Test-6: the expiry set by another console while the reaper runs is kept
+	minioRemoveUserMock = func(accessKey string) error {
+		removed = append(removed, accessKey)
+		return nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"contractor": {}, "temp": {}}, nil
+	}
+	store.changed = func() {
+		funcAssert.NoError(setUserExpiry(ctx, store, "temp", &userExpiry{ExpiresAt: now.Add(time.Hour)}))
+	}
+	funcAssert.NoError(reaper.reap(ctx))
+	funcAssert.NotContains(store.state.Users, "contractor")
+	funcAssert.Contains(store.state.Users, "temp")
+
+	// Test-7: a console that isn't the leader doesn't reap the users
+	now = now.Add(2 * time.Hour)
+	disabled = nil
+	reaper.isLeader = func() bool { return false }
+	funcAssert.NoError(reaper.reap(ctx))
+	funcAssert.Empty(disabled)
+	funcAssert.False(store.state.Users["temp"].Disabled)
+	reaper.isLeader = func() bool { return true }
+	funcAssert.NoError(reaper.reap(ctx))
+	funcAssert.Equal([]string{"temp"}, disabled)
+	funcAssert.True(store.state.Users["temp"].Disabled)
+}
+
+func TestUserExpiryGenerations(t *testing.T) {
+	funcAssert := assert.New(t)
+	generations := &userExpiryGenerations{}
+
+	// Test-1: a missing expiry is empty until one is read or written
+	funcAssert.NoError(generations.check(&userExpiryState{}, false))
+	funcAssert.NoError(generations.check(&userExpiryState{Generation: 2}, true))
+	funcAssert.NoError(generations.check(&userExpiryState{Generation: 2}, true))
+
+	// Test-2: the deletion of the expiry and an older copy of it are rejected
+	funcAssert.ErrorIs(generations.check(&userExpiryState{}, false), errUserExpiryMissing)
+	funcAssert.ErrorIs(generations.check(&userExpiryState{Generation: 1}, true), errUserExpiryReplayed)
+
+	// Test-3: the generations written by the console are tracked
+	generations.saved(3)
+	funcAssert.ErrorIs(generations.check(&userExpiryState{Generation: 2}, true), errUserExpiryReplayed)
+	funcAssert.NoError(generations.check(&userExpiryState{Generation: 4}, true))
+	generations.saved(1)
+	funcAssert.ErrorIs(generations.check(&userExpiryState{Generation: 3}, true), errUserExpiryReplayed)
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/openstor/console/api/operations"
 	logApi "github.com/openstor/console/api/operations/logging"
 	"github.com/openstor/console/models"
@@ -28,7 +29,7 @@ func registerLogSearchHandlers(api *operations.ConsoleAPI) {
 	})
 }
 
-// getLogSearchResponse performs a query to Log Search if Enabled
+// getLogSearchResponse performs a query to Log Search if Enabled, or to the embedded log search
 func getLogSearchResponse(session *models.Principal, params logApi.LogSearchParams) (*models.LogSearchResponse, *CodedAPIError) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
@@ -56,6 +57,24 @@ func getLogSearchResponse(session *models.Principal, params logApi.LogSearchPara
 		}
 	}
 
+	// without a Log Search API, the queries are answered by the embedded log search when enabled
+	if getLogSearchURL() == "" && globalLogSearchStore != nil {
+		query, errQuery := newLogSearchQuery(params.Fp, *params.Order, swag.StringValue(params.TimeStart),
+			swag.StringValue(params.TimeEnd), *params.PageSize, *params.PageNo)
+		if errQuery != nil {
+			return nil, &CodedAPIError{
+				Code: 400,
+				APIError: &models.APIError{
+					Message:         ErrBadRequest.Error(),
+					DetailedMessage: errQuery.Error(),
+				},
+			}
+		}
+		return &models.LogSearchResponse{
+			Results: globalLogSearchStore.Search(query),
+		}, nil
+	}
+
 	token := getLogSearchAPIToken()
 	endpoint := fmt.Sprintf("%s/api/query?token=%s&q=reqinfo", getLogSearchURL(), token)
 	for _, fp := range params.Fp {
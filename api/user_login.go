@@ -37,6 +37,7 @@ func registerLoginHandlers(api *operations.ConsoleAPI) {
 	// POST login using user credentials
 	api.AuthLoginHandler = authApi.LoginHandlerFunc(func(params authApi.LoginParams) middleware.Responder {
 		loginResponse, err := getLoginResponse(params)
+		recordLogin(metricsLoginCredentials, err)
 		if err != nil {
 			return authApi.NewLoginDefault(err.Code).WithPayload(err.APIError)
 		}
@@ -50,6 +51,7 @@ func registerLoginHandlers(api *operations.ConsoleAPI) {
 	// POST login using external IDP
 	api.AuthLoginOauth2AuthHandler = authApi.LoginOauth2AuthHandlerFunc(func(params authApi.LoginOauth2AuthParams) middleware.Responder {
 		loginResponse, err := getLoginOauth2AuthResponse(params, GlobalMinIOConfig.OpenIDProviders)
+		recordLogin(metricsLoginIDP, err)
 		if err != nil {
 			return authApi.NewLoginOauth2AuthDefault(err.Code).WithPayload(err.APIError)
 		}
@@ -57,14 +59,8 @@ func registerLoginHandlers(api *operations.ConsoleAPI) {
 		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
 			cookie := NewSessionCookieForConsole(loginResponse.SessionID)
 			http.SetCookie(w, &cookie)
-			http.SetCookie(w, &http.Cookie{
-				Path:     "/",
-				Name:     "idp-refresh-token",
-				Value:    loginResponse.IDPRefreshToken,
-				HttpOnly: true,
-				Secure:   len(GlobalPublicCerts) > 0,
-				SameSite: http.SameSiteLaxMode,
-			})
+			refreshCookie := NewIDPRefreshTokenCookie(loginResponse.IDPRefreshToken)
+			http.SetCookie(w, &refreshCookie)
 			authApi.NewLoginOauth2AuthNoContent().WriteResponse(w, p)
 		})
 	})
@@ -291,13 +287,19 @@ func getLoginOauth2AuthResponse(params authApi.LoginOauth2AuthParams, openIDProv
 		if err != nil {
 			return nil, ErrorWithContext(ctx, err)
 		}
-		// initialize admin client
-		// login user against console and generate session token
-		token, err := login(&ConsoleCredentials{
+		consoleCreds := &ConsoleCredentials{
 			ConsoleCredentials: userCredentials,
 			AccountAccessKey:   "",
 			CredContext:        &credentials.CredContext{Client: client},
-		}, nil)
+		}
+		// retrieve the credentials first so the features mapped from the id_token claims
+		// are known before generating the session token
+		if _, err = consoleCreds.Get(); err != nil {
+			return nil, ErrorWithContext(ctx, err)
+		}
+		// initialize admin client
+		// login user against console and generate session token
+		token, err := login(consoleCreds, getIDPSessionFeatures(IDPName, identityProvider.Client.Features))
 		if err != nil {
 			return nil, ErrorWithContext(ctx, err)
 		}
@@ -310,3 +312,22 @@ func getLoginOauth2AuthResponse(params authApi.LoginOauth2AuthParams, openIDProv
 	}
 	return nil, ErrorWithContext(ctx, ErrDefault)
 }
+
+// getIDPSessionFeatures returns the features of a session created with the IDP, based on the
+// feature rules matching the id_token claims
+func getIDPSessionFeatures(idpName string, features *oauth2.Features) *auth.SessionFeatures {
+	sf := &auth.SessionFeatures{IDPName: idpName}
+	if features == nil {
+		return sf
+	}
+	sf.HideMenu = features.HideMenu
+	sf.ObjectBrowser = features.ObjectBrowser
+	if features.CustomStyleOB != "" {
+		if err := ValidateEncodedStyles(features.CustomStyleOB); err != nil {
+			LogError("ignoring invalid custom style of IDP feature rule: %v", err)
+		} else {
+			sf.CustomStyleOB = features.CustomStyleOB
+		}
+	}
+	return sf
+}
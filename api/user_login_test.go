@@ -6,6 +6,7 @@ package api
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"reflect"
 	"testing"
@@ -17,6 +18,7 @@ import (
 	iampolicy "github.com/openstor/pkg/v3/policy"
 
 	"github.com/openstor/console/pkg/auth"
+	"github.com/openstor/console/pkg/auth/idp/oauth2"
 
 	"github.com/openstor/openstor-go/v7/pkg/credentials"
 	"github.com/stretchr/testify/assert"
@@ -70,6 +72,7 @@ type IdentityProviderMock struct{}
 var (
 	idpVerifyIdentityMock            func(ctx context.Context, code, state string) (*credentials.Credentials, error)
 	idpVerifyIdentityForOperatorMock func(ctx context.Context, code, state string) (*xoauth2.Token, error)
+	idpRefreshIdentityMock           func(ctx context.Context, refreshToken string) (*credentials.Credentials, error)
 	idpGenerateLoginURLMock          func() string
 )
 
@@ -81,6 +84,10 @@ func (ac IdentityProviderMock) VerifyIdentityForOperator(ctx context.Context, co
 	return idpVerifyIdentityForOperatorMock(ctx, code, state)
 }
 
+func (ac IdentityProviderMock) RefreshIdentity(ctx context.Context, refreshToken string) (*credentials.Credentials, error) {
+	return idpRefreshIdentityMock(ctx, refreshToken)
+}
+
 func (ac IdentityProviderMock) GenerateLoginURL() string {
 	return idpGenerateLoginURLMock()
 }
@@ -182,3 +189,18 @@ func Test_getAccountInfo(t *testing.T) {
 		})
 	}
 }
+
+func Test_getIDPSessionFeatures(t *testing.T) {
+	funcAssert := assert.New(t)
+	validStyle := base64.StdEncoding.EncodeToString([]byte(`{"backgroundColor":"#fff","fontColor":"#000","borderColor":"#ccc","okColor":"#0f0","buttonStyles":{}}`))
+
+	sf := getIDPSessionFeatures("_", nil)
+	funcAssert.Equal(&auth.SessionFeatures{IDPName: "_"}, sf)
+
+	sf = getIDPSessionFeatures("_", &oauth2.Features{HideMenu: true, ObjectBrowser: true, CustomStyleOB: validStyle})
+	funcAssert.Equal(&auth.SessionFeatures{IDPName: "_", HideMenu: true, ObjectBrowser: true, CustomStyleOB: validStyle}, sf)
+
+	// invalid styles are ignored, the rest of the features still apply
+	sf = getIDPSessionFeatures("_", &oauth2.Features{ObjectBrowser: true, CustomStyleOB: "invalid"})
+	funcAssert.Equal(&auth.SessionFeatures{IDPName: "_", ObjectBrowser: true}, sf)
+}
@@ -479,7 +479,8 @@ func getDownloadObjectResponse(session *models.Principal, params objectApi.Downl
 		}
 
 		rw.Header().Set("Content-Length", fmt.Sprintf("%d", length))
-		_, err = io.Copy(rw, io.LimitReader(resp, length))
+		n, err := io.Copy(rw, io.LimitReader(resp, length))
+		metricsDownloadBytes.Add(float64(n))
 		if err != nil {
 			ErrorWithContext(ctx, fmt.Errorf("unable to write all data to client: %v", err))
 			// You can't change headers after you already started writing the body.
@@ -572,7 +573,8 @@ func getDownloadFolderResponse(session *models.Principal, params objectApi.Downl
 		rw.Header().Set("Content-Type", "application/zip")
 
 		// Copy the stream
-		_, err := io.Copy(rw, resp)
+		n, err := io.Copy(rw, resp)
+		metricsDownloadBytes.Add(float64(n))
 		if err != nil {
 			ErrorWithContext(ctx, fmt.Errorf("unable to write all the requested data: %v", err))
 			// You can't change headers after you already started writing the body.
@@ -704,7 +706,8 @@ func getMultipleFilesDownloadResponse(session *models.Principal, params objectAp
 		rw.Header().Set("Content-Type", "application/zip")
 
 		// Copy the stream
-		_, err := io.Copy(rw, resp)
+		n, err := io.Copy(rw, resp)
+		metricsDownloadBytes.Add(float64(n))
 		if err != nil {
 			ErrorWithContext(ctx, fmt.Errorf("unable to write all the requested data: %v", err))
 			// You can't change headers after you already started writing the body.
@@ -971,13 +974,14 @@ func uploadFiles(ctx context.Context, client MinioClient, params objectApi.PostB
 			contentType = mimedb.TypeByExtension(filepath.Ext(p.FileName()))
 		}
 		objectName := prefix // prefix will have complete object path e.g: /test-prefix/test-object.txt
-		_, err = client.putObject(ctx, params.BucketName, objectName, p, size, openstor.PutObjectOptions{
+		info, err := client.putObject(ctx, params.BucketName, objectName, p, size, openstor.PutObjectOptions{
 			ContentType:      contentType,
 			DisableMultipart: true, // Do not upload as multipart stream for console uploader.
 		})
 		if err != nil {
 			return err
 		}
+		metricsUploadBytes.Add(float64(info.Size))
 	}
 
 	return nil
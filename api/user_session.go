@@ -52,6 +52,36 @@ func getClaimsFromToken(sessionToken string) (map[string]interface{}, error) {
 	return claims, nil
 }
 
+// checkConsolePermission fails unless the session is allowed the admin action on the console
+func checkConsolePermission(ctx context.Context, session *models.Principal, action minioIAMPolicy.Action) *CodedAPIError {
+	sessionResp, apiErr := getSessionResponse(ctx, session)
+	if apiErr != nil {
+		return apiErr
+	}
+	for _, permission := range sessionResp.Permissions[ConsoleResourceName] {
+		if permission == string(action) {
+			return nil
+		}
+	}
+	return ErrorWithContext(ctx, ErrForbidden)
+}
+
+// getSessionIdentity returns the user the session acts as: the access key of the users logged in with their
+// credentials and the parent user MinIO recorded in the STS session token of the users logged in with an IDP, e.g.
+// their LDAP DN
+func getSessionIdentity(session *models.Principal) string {
+	if session.AccountAccessKey != "" {
+		return session.AccountAccessKey
+	}
+	claims, _ := getClaimsFromToken(session.STSSessionToken)
+	for _, claim := range []string{"parent", "sub"} {
+		if value, ok := claims[claim].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
 // getSessionResponse parse the token of the current session and returns a list of allowed actions to render in the UI
 func getSessionResponse(ctx context.Context, session *models.Principal) (*models.SessionResponse, *CodedAPIError) {
 	ctx, cancel := context.WithCancel(ctx)
@@ -248,7 +278,7 @@ func getListOfEnabledFeatures(ctx context.Context, minioClient MinioAdmin, sessi
 	oidcEnabled := oauth2.IsIDPEnabled()
 	ldapEnabled := ldap.GetLDAPEnabled()
 
-	if logSearchURL != "" {
+	if logSearchURL != "" || globalLogSearchStore != nil {
 		features = append(features, "log-search")
 	}
 	if oidcEnabled {
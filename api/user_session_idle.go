@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/openstor/console/pkg/auth"
+)
+
+const (
+	// sessionIdleWSPolicyIdle closes WebSocket connections once the session becomes idle
+	sessionIdleWSPolicyIdle = "idle"
+	// sessionIdleWSPolicyActive keeps the session active while a WebSocket connection is open
+	sessionIdleWSPolicyActive = "active"
+
+	// maxSessionActivityRenewals limits how many renewed sessions are followed when looking for the last activity
+	maxSessionActivityRenewals = 10
+)
+
+// globalSessionActivity complements the last activity stored in the session cookie with the activity of the
+// WebSocket connections served by this console instance, since those can't refresh the cookie once upgraded.
+var globalSessionActivity = newSessionActivityTracker()
+
+type sessionActivity struct {
+	last time.Time
+	// renewedAs is the key of the session replacing this one after being renewed with the IDP refresh token
+	renewedAs string
+}
+
+type sessionActivityTracker struct {
+	mu        sync.Mutex
+	sessions  map[string]*sessionActivity
+	lastPurge time.Time
+}
+
+func newSessionActivityTracker() *sessionActivityTracker {
+	return &sessionActivityTracker{sessions: map[string]*sessionActivity{}}
+}
+
+// touch records activity for the session
+func (t *sessionActivityTracker) touch(key string, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if s, ok := t.sessions[key]; ok {
+		if now.After(s.last) {
+			s.last = now
+		}
+	} else {
+		t.sessions[key] = &sessionActivity{last: now}
+	}
+	t.purge(now)
+}
+
+// renewed links a session to the one replacing it, so connections opened with the old session
+// keep seeing the activity of the new one
+func (t *sessionActivityTracker) renewed(oldKey, newKey string, now time.Time) {
+	t.mu.Lock()
+	if s, ok := t.sessions[oldKey]; ok {
+		s.renewedAs = newKey
+	} else {
+		t.sessions[oldKey] = &sessionActivity{last: now, renewedAs: newKey}
+	}
+	t.mu.Unlock()
+	t.touch(newKey, now)
+}
+
+// lastActivity returns the last activity recorded for the session or any of the sessions renewing it
+func (t *sessionActivityTracker) lastActivity(key string) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lookup(key)
+}
+
+func (t *sessionActivityTracker) lookup(key string) time.Time {
+	var last time.Time
+	for i := 0; i < maxSessionActivityRenewals && key != ""; i++ {
+		s, ok := t.sessions[key]
+		if !ok {
+			break
+		}
+		if s.last.After(last) {
+			last = s.last
+		}
+		key = s.renewedAs
+	}
+	return last
+}
+
+// purge removes the sessions that are already idle, it runs at most once per idle timeout
+func (t *sessionActivityTracker) purge(now time.Time) {
+	timeout := getSessionIdleTimeout()
+	if timeout == 0 || now.Sub(t.lastPurge) < timeout {
+		return
+	}
+	t.lastPurge = now
+	var idle []string
+	for key := range t.sessions {
+		if now.Sub(t.lookup(key)) > timeout {
+			idle = append(idle, key)
+		}
+	}
+	for _, key := range idle {
+		delete(t.sessions, key)
+	}
+}
+
+// sessionActivityInterval returns how often the activity of a session is persisted in its cookie
+func sessionActivityInterval(timeout time.Duration) time.Duration {
+	return min(time.Minute, timeout/4)
+}
+
+// sessionIdleExpired returns true if the session has not been active within the idle timeout
+func sessionIdleExpired(claims *auth.TokenClaims, now time.Time) bool {
+	timeout := getSessionIdleTimeout()
+	// sessions created before enabling the idle timeout start being tracked from now on
+	if timeout == 0 || claims == nil || claims.LastActivity == 0 {
+		return false
+	}
+	lastActivity := time.Unix(claims.LastActivity, 0)
+	if tracked := globalSessionActivity.lastActivity(claims.STSAccessKeyID); tracked.After(lastActivity) {
+		lastActivity = tracked
+	}
+	return now.Sub(lastActivity) > timeout
+}
+
+// touchSession records the request as activity of the session, refreshing the session cookie
+// if the last activity stored in it is outdated
+func touchSession(w http.ResponseWriter, claims *auth.TokenClaims, now time.Time) {
+	timeout := getSessionIdleTimeout()
+	if timeout == 0 || claims == nil {
+		return
+	}
+	globalSessionActivity.touch(claims.STSAccessKeyID, now)
+	if now.Sub(time.Unix(claims.LastActivity, 0)) < sessionActivityInterval(timeout) {
+		return
+	}
+	claims.LastActivity = now.Unix()
+	sessionID, err := auth.NewEncryptedTokenFromClaims(claims)
+	if err != nil {
+		LogError("unable to refresh session activity: %v", err)
+		return
+	}
+	sessionCookie := NewSessionCookieForConsole(sessionID)
+	http.SetCookie(w, &sessionCookie)
+}
+
+// isSessionPath returns true for the paths requiring a session, API and WebSocket requests
+func isSessionPath(p string) bool {
+	p = trimSubPath(p)
+	return strings.HasPrefix(p, "/api") || strings.HasPrefix(p, wsBasePath)
+}
+
+// watchSessionIdle applies the configured idle policy to a WebSocket connection of the session, the returned
+// function must be called once the connection is done.
+func watchSessionIdle(conn *websocket.Conn, key string) func() {
+	timeout := getSessionIdleTimeout()
+	if timeout == 0 || key == "" {
+		return func() {}
+	}
+	policy := getSessionIdleWSPolicy()
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(sessionActivityInterval(timeout))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				if policy == sessionIdleWSPolicyActive {
+					globalSessionActivity.touch(key, now)
+					continue
+				}
+				if now.Sub(globalSessionActivity.lastActivity(key)) > timeout {
+					LogInfo("closing websocket connection of idle session")
+					conn.WriteControl(websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "session expired due to inactivity"),
+						now.Add(time.Second))
+					conn.Close()
+					return
+				}
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openstor/console/pkg/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_sessionIdleExpired(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		timeout string
+		claims  *auth.TokenClaims
+		tracked time.Time
+		want    bool
+	}{
+		{
+			name:    "idle timeout disabled",
+			timeout: "0",
+			claims:  &auth.TokenClaims{STSAccessKeyID: "disabled", LastActivity: now.Add(-time.Hour).Unix()},
+			want:    false,
+		},
+		{
+			name:    "no session",
+			timeout: "15m",
+			claims:  nil,
+			want:    false,
+		},
+		{
+			name:    "session without activity",
+			timeout: "15m",
+			claims:  &auth.TokenClaims{STSAccessKeyID: "legacy"},
+			want:    false,
+		},
+		{
+			name:    "recently active session",
+			timeout: "15m",
+			claims:  &auth.TokenClaims{STSAccessKeyID: "active", LastActivity: now.Add(-time.Minute).Unix()},
+			want:    false,
+		},
+		{
+			name:    "idle session",
+			timeout: "15m",
+			claims:  &auth.TokenClaims{STSAccessKeyID: "idle", LastActivity: now.Add(-time.Hour).Unix()},
+			want:    true,
+		},
+		{
+			name:    "session kept active by a websocket connection",
+			timeout: "15m",
+			claims:  &auth.TokenClaims{STSAccessKeyID: "streaming", LastActivity: now.Add(-time.Hour).Unix()},
+			tracked: now.Add(-time.Minute),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(ConsoleSessionIdleTimeout, tt.timeout)
+			if !tt.tracked.IsZero() {
+				globalSessionActivity.touch(tt.claims.STSAccessKeyID, tt.tracked)
+			}
+			assert.Equal(t, tt.want, sessionIdleExpired(tt.claims, now))
+		})
+	}
+}
+
+func Test_touchSession(t *testing.T) {
+	funcAssert := assert.New(t)
+	t.Setenv(ConsoleSessionIdleTimeout, "15m")
+	now := time.Now()
+
+	// recent activity is not persisted again in the cookie
+	rec := httptest.NewRecorder()
+	touchSession(rec, &auth.TokenClaims{STSAccessKeyID: "recent", LastActivity: now.Unix()}, now)
+	funcAssert.Empty(rec.Result().Cookies())
+	funcAssert.Equal(now, globalSessionActivity.lastActivity("recent"))
+
+	// outdated activity refreshes the session cookie
+	rec = httptest.NewRecorder()
+	touchSession(rec, &auth.TokenClaims{STSAccessKeyID: "outdated", LastActivity: now.Add(-5 * time.Minute).Unix()}, now)
+	cookies := rec.Result().Cookies()
+	funcAssert.Len(cookies, 1)
+	funcAssert.Equal("token", cookies[0].Name)
+	claims, err := auth.SessionTokenAuthenticate(cookies[0].Value)
+	funcAssert.NoError(err)
+	funcAssert.Equal("outdated", claims.STSAccessKeyID)
+	funcAssert.Equal(now.Unix(), claims.LastActivity)
+}
+
+func Test_sessionActivityTracker(t *testing.T) {
+	funcAssert := assert.New(t)
+	t.Setenv(ConsoleSessionIdleTimeout, "15m")
+	now := time.Now()
+	tracker := newSessionActivityTracker()
+
+	tracker.touch("old", now.Add(-10*time.Minute))
+	// activity of renewed sessions is visible to the connections of the old session
+	tracker.renewed("old", "new", now.Add(-5*time.Minute))
+	tracker.touch("new", now.Add(-time.Minute))
+	funcAssert.Equal(now.Add(-time.Minute), tracker.lastActivity("old"))
+	funcAssert.True(tracker.lastActivity("unknown").IsZero())
+
+	// idle sessions are purged, renewed ones are kept while the new session is active
+	tracker.touch("idle", now.Add(-time.Hour))
+	tracker.lastPurge = time.Time{}
+	tracker.touch("other", now)
+	funcAssert.True(tracker.lastActivity("idle").IsZero())
+	funcAssert.Equal(now.Add(-time.Minute), tracker.lastActivity("old"))
+}
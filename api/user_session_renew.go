@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openstor/console/pkg/auth"
+	"github.com/openstor/openstor-go/v7/pkg/credentials"
+	"golang.org/x/sync/singleflight"
+)
+
+// errNoRefreshToken is returned when the session is renewed without the refresh token, the IDP didn't issue one
+var errNoRefreshToken = errors.New("missing idp refresh token")
+
+// sessionRenewals makes sure concurrent requests of the same session only
+// use the refresh token once, IDPs rotating refresh tokens would reject the rest.
+var sessionRenewals singleflight.Group
+
+type renewedSession struct {
+	sessionID    string
+	refreshToken string
+}
+
+// sessionNeedsRenewal returns true if the session was created with an IDP and its sts
+// credentials expire within the configured renew window
+func sessionNeedsRenewal(claims *auth.TokenClaims, now time.Time) bool {
+	if claims == nil || claims.IDPName == "" || claims.STSExpiration == 0 {
+		return false
+	}
+	return now.Add(getSessionRenewWindow()).After(time.Unix(claims.STSExpiration, 0))
+}
+
+// renewSession gets new sts credentials for the session using the refresh token stored during login,
+// the renewed session cookies are set on the response and the decrypted session is returned so
+// the current request is served with the new credentials.
+func renewSession(w http.ResponseWriter, r *http.Request, claims *auth.TokenClaims) ([]byte, *auth.TokenClaims, error) {
+	refreshCookie, err := r.Cookie("idp-refresh-token")
+	if err != nil || refreshCookie.Value == "" {
+		return nil, nil, errNoRefreshToken
+	}
+	providerCfg, ok := GlobalMinIOConfig.OpenIDProviders[claims.IDPName]
+	if !ok {
+		return nil, nil, fmt.Errorf("selected IDP %s does not exist", claims.IDPName)
+	}
+	result, err, _ := sessionRenewals.Do(refreshCookie.Value, func() (interface{}, error) {
+		client := GetConsoleHTTPClient(getClientIP(r))
+		oauth2Client, err := providerCfg.GetOauth2Provider(claims.IDPName, nil, r, client)
+		if err != nil {
+			return nil, err
+		}
+		identityProvider := auth.IdentityProvider{
+			KeyFunc: providerCfg.GetStateKeyFunc(),
+			Client:  oauth2Client,
+			RoleARN: providerCfg.RoleArn,
+		}
+		sessionID, err := getRenewedSessionID(r.Context(), identityProvider, refreshCookie.Value, claims, client)
+		if err != nil {
+			return nil, err
+		}
+		return &renewedSession{
+			sessionID:    sessionID,
+			refreshToken: oauth2Client.RefreshToken,
+		}, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	renewed := result.(*renewedSession)
+	sessionToken, err := auth.DecryptToken(renewed.sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	renewedClaims, err := auth.ParseClaimsFromToken(string(sessionToken))
+	if err != nil {
+		return nil, nil, err
+	}
+	sessionCookie := NewSessionCookieForConsole(renewed.sessionID)
+	http.SetCookie(w, &sessionCookie)
+	if renewed.refreshToken != "" {
+		renewedRefreshCookie := NewIDPRefreshTokenCookie(renewed.refreshToken)
+		http.SetCookie(w, &renewedRefreshCookie)
+	}
+	return sessionToken, renewedClaims, nil
+}
+
+// getRenewedSessionID exchanges the refresh token for new sts credentials and returns a new session token
+// keeping the features of the current session
+func getRenewedSessionID(ctx context.Context, provider auth.IdentityProviderI, refreshToken string, claims *auth.TokenClaims, client *http.Client) (string, error) {
+	userCredentials, err := provider.RefreshIdentity(ctx, refreshToken)
+	if err != nil {
+		return "", err
+	}
+	sessionID, err := login(&ConsoleCredentials{
+		ConsoleCredentials: userCredentials,
+		AccountAccessKey:   claims.AccountAccessKey,
+		CredContext:        &credentials.CredContext{Client: client},
+	}, &auth.SessionFeatures{
+		HideMenu:      claims.HideMenu,
+		ObjectBrowser: claims.ObjectBrowser,
+		CustomStyleOB: claims.CustomStyleOB,
+		IDPName:       claims.IDPName,
+	})
+	if err != nil {
+		return "", err
+	}
+	return *sessionID, nil
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openstor/console/pkg/auth"
+	"github.com/openstor/openstor-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_sessionNeedsRenewal(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		claims *auth.TokenClaims
+		want   bool
+	}{
+		{
+			name:   "no session",
+			claims: nil,
+			want:   false,
+		},
+		{
+			name:   "session not created with an idp",
+			claims: &auth.TokenClaims{STSExpiration: now.Add(time.Minute).Unix()},
+			want:   false,
+		},
+		{
+			name:   "idp session without expiration",
+			claims: &auth.TokenClaims{IDPName: "_"},
+			want:   false,
+		},
+		{
+			name:   "idp session far from expiration",
+			claims: &auth.TokenClaims{IDPName: "_", STSExpiration: now.Add(time.Hour).Unix()},
+			want:   false,
+		},
+		{
+			name:   "idp session about to expire",
+			claims: &auth.TokenClaims{IDPName: "_", STSExpiration: now.Add(time.Minute).Unix()},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(_ *testing.T) {
+			if got := sessionNeedsRenewal(tt.claims, now); got != tt.want {
+				t.Errorf("sessionNeedsRenewal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getRenewedSessionID(t *testing.T) {
+	funcAssert := assert.New(t)
+	provider := IdentityProviderMock{}
+	claims := &auth.TokenClaims{
+		IDPName:       "_",
+		ObjectBrowser: true,
+		CustomStyleOB: "style",
+	}
+
+	// Test-1: the refresh token is rejected by the idp
+	idpRefreshIdentityMock = func(_ context.Context, _ string) (*credentials.Credentials, error) {
+		return nil, errors.New("invalid_grant")
+	}
+	_, err := getRenewedSessionID(context.Background(), provider, "refresh", claims, http.DefaultClient)
+	funcAssert.Error(err)
+
+	// Test-2: the new session keeps the features of the renewed one
+	var usedRefreshToken string
+	idpRefreshIdentityMock = func(_ context.Context, refreshToken string) (*credentials.Credentials, error) {
+		usedRefreshToken = refreshToken
+		return credentials.NewStaticV4("renewedAccessKey", "renewedSecretKey", "renewedSessionToken"), nil
+	}
+	sessionID, err := getRenewedSessionID(context.Background(), provider, "refresh", claims, http.DefaultClient)
+	funcAssert.NoError(err)
+	funcAssert.Equal("refresh", usedRefreshToken)
+	renewedClaims, err := auth.SessionTokenAuthenticate(sessionID)
+	funcAssert.NoError(err)
+	funcAssert.Equal("renewedAccessKey", renewedClaims.STSAccessKeyID)
+	funcAssert.Equal("renewedSessionToken", renewedClaims.STSSessionToken)
+	funcAssert.Equal("_", renewedClaims.IDPName)
+	funcAssert.True(renewedClaims.ObjectBrowser)
+	funcAssert.Equal("style", renewedClaims.CustomStyleOB)
+}
+
+func Test_renewSessionWithoutRefreshToken(t *testing.T) {
+	funcAssert := assert.New(t)
+	claims := &auth.TokenClaims{IDPName: "_", STSExpiration: time.Now().Unix()}
+
+	// Test-1: a session the IDP issued no refresh token for can't be renewed
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/session", nil)
+	_, _, err := renewSession(httptest.NewRecorder(), r, claims)
+	funcAssert.ErrorIs(err, errNoRefreshToken)
+
+	// Test-2: an empty refresh token cookie is the same as a missing one
+	r.AddCookie(&http.Cookie{Name: "idp-refresh-token", Value: ""})
+	_, _, err = renewSession(httptest.NewRecorder(), r, claims)
+	funcAssert.ErrorIs(err, errNoRefreshToken)
+}
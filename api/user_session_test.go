@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"testing"
 
+	jwtgo "github.com/golang-jwt/jwt/v4"
 	"github.com/openstor/console/pkg/utils"
 
 	"github.com/openstor/console/models"
@@ -127,3 +128,24 @@ func Test_getListOfEnabledFeatures(t *testing.T) {
 		})
 	}
 }
+
+func Test_getSessionIdentity(t *testing.T) {
+	funcAssert := assert.New(t)
+	stsToken := func(claims jwtgo.MapClaims) string {
+		token, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS512, claims).SignedString([]byte("secret"))
+		funcAssert.NoError(err)
+		return token
+	}
+
+	// Test-1: the users logged in with their credentials are their access key
+	funcAssert.Equal("alice", getSessionIdentity(&models.Principal{AccountAccessKey: "alice", STSSessionToken: stsToken(jwtgo.MapClaims{"parent": "alice"})}))
+
+	// Test-2: the users logged in with an IDP are the parent user of their STS session
+	funcAssert.Equal("uid=bob,ou=people,dc=example,dc=org", getSessionIdentity(&models.Principal{
+		STSSessionToken: stsToken(jwtgo.MapClaims{"parent": "uid=bob,ou=people,dc=example,dc=org", "sub": "bob"}),
+	}))
+	funcAssert.Equal("carol", getSessionIdentity(&models.Principal{STSSessionToken: stsToken(jwtgo.MapClaims{"sub": "carol"})}))
+
+	// Test-3: sessions without an identity
+	funcAssert.Empty(getSessionIdentity(&models.Principal{STSSessionToken: "invalid"}))
+}
@@ -162,6 +162,22 @@ func NewSessionCookieForConsole(token string) http.Cookie {
 	}
 }
 
+// NewIDPRefreshTokenCookie returns the cookie holding the refresh token issued by the IDP, it expires along with
+// the session cookie
+func NewIDPRefreshTokenCookie(refreshToken string) http.Cookie {
+	sessionDuration := xjwt.GetConsoleSTSDuration()
+	return http.Cookie{
+		Path:     "/",
+		Name:     "idp-refresh-token",
+		Value:    refreshToken,
+		MaxAge:   int(sessionDuration.Seconds()),
+		Expires:  time.Now().Add(sessionDuration),
+		HttpOnly: true,
+		Secure:   len(GlobalPublicCerts) > 0,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
 func ExpireSessionCookie() http.Cookie {
 	return http.Cookie{
 		Path:     "/",
@@ -117,6 +117,16 @@ func TestNewSessionCookieForConsole(t *testing.T) {
 	}
 }
 
+func TestNewIDPRefreshTokenCookie(t *testing.T) {
+	got := NewIDPRefreshTokenCookie("refresh-xxxxxxxxx")
+	session := NewSessionCookieForConsole("jwt-xxxxxxxxx")
+	assert.Equal(t, "idp-refresh-token", got.Name)
+	assert.Equal(t, "refresh-xxxxxxxxx", got.Value)
+	assert.True(t, got.HttpOnly)
+	assert.Equal(t, session.MaxAge, got.MaxAge)
+	assert.WithinDuration(t, session.Expires, got.Expires, time.Second)
+}
+
 func TestExpireSessionCookie(t *testing.T) {
 	tests := []struct {
 		name string
@@ -163,6 +163,11 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 		}
 	}
 
+	var sessionKey string
+	if session != nil {
+		sessionKey = session.STSAccessKeyID
+	}
+
 	switch {
 	case strings.HasPrefix(wsPath, `/console`):
 
@@ -179,7 +184,13 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			node:    node,
 			logType: logType,
 		}
-		go wsAdminClient.console(ctx, logRequestItem)
+		stopIdleWatch := watchSessionIdle(conn, sessionKey)
+		untrack := trackWebSocketConnection(metricsWSRouteConsole)
+		go func() {
+			defer untrack()
+			defer stopIdleWatch()
+			wsAdminClient.console(ctx, logRequestItem)
+		}()
 	case strings.HasPrefix(wsPath, `/objectManager`):
 		wsMinioClient, err := newWebSocketMinioClient(conn, session, clientIP)
 		if err != nil {
@@ -188,7 +199,13 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 
-		go wsMinioClient.objectManager(session)
+		stopIdleWatch := watchSessionIdle(conn, sessionKey)
+		untrack := trackWebSocketConnection(metricsWSRouteObjectManager)
+		go func() {
+			defer untrack()
+			defer stopIdleWatch()
+			wsMinioClient.objectManager(session)
+		}()
 	default:
 		// path not found
 		closeWsConn(conn)
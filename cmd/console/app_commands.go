@@ -46,6 +46,10 @@ func StartServer(ctx *cli.Context) error {
 		api.LogError("argument validation failed: %v", err)
 		return err
 	}
+	if err := api.ValidateConfig(); err != nil {
+		api.LogError("configuration validation failed: %v", err)
+		return err
+	}
 
 	server, err := buildServer()
 	if err != nil {
@@ -21,6 +21,9 @@ import (
 // swagger:model addPolicyRequest
 type AddPolicyRequest struct {
 
+	// Optional comment stored in the policy history
+	Comment string `json:"comment,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
@@ -25,6 +25,9 @@ type AddUserRequest struct {
 	// Required: true
 	AccessKey *string `json:"accessKey"`
 
+	// RFC 3339 date the user is disabled at
+	ExpiresAt string `json:"expiresAt,omitempty"`
+
 	// groups
 	// Required: true
 	Groups []string `json:"groups"`
@@ -33,6 +36,9 @@ type AddUserRequest struct {
 	// Required: true
 	Policies []string `json:"policies"`
 
+	// how long the user is kept once disabled before it is deleted, e.g. 720h, it is never deleted when empty
+	Retention string `json:"retention,omitempty"`
+
 	// secret key
 	// Required: true
 	SecretKey *string `json:"secretKey"`
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Alert alert
+//
+// swagger:model alert
+type Alert struct {
+
+	// ends at
+	EndsAt string `json:"endsAt,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// kind
+	Kind string `json:"kind,omitempty"`
+
+	// message
+	Message string `json:"message,omitempty"`
+
+	// rule
+	Rule string `json:"rule,omitempty"`
+
+	// severity
+	Severity string `json:"severity,omitempty"`
+
+	// silenced
+	Silenced bool `json:"silenced,omitempty"`
+
+	// starts at
+	StartsAt string `json:"startsAt,omitempty"`
+
+	// status
+	// Enum: ["firing","resolved"]
+	Status string `json:"status,omitempty"`
+
+	// What the alert is about, e.g. the drive, bucket or tier
+	Subject string `json:"subject,omitempty"`
+}
+
+// Validate validates this alert
+func (m *Alert) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var alertTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["firing","resolved"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		alertTypeStatusPropEnum = append(alertTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AlertStatusFiring captures enum value "firing"
+	AlertStatusFiring string = "firing"
+
+	// AlertStatusResolved captures enum value "resolved"
+	AlertStatusResolved string = "resolved"
+)
+
+// prop value enum
+func (m *Alert) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, alertTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Alert) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this alert based on context it is used
+func (m *Alert) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Alert) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Alert) UnmarshalBinary(b []byte) error {
+	var res Alert
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AlertRule alert rule
+//
+// swagger:model alertRule
+type AlertRule struct {
+
+	// kind
+	// Enum: ["driveOffline","serverOffline","bucketQuota","kmsUnreachable","tierUnreachable","prometheus"]
+	Kind string `json:"kind,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// Prometheus query of the prometheus rules
+	Query string `json:"query,omitempty"`
+
+	// severity
+	// Enum: ["info","warning","critical"]
+	Severity string `json:"severity,omitempty"`
+
+	// Quota percentage of the bucketQuota rules, value above which the series of the prometheus rules fire
+	Threshold float64 `json:"threshold,omitempty"`
+}
+
+// Validate validates this alert rule
+func (m *AlertRule) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSeverity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var alertRuleTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["driveOffline","serverOffline","bucketQuota","kmsUnreachable","tierUnreachable","prometheus"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		alertRuleTypeKindPropEnum = append(alertRuleTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// AlertRuleKindDriveOffline captures enum value "driveOffline"
+	AlertRuleKindDriveOffline string = "driveOffline"
+
+	// AlertRuleKindServerOffline captures enum value "serverOffline"
+	AlertRuleKindServerOffline string = "serverOffline"
+
+	// AlertRuleKindBucketQuota captures enum value "bucketQuota"
+	AlertRuleKindBucketQuota string = "bucketQuota"
+
+	// AlertRuleKindKmsUnreachable captures enum value "kmsUnreachable"
+	AlertRuleKindKmsUnreachable string = "kmsUnreachable"
+
+	// AlertRuleKindTierUnreachable captures enum value "tierUnreachable"
+	AlertRuleKindTierUnreachable string = "tierUnreachable"
+
+	// AlertRuleKindPrometheus captures enum value "prometheus"
+	AlertRuleKindPrometheus string = "prometheus"
+)
+
+// prop value enum
+func (m *AlertRule) validateKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, alertRuleTypeKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AlertRule) validateKind(formats strfmt.Registry) error {
+	if swag.IsZero(m.Kind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var alertRuleTypeSeverityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["info","warning","critical"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		alertRuleTypeSeverityPropEnum = append(alertRuleTypeSeverityPropEnum, v)
+	}
+}
+
+const (
+
+	// AlertRuleSeverityInfo captures enum value "info"
+	AlertRuleSeverityInfo string = "info"
+
+	// AlertRuleSeverityWarning captures enum value "warning"
+	AlertRuleSeverityWarning string = "warning"
+
+	// AlertRuleSeverityCritical captures enum value "critical"
+	AlertRuleSeverityCritical string = "critical"
+)
+
+// prop value enum
+func (m *AlertRule) validateSeverityEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, alertRuleTypeSeverityPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AlertRule) validateSeverity(formats strfmt.Registry) error {
+	if swag.IsZero(m.Severity) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSeverityEnum("severity", "body", m.Severity); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this alert rule based on context it is used
+func (m *AlertRule) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AlertRule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AlertRule) UnmarshalBinary(b []byte) error {
+	var res AlertRule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
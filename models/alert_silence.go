@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// AlertSilence alert silence
+//
+// swagger:model alertSilence
+type AlertSilence struct {
+
+	// comment
+	Comment string `json:"comment,omitempty"`
+
+	// created by
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// ends at
+	EndsAt string `json:"endsAt,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// Rule of the silenced alerts, any rule when empty
+	Rule string `json:"rule,omitempty"`
+
+	// starts at
+	StartsAt string `json:"startsAt,omitempty"`
+
+	// Subject of the silenced alerts, `*` matches any characters
+	Subject string `json:"subject,omitempty"`
+}
+
+// Validate validates this alert silence
+func (m *AlertSilence) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this alert silence based on context it is used
+func (m *AlertSilence) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AlertSilence) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AlertSilence) UnmarshalBinary(b []byte) error {
+	var res AlertSilence
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
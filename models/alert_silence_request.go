@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AlertSilenceRequest alert silence request
+//
+// swagger:model alertSilenceRequest
+type AlertSilenceRequest struct {
+
+	// comment
+	Comment string `json:"comment,omitempty"`
+
+	// How long the alerts are silenced, e.g. `2h`
+	// Required: true
+	Duration *string `json:"duration"`
+
+	// rule
+	Rule string `json:"rule,omitempty"`
+
+	// subject
+	Subject string `json:"subject,omitempty"`
+}
+
+// Validate validates this alert silence request
+func (m *AlertSilenceRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDuration(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AlertSilenceRequest) validateDuration(formats strfmt.Registry) error {
+
+	if err := validate.Required("duration", "body", m.Duration); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this alert silence request based on context it is used
+func (m *AlertSilenceRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AlertSilenceRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AlertSilenceRequest) UnmarshalBinary(b []byte) error {
+	var res AlertSilenceRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
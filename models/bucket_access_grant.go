@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BucketAccessGrant bucket access grant
+//
+// swagger:model bucketAccessGrant
+type BucketAccessGrant struct {
+
+	// access
+	Access string `json:"access,omitempty"`
+
+	// groups
+	Groups []string `json:"groups"`
+
+	// policy
+	Policy string `json:"policy,omitempty"`
+
+	// prefix
+	Prefix string `json:"prefix,omitempty"`
+
+	// users
+	Users []string `json:"users"`
+}
+
+// Validate validates this bucket access grant
+func (m *BucketAccessGrant) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this bucket access grant based on context it is used
+func (m *BucketAccessGrant) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BucketAccessGrant) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BucketAccessGrant) UnmarshalBinary(b []byte) error {
+	var res BucketAccessGrant
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BucketAccessGrantRequest bucket access grant request
+//
+// swagger:model bucketAccessGrantRequest
+type BucketAccessGrantRequest struct {
+
+	// access
+	// Required: true
+	// Enum: ["readonly","writeonly","readwrite"]
+	Access *string `json:"access"`
+
+	// groups
+	Groups []string `json:"groups"`
+
+	// prefix
+	Prefix string `json:"prefix,omitempty"`
+
+	// users
+	Users []string `json:"users"`
+}
+
+// Validate validates this bucket access grant request
+func (m *BucketAccessGrantRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAccess(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var bucketAccessGrantRequestTypeAccessPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["readonly","writeonly","readwrite"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		bucketAccessGrantRequestTypeAccessPropEnum = append(bucketAccessGrantRequestTypeAccessPropEnum, v)
+	}
+}
+
+const (
+
+	// BucketAccessGrantRequestAccessReadonly captures enum value "readonly"
+	BucketAccessGrantRequestAccessReadonly string = "readonly"
+
+	// BucketAccessGrantRequestAccessWriteonly captures enum value "writeonly"
+	BucketAccessGrantRequestAccessWriteonly string = "writeonly"
+
+	// BucketAccessGrantRequestAccessReadwrite captures enum value "readwrite"
+	BucketAccessGrantRequestAccessReadwrite string = "readwrite"
+)
+
+// prop value enum
+func (m *BucketAccessGrantRequest) validateAccessEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, bucketAccessGrantRequestTypeAccessPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BucketAccessGrantRequest) validateAccess(formats strfmt.Registry) error {
+
+	if err := validate.Required("access", "body", m.Access); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateAccessEnum("access", "body", *m.Access); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this bucket access grant request based on context it is used
+func (m *BucketAccessGrantRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BucketAccessGrantRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BucketAccessGrantRequest) UnmarshalBinary(b []byte) error {
+	var res BucketAccessGrantRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
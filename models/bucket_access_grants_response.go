@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BucketAccessGrantsResponse bucket access grants response
+//
+// swagger:model bucketAccessGrantsResponse
+type BucketAccessGrantsResponse struct {
+
+	// grants
+	Grants []*BucketAccessGrant `json:"grants"`
+}
+
+// Validate validates this bucket access grants response
+func (m *BucketAccessGrantsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGrants(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BucketAccessGrantsResponse) validateGrants(formats strfmt.Registry) error {
+	if swag.IsZero(m.Grants) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Grants); i++ {
+		if swag.IsZero(m.Grants[i]) { // not required
+			continue
+		}
+
+		if m.Grants[i] != nil {
+			if err := m.Grants[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("grants" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("grants" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this bucket access grants response based on the context it is used
+func (m *BucketAccessGrantsResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateGrants(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BucketAccessGrantsResponse) contextValidateGrants(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Grants); i++ {
+
+		if m.Grants[i] != nil {
+
+			if swag.IsZero(m.Grants[i]) { // not required
+				return nil
+			}
+
+			if err := m.Grants[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("grants" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("grants" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BucketAccessGrantsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BucketAccessGrantsResponse) UnmarshalBinary(b []byte) error {
+	var res BucketAccessGrantsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
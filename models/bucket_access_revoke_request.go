@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BucketAccessRevokeRequest bucket access revoke request
+//
+// swagger:model bucketAccessRevokeRequest
+type BucketAccessRevokeRequest struct {
+
+	// groups
+	Groups []string `json:"groups"`
+
+	// prefix
+	Prefix string `json:"prefix,omitempty"`
+
+	// users losing the access, every user and group loses it when both users and groups are empty
+	Users []string `json:"users"`
+}
+
+// Validate validates this bucket access revoke request
+func (m *BucketAccessRevokeRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this bucket access revoke request based on context it is used
+func (m *BucketAccessRevokeRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BucketAccessRevokeRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BucketAccessRevokeRequest) UnmarshalBinary(b []byte) error {
+	var res BucketAccessRevokeRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BucketEffectivePermissions bucket effective permissions
+//
+// swagger:model bucketEffectivePermissions
+type BucketEffectivePermissions struct {
+
+	// admin
+	Admin string `json:"admin,omitempty"`
+
+	// allowed actions
+	AllowedActions []string `json:"allowedActions"`
+
+	// bucket
+	Bucket string `json:"bucket,omitempty"`
+
+	// delete
+	Delete string `json:"delete,omitempty"`
+
+	// read
+	Read string `json:"read,omitempty"`
+
+	// write
+	Write string `json:"write,omitempty"`
+}
+
+// Validate validates this bucket effective permissions
+func (m *BucketEffectivePermissions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this bucket effective permissions based on context it is used
+func (m *BucketEffectivePermissions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BucketEffectivePermissions) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BucketEffectivePermissions) UnmarshalBinary(b []byte) error {
+	var res BucketEffectivePermissions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
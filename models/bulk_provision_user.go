@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BulkProvisionUser bulk provision user
+//
+// swagger:model bulkProvisionUser
+type BulkProvisionUser struct {
+
+	// access key
+	AccessKey string `json:"accessKey,omitempty"`
+
+	// groups
+	Groups []string `json:"groups"`
+
+	// policies
+	Policies []string `json:"policies"`
+
+	// secret key
+	SecretKey string `json:"secretKey,omitempty"`
+
+	// status
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this bulk provision user
+func (m *BulkProvisionUser) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this bulk provision user based on context it is used
+func (m *BulkProvisionUser) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BulkProvisionUser) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BulkProvisionUser) UnmarshalBinary(b []byte) error {
+	var res BulkProvisionUser
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BulkProvisionUserResult bulk provision user result
+//
+// swagger:model bulkProvisionUserResult
+type BulkProvisionUserResult struct {
+
+	// access key
+	AccessKey string `json:"accessKey,omitempty"`
+
+	// errors
+	Errors []string `json:"errors"`
+
+	// row
+	Row int32 `json:"row,omitempty"`
+
+	// status
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this bulk provision user result
+func (m *BulkProvisionUserResult) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this bulk provision user result based on context it is used
+func (m *BulkProvisionUserResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BulkProvisionUserResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BulkProvisionUserResult) UnmarshalBinary(b []byte) error {
+	var res BulkProvisionUserResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// CustomWidget custom widget
+//
+// swagger:model customWidget
+type CustomWidget struct {
+
+	// Namespaced ID of the widget, `<namespace>/<widgetId>`
+	ID string `json:"id,omitempty"`
+
+	// namespace
+	Namespace string `json:"namespace,omitempty"`
+
+	// Where the widget is defined, `file` or `bucket`
+	Source string `json:"source,omitempty"`
+
+	// title
+	Title string `json:"title,omitempty"`
+
+	// type
+	Type string `json:"type,omitempty"`
+
+	// widget Id
+	WidgetID int32 `json:"widgetId,omitempty"`
+}
+
+// Validate validates this custom widget
+func (m *CustomWidget) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this custom widget based on context it is used
+func (m *CustomWidget) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CustomWidget) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CustomWidget) UnmarshalBinary(b []byte) error {
+	var res CustomWidget
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
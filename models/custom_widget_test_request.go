@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CustomWidgetTestRequest custom widget test request
+//
+// swagger:model customWidgetTestRequest
+type CustomWidgetTestRequest struct {
+
+	// YAML or JSON definition of the widget
+	// Required: true
+	Definition *string `json:"definition"`
+
+	// end
+	End int64 `json:"end,omitempty"`
+
+	// start
+	Start int64 `json:"start,omitempty"`
+
+	// step
+	Step int32 `json:"step,omitempty"`
+}
+
+// Validate validates this custom widget test request
+func (m *CustomWidgetTestRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDefinition(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CustomWidgetTestRequest) validateDefinition(formats strfmt.Registry) error {
+
+	if err := validate.Required("definition", "body", m.Definition); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this custom widget test request based on context it is used
+func (m *CustomWidgetTestRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CustomWidgetTestRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CustomWidgetTestRequest) UnmarshalBinary(b []byte) error {
+	var res CustomWidgetTestRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// IamBundleGroup iam bundle group
+//
+// swagger:model iamBundleGroup
+type IamBundleGroup struct {
+
+	// members
+	Members []string `json:"members"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// status
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this iam bundle group
+func (m *IamBundleGroup) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this iam bundle group based on context it is used
+func (m *IamBundleGroup) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *IamBundleGroup) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *IamBundleGroup) UnmarshalBinary(b []byte) error {
+	var res IamBundleGroup
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
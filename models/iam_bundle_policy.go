@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// IamBundlePolicy iam bundle policy
+//
+// swagger:model iamBundlePolicy
+type IamBundlePolicy struct {
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// policy
+	Policy string `json:"policy,omitempty"`
+}
+
+// Validate validates this iam bundle policy
+func (m *IamBundlePolicy) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this iam bundle policy based on context it is used
+func (m *IamBundlePolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *IamBundlePolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *IamBundlePolicy) UnmarshalBinary(b []byte) error {
+	var res IamBundlePolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
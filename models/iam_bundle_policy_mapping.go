@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// IamBundlePolicyMapping iam bundle policy mapping
+//
+// swagger:model iamBundlePolicyMapping
+type IamBundlePolicyMapping struct {
+
+	// entity
+	Entity string `json:"entity,omitempty"`
+
+	// entity type
+	EntityType string `json:"entityType,omitempty"`
+
+	// policies
+	Policies []string `json:"policies"`
+}
+
+// Validate validates this iam bundle policy mapping
+func (m *IamBundlePolicyMapping) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this iam bundle policy mapping based on context it is used
+func (m *IamBundlePolicyMapping) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *IamBundlePolicyMapping) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *IamBundlePolicyMapping) UnmarshalBinary(b []byte) error {
+	var res IamBundlePolicyMapping
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// IamBundleUser iam bundle user
+//
+// swagger:model iamBundleUser
+type IamBundleUser struct {
+
+	// access key
+	AccessKey string `json:"accessKey,omitempty"`
+
+	// status
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this iam bundle user
+func (m *IamBundleUser) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this iam bundle user based on context it is used
+func (m *IamBundleUser) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *IamBundleUser) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *IamBundleUser) UnmarshalBinary(b []byte) error {
+	var res IamBundleUser
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
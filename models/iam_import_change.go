@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// IamImportChange iam import change
+//
+// swagger:model iamImportChange
+type IamImportChange struct {
+
+	// action
+	Action string `json:"action,omitempty"`
+
+	// detail
+	Detail string `json:"detail,omitempty"`
+
+	// entity type
+	EntityType string `json:"entityType,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this iam import change
+func (m *IamImportChange) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this iam import change based on context it is used
+func (m *IamImportChange) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *IamImportChange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *IamImportChange) UnmarshalBinary(b []byte) error {
+	var res IamImportChange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
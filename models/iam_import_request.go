@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// IamImportRequest iam import request
+//
+// swagger:model iamImportRequest
+type IamImportRequest struct {
+
+	// bundle
+	Bundle *IamBundle `json:"bundle,omitempty"`
+
+	// conflict strategy
+	ConflictStrategy string `json:"conflictStrategy,omitempty"`
+
+	// dry run
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// Validate validates this iam import request
+func (m *IamImportRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBundle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *IamImportRequest) validateBundle(formats strfmt.Registry) error {
+	if swag.IsZero(m.Bundle) { // not required
+		return nil
+	}
+
+	if m.Bundle != nil {
+		if err := m.Bundle.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bundle")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bundle")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this iam import request based on the context it is used
+func (m *IamImportRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBundle(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *IamImportRequest) contextValidateBundle(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Bundle != nil {
+
+		if swag.IsZero(m.Bundle) { // not required
+			return nil
+		}
+
+		if err := m.Bundle.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bundle")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bundle")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *IamImportRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *IamImportRequest) UnmarshalBinary(b []byte) error {
+	var res IamImportRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LdapPolicyAssociationRequest ldap policy association request
+//
+// swagger:model ldapPolicyAssociationRequest
+type LdapPolicyAssociationRequest struct {
+
+	// Group DNs
+	Groups []string `json:"groups"`
+
+	// policies
+	// Required: true
+	Policies []string `json:"policies"`
+
+	// User DNs
+	Users []string `json:"users"`
+}
+
+// Validate validates this ldap policy association request
+func (m *LdapPolicyAssociationRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePolicies(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LdapPolicyAssociationRequest) validatePolicies(formats strfmt.Registry) error {
+
+	if err := validate.Required("policies", "body", m.Policies); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this ldap policy association request based on context it is used
+func (m *LdapPolicyAssociationRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LdapPolicyAssociationRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LdapPolicyAssociationRequest) UnmarshalBinary(b []byte) error {
+	var res LdapPolicyAssociationRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// LdapPolicyAssociationResult ldap policy association result
+//
+// swagger:model ldapPolicyAssociationResult
+type LdapPolicyAssociationResult struct {
+
+	// dn
+	Dn string `json:"dn,omitempty"`
+
+	// entity type
+	EntityType string `json:"entityType,omitempty"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// Policies actually attached or detached
+	Policies []string `json:"policies"`
+
+	// status
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this ldap policy association result
+func (m *LdapPolicyAssociationResult) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this ldap policy association result based on context it is used
+func (m *LdapPolicyAssociationResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LdapPolicyAssociationResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LdapPolicyAssociationResult) UnmarshalBinary(b []byte) error {
+	var res LdapPolicyAssociationResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
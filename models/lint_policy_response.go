@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// LintPolicyResponse lint policy response
+//
+// swagger:model lintPolicyResponse
+type LintPolicyResponse struct {
+
+	// findings
+	Findings []*PolicyLintFinding `json:"findings"`
+
+	// False when any of the findings is an error
+	Valid bool `json:"valid,omitempty"`
+}
+
+// Validate validates this lint policy response
+func (m *LintPolicyResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFindings(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LintPolicyResponse) validateFindings(formats strfmt.Registry) error {
+	if swag.IsZero(m.Findings) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Findings); i++ {
+		if swag.IsZero(m.Findings[i]) { // not required
+			continue
+		}
+
+		if m.Findings[i] != nil {
+			if err := m.Findings[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("findings" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("findings" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this lint policy response based on the context it is used
+func (m *LintPolicyResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFindings(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LintPolicyResponse) contextValidateFindings(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Findings); i++ {
+
+		if m.Findings[i] != nil {
+
+			if swag.IsZero(m.Findings[i]) { // not required
+				return nil
+			}
+
+			if err := m.Findings[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("findings" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("findings" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LintPolicyResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LintPolicyResponse) UnmarshalBinary(b []byte) error {
+	var res LintPolicyResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// MatchedStatement matched statement
+//
+// swagger:model matchedStatement
+type MatchedStatement struct {
+
+	// effect
+	Effect string `json:"effect,omitempty"`
+
+	// policy
+	Policy string `json:"policy,omitempty"`
+
+	// sid
+	Sid string `json:"sid,omitempty"`
+
+	// source
+	Source string `json:"source,omitempty"`
+
+	// statement
+	Statement string `json:"statement,omitempty"`
+}
+
+// Validate validates this matched statement
+func (m *MatchedStatement) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this matched statement based on context it is used
+func (m *MatchedStatement) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *MatchedStatement) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *MatchedStatement) UnmarshalBinary(b []byte) error {
+	var res MatchedStatement
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -19,6 +19,9 @@ import (
 // swagger:model policy
 type Policy struct {
 
+	// Why the change of the policy couldn't be recorded in its history, empty when it was
+	HistoryError string `json:"historyError,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// PolicyDiffEntry policy diff entry
+//
+// swagger:model policyDiffEntry
+type PolicyDiffEntry struct {
+
+	// from
+	From string `json:"from,omitempty"`
+
+	// op
+	Op string `json:"op,omitempty"`
+
+	// path
+	Path string `json:"path,omitempty"`
+
+	// to
+	To string `json:"to,omitempty"`
+}
+
+// Validate validates this policy diff entry
+func (m *PolicyDiffEntry) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this policy diff entry based on context it is used
+func (m *PolicyDiffEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PolicyDiffEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PolicyDiffEntry) UnmarshalBinary(b []byte) error {
+	var res PolicyDiffEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// PolicyHistoryEntry policy history entry
+//
+// swagger:model policyHistoryEntry
+type PolicyHistoryEntry struct {
+
+	// action
+	Action string `json:"action,omitempty"`
+
+	// comment
+	Comment string `json:"comment,omitempty"`
+
+	// diff
+	Diff []*PolicyDiffEntry `json:"diff"`
+
+	// document
+	Document string `json:"document,omitempty"`
+
+	// editor
+	Editor string `json:"editor,omitempty"`
+
+	// policy
+	Policy string `json:"policy,omitempty"`
+
+	// previous document
+	PreviousDocument string `json:"previousDocument,omitempty"`
+
+	// timestamp
+	Timestamp string `json:"timestamp,omitempty"`
+
+	// version
+	Version int64 `json:"version,omitempty"`
+}
+
+// Validate validates this policy history entry
+func (m *PolicyHistoryEntry) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDiff(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PolicyHistoryEntry) validateDiff(formats strfmt.Registry) error {
+	if swag.IsZero(m.Diff) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Diff); i++ {
+		if swag.IsZero(m.Diff[i]) { // not required
+			continue
+		}
+
+		if m.Diff[i] != nil {
+			if err := m.Diff[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("diff" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("diff" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this policy history entry based on the context it is used
+func (m *PolicyHistoryEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDiff(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PolicyHistoryEntry) contextValidateDiff(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Diff); i++ {
+
+		if m.Diff[i] != nil {
+
+			if swag.IsZero(m.Diff[i]) { // not required
+				return nil
+			}
+
+			if err := m.Diff[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("diff" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("diff" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PolicyHistoryEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PolicyHistoryEntry) UnmarshalBinary(b []byte) error {
+	var res PolicyHistoryEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PolicyLintFinding policy lint finding
+//
+// swagger:model policyLintFinding
+type PolicyLintFinding struct {
+
+	// code
+	Code string `json:"code,omitempty"`
+
+	// message
+	Message string `json:"message,omitempty"`
+
+	// severity
+	// Enum: ["error","warning","info"]
+	Severity string `json:"severity,omitempty"`
+
+	// sid
+	Sid string `json:"sid,omitempty"`
+
+	// Index of the statement the finding refers to, -1 for the whole document
+	Statement int32 `json:"statement,omitempty"`
+
+	// suggestion
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// Validate validates this policy lint finding
+func (m *PolicyLintFinding) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSeverity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var policyLintFindingTypeSeverityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["error","warning","info"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		policyLintFindingTypeSeverityPropEnum = append(policyLintFindingTypeSeverityPropEnum, v)
+	}
+}
+
+const (
+
+	// PolicyLintFindingSeverityError captures enum value "error"
+	PolicyLintFindingSeverityError string = "error"
+
+	// PolicyLintFindingSeverityWarning captures enum value "warning"
+	PolicyLintFindingSeverityWarning string = "warning"
+
+	// PolicyLintFindingSeverityInfo captures enum value "info"
+	PolicyLintFindingSeverityInfo string = "info"
+)
+
+// prop value enum
+func (m *PolicyLintFinding) validateSeverityEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, policyLintFindingTypeSeverityPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PolicyLintFinding) validateSeverity(formats strfmt.Registry) error {
+	if swag.IsZero(m.Severity) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSeverityEnum("severity", "body", m.Severity); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this policy lint finding based on context it is used
+func (m *PolicyLintFinding) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PolicyLintFinding) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PolicyLintFinding) UnmarshalBinary(b []byte) error {
+	var res PolicyLintFinding
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
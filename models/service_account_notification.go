@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ServiceAccountNotification service account notification
+//
+// swagger:model serviceAccountNotification
+type ServiceAccountNotification struct {
+
+	// access key
+	AccessKey string `json:"accessKey,omitempty"`
+
+	// When the rotated access key gets deleted
+	DeleteAfter string `json:"deleteAfter,omitempty"`
+
+	// expiration
+	Expiration string `json:"expiration,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// kind
+	// Enum: ["expiring","expired","aged","rotated","rotationFailed","retired"]
+	Kind string `json:"kind,omitempty"`
+
+	// message
+	Message string `json:"message,omitempty"`
+
+	// Access key replacing a rotated one
+	NewAccessKey string `json:"newAccessKey,omitempty"`
+
+	// parent user
+	ParentUser string `json:"parentUser,omitempty"`
+
+	// Secret key of the replacing access key, only returned once to its owner by the console that rotated it
+	SecretKey string `json:"secretKey,omitempty"`
+
+	// time
+	Time string `json:"time,omitempty"`
+}
+
+// Validate validates this service account notification
+func (m *ServiceAccountNotification) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var serviceAccountNotificationTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["expiring","expired","aged","rotated","rotationFailed","retired"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		serviceAccountNotificationTypeKindPropEnum = append(serviceAccountNotificationTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// ServiceAccountNotificationKindExpiring captures enum value "expiring"
+	ServiceAccountNotificationKindExpiring string = "expiring"
+
+	// ServiceAccountNotificationKindExpired captures enum value "expired"
+	ServiceAccountNotificationKindExpired string = "expired"
+
+	// ServiceAccountNotificationKindAged captures enum value "aged"
+	ServiceAccountNotificationKindAged string = "aged"
+
+	// ServiceAccountNotificationKindRotated captures enum value "rotated"
+	ServiceAccountNotificationKindRotated string = "rotated"
+
+	// ServiceAccountNotificationKindRotationFailed captures enum value "rotationFailed"
+	ServiceAccountNotificationKindRotationFailed string = "rotationFailed"
+
+	// ServiceAccountNotificationKindRetired captures enum value "retired"
+	ServiceAccountNotificationKindRetired string = "retired"
+)
+
+// prop value enum
+func (m *ServiceAccountNotification) validateKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, serviceAccountNotificationTypeKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ServiceAccountNotification) validateKind(formats strfmt.Registry) error {
+	if swag.IsZero(m.Kind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this service account notification based on context it is used
+func (m *ServiceAccountNotification) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ServiceAccountNotification) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ServiceAccountNotification) UnmarshalBinary(b []byte) error {
+	var res ServiceAccountNotification
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
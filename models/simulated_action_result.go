@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SimulatedActionResult simulated action result
+//
+// swagger:model simulatedActionResult
+type SimulatedActionResult struct {
+
+	// action
+	Action string `json:"action,omitempty"`
+
+	// allowed
+	Allowed bool `json:"allowed,omitempty"`
+
+	// matched statements
+	MatchedStatements []*MatchedStatement `json:"matchedStatements"`
+
+	// Why the action is denied regardless of the policies, e.g. the account is disabled
+	Reason string `json:"reason,omitempty"`
+}
+
+// Validate validates this simulated action result
+func (m *SimulatedActionResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMatchedStatements(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SimulatedActionResult) validateMatchedStatements(formats strfmt.Registry) error {
+	if swag.IsZero(m.MatchedStatements) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MatchedStatements); i++ {
+		if swag.IsZero(m.MatchedStatements[i]) { // not required
+			continue
+		}
+
+		if m.MatchedStatements[i] != nil {
+			if err := m.MatchedStatements[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("matchedStatements" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("matchedStatements" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this simulated action result based on the context it is used
+func (m *SimulatedActionResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMatchedStatements(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SimulatedActionResult) contextValidateMatchedStatements(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.MatchedStatements); i++ {
+
+		if m.MatchedStatements[i] != nil {
+
+			if swag.IsZero(m.MatchedStatements[i]) { // not required
+				return nil
+			}
+
+			if err := m.MatchedStatements[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("matchedStatements" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("matchedStatements" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SimulatedActionResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SimulatedActionResult) UnmarshalBinary(b []byte) error {
+	var res SimulatedActionResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SimulatedPolicy simulated policy
+//
+// swagger:model simulatedPolicy
+type SimulatedPolicy struct {
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// source
+	Source string `json:"source,omitempty"`
+}
+
+// Validate validates this simulated policy
+func (m *SimulatedPolicy) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this simulated policy based on context it is used
+func (m *SimulatedPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SimulatedPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SimulatedPolicy) UnmarshalBinary(b []byte) error {
+	var res SimulatedPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// SimulationEntity simulation entity
+//
+// swagger:model simulationEntity
+type SimulationEntity string
+
+func NewSimulationEntity(value SimulationEntity) *SimulationEntity {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated SimulationEntity.
+func (m SimulationEntity) Pointer() *SimulationEntity {
+	return &m
+}
+
+const (
+
+	// SimulationEntityUser captures enum value "user"
+	SimulationEntityUser SimulationEntity = "user"
+
+	// SimulationEntityGroup captures enum value "group"
+	SimulationEntityGroup SimulationEntity = "group"
+
+	// SimulationEntityServiceAccount captures enum value "serviceAccount"
+	SimulationEntityServiceAccount SimulationEntity = "serviceAccount"
+)
+
+// for schema
+var simulationEntityEnum []interface{}
+
+func init() {
+	var res []SimulationEntity
+	if err := json.Unmarshal([]byte(`["user","group","serviceAccount"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		simulationEntityEnum = append(simulationEntityEnum, v)
+	}
+}
+
+func (m SimulationEntity) validateSimulationEntityEnum(path, location string, value SimulationEntity) error {
+	if err := validate.EnumCase(path, location, value, simulationEntityEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this simulation entity
+func (m SimulationEntity) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateSimulationEntityEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this simulation entity based on context it is used
+func (m SimulationEntity) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// StaleIdentityCleanupItem stale identity cleanup item
+//
+// swagger:model staleIdentityCleanupItem
+type StaleIdentityCleanupItem struct {
+
+	// entity type
+	EntityType string `json:"entityType,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this stale identity cleanup item
+func (m *StaleIdentityCleanupItem) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this stale identity cleanup item based on context it is used
+func (m *StaleIdentityCleanupItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaleIdentityCleanupItem) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaleIdentityCleanupItem) UnmarshalBinary(b []byte) error {
+	var res StaleIdentityCleanupItem
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
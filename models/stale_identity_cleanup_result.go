@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// StaleIdentityCleanupResult stale identity cleanup result
+//
+// swagger:model staleIdentityCleanupResult
+type StaleIdentityCleanupResult struct {
+
+	// entity type
+	EntityType string `json:"entityType,omitempty"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// status
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this stale identity cleanup result
+func (m *StaleIdentityCleanupResult) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this stale identity cleanup result based on context it is used
+func (m *StaleIdentityCleanupResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaleIdentityCleanupResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaleIdentityCleanupResult) UnmarshalBinary(b []byte) error {
+	var res StaleIdentityCleanupResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StaleIdentityFinding stale identity finding
+//
+// swagger:model staleIdentityFinding
+type StaleIdentityFinding struct {
+
+	// cleanup action
+	CleanupAction string `json:"cleanupAction,omitempty"`
+
+	// detail
+	Detail string `json:"detail,omitempty"`
+
+	// entity type
+	EntityType string `json:"entityType,omitempty"`
+
+	// kind
+	// Enum: ["userWithoutPolicy","emptyGroup","unusedPolicy","disabledUser","orphanServiceAccount"]
+	Kind string `json:"kind,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this stale identity finding
+func (m *StaleIdentityFinding) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var staleIdentityFindingTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["userWithoutPolicy","emptyGroup","unusedPolicy","disabledUser","orphanServiceAccount"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		staleIdentityFindingTypeKindPropEnum = append(staleIdentityFindingTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// StaleIdentityFindingKindUserWithoutPolicy captures enum value "userWithoutPolicy"
+	StaleIdentityFindingKindUserWithoutPolicy string = "userWithoutPolicy"
+
+	// StaleIdentityFindingKindEmptyGroup captures enum value "emptyGroup"
+	StaleIdentityFindingKindEmptyGroup string = "emptyGroup"
+
+	// StaleIdentityFindingKindUnusedPolicy captures enum value "unusedPolicy"
+	StaleIdentityFindingKindUnusedPolicy string = "unusedPolicy"
+
+	// StaleIdentityFindingKindDisabledUser captures enum value "disabledUser"
+	StaleIdentityFindingKindDisabledUser string = "disabledUser"
+
+	// StaleIdentityFindingKindOrphanServiceAccount captures enum value "orphanServiceAccount"
+	StaleIdentityFindingKindOrphanServiceAccount string = "orphanServiceAccount"
+)
+
+// prop value enum
+func (m *StaleIdentityFinding) validateKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, staleIdentityFindingTypeKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *StaleIdentityFinding) validateKind(formats strfmt.Registry) error {
+	if swag.IsZero(m.Kind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this stale identity finding based on context it is used
+func (m *StaleIdentityFinding) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaleIdentityFinding) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaleIdentityFinding) UnmarshalBinary(b []byte) error {
+	var res StaleIdentityFinding
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -22,6 +22,12 @@ type User struct {
 	// access key
 	AccessKey string `json:"accessKey,omitempty"`
 
+	// delete at
+	DeleteAt string `json:"deleteAt,omitempty"`
+
+	// expires at
+	ExpiresAt string `json:"expiresAt,omitempty"`
+
 	// has policy
 	HasPolicy bool `json:"hasPolicy,omitempty"`
 
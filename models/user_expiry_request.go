@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// UserExpiryRequest user expiry request
+//
+// swagger:model userExpiryRequest
+type UserExpiryRequest struct {
+
+	// RFC 3339 date the user is disabled at, the expiry is cleared when empty
+	ExpiresAt string `json:"expiresAt,omitempty"`
+
+	// how long the user is kept once disabled before it is deleted, e.g. 720h, it is never deleted when empty
+	Retention string `json:"retention,omitempty"`
+}
+
+// Validate validates this user expiry request
+func (m *UserExpiryRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this user expiry request based on context it is used
+func (m *UserExpiryRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UserExpiryRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UserExpiryRequest) UnmarshalBinary(b []byte) error {
+	var res UserExpiryRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
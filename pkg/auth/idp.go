@@ -18,6 +18,7 @@ import (
 type IdentityProviderI interface {
 	VerifyIdentity(ctx context.Context, code, state string) (*credentials.Credentials, error)
 	VerifyIdentityForOperator(ctx context.Context, code, state string) (*xoauth2.Token, error)
+	RefreshIdentity(ctx context.Context, refreshToken string) (*credentials.Credentials, error)
 	GenerateLoginURL() string
 }
 
@@ -41,6 +42,11 @@ func (c IdentityProvider) VerifyIdentityForOperator(ctx context.Context, code, s
 	return c.Client.VerifyIdentityForOperator(ctx, code, state, c.KeyFunc)
 }
 
+// RefreshIdentity will get new credentials for the user using the refresh token issued by the idp
+func (c IdentityProvider) RefreshIdentity(ctx context.Context, refreshToken string) (*credentials.Credentials, error) {
+	return c.Client.RefreshIdentity(ctx, refreshToken, c.RoleARN)
+}
+
 // GenerateLoginURL returns a new URL used by the user to login against the idp
 func (c IdentityProvider) GenerateLoginURL() string {
 	return c.Client.GenerateLoginURL(c.KeyFunc, c.Client.IDPName)
@@ -29,7 +29,9 @@ type ProviderConfig struct {
 	RedirectCallbackDynamic  bool
 	RedirectCallback         string
 	EndSessionEndpoint       string
-	RoleArn                  string // can be empty
+	RoleArn                  string        // can be empty
+	PKCE                     bool          // always enabled for public clients (no ClientSecret)
+	FeatureRules             []FeatureRule // defaults to the rules in CONSOLE_IDP_FEATURE_RULES
 }
 
 // GetOauth2Provider instantiates a new oauth2 client using the configured credentials
@@ -60,6 +62,18 @@ func (pc ProviderConfig) GetOauth2Provider(name string, scopes []string, r *http
 		return nil, fmt.Errorf("expected 'code' response type - got %s, login not allowed", ddoc.ResponseTypesSupported)
 	}
 
+	pkce, err := usePKCE(pc.PKCE || pc.ClientSecret == "", ddoc)
+	if err != nil {
+		return nil, err
+	}
+
+	featureRules := pc.FeatureRules
+	if len(featureRules) == 0 {
+		if featureRules, err = GetIDPFeatureRules(); err != nil {
+			return nil, err
+		}
+	}
+
 	// If provided scopes are empty we use the user configured list or a default list.
 	if len(scopes) == 0 {
 		for _, s := range strings.Split(pc.Scopes, ",") {
@@ -98,6 +112,8 @@ func (pc ProviderConfig) GetOauth2Provider(name string, scopes []string, r *http
 
 	client.IDPName = name
 	client.UserInfo = pc.Userinfo
+	client.pkce = pkce
+	client.featureRules = featureRules
 	client.client = clnt
 
 	return client, nil
@@ -138,6 +154,11 @@ func GetIDPSecret() string {
 	return env.Get(ConsoleIDPSecret, "")
 }
 
+// GetIDPPKCE returns true if PKCE should be used even though a client secret is configured
+func GetIDPPKCE() bool {
+	return env.Get(ConsoleIDPPKCE, "") == "on"
+}
+
 // Public endpoint used by the identity oidcProvider when redirecting
 // the user after identity verification
 func GetIDPCallbackURL() string {
@@ -17,4 +17,6 @@ const (
 	ConsoleIDPScopes             = "CONSOLE_IDP_SCOPES"
 	ConsoleIDPUserInfo           = "CONSOLE_IDP_USERINFO"
 	ConsoleIDPTokenExpiration    = "CONSOLE_IDP_TOKEN_EXPIRATION"
+	ConsoleIDPPKCE               = "CONSOLE_IDP_PKCE"
+	ConsoleIDPFeatureRules       = "CONSOLE_IDP_FEATURE_RULES"
 )
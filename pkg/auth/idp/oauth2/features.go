@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package oauth2
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/openstor/pkg/v3/env"
+)
+
+// FeatureRule maps the claims of the id_token issued by the IDP to the console features
+// enabled for the session, e.g. users with `groups` containing `contractors` only get the object browser.
+type FeatureRule struct {
+	// Claim is the name of the claim to evaluate, nested claims are separated by dots, e.g. `realm_access.roles`
+	Claim string `json:"claim"`
+	// Value must be equal to the claim value, or to any of its elements if the claim is a list
+	Value         string `json:"value"`
+	HideMenu      bool   `json:"hideMenu,omitempty"`
+	ObjectBrowser bool   `json:"objectBrowser,omitempty"`
+	// CustomStyle is a base64 encoded style configuration, same format as the one used by the object browser
+	CustomStyle string `json:"customStyle,omitempty"`
+}
+
+// Features are the console features a set of claims is mapped to
+type Features struct {
+	HideMenu      bool
+	ObjectBrowser bool
+	CustomStyleOB string
+}
+
+// GetIDPFeatureRules returns the rules configured with CONSOLE_IDP_FEATURE_RULES as a JSON list
+func GetIDPFeatureRules() ([]FeatureRule, error) {
+	value := env.Get(ConsoleIDPFeatureRules, "")
+	if value == "" {
+		return nil, nil
+	}
+	var rules []FeatureRule
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", ConsoleIDPFeatureRules, err)
+	}
+	for _, rule := range rules {
+		if rule.Claim == "" {
+			return nil, fmt.Errorf("invalid %s: claim is required", ConsoleIDPFeatureRules)
+		}
+	}
+	return rules, nil
+}
+
+// MatchFeatureRules evaluates the rules in order against the claims, the first
+// matching rule decides the features of the session. It returns nil if no rule matches.
+func MatchFeatureRules(rules []FeatureRule, claims map[string]interface{}) *Features {
+	for _, rule := range rules {
+		if claimMatches(lookupClaim(claims, rule.Claim), rule.Value) {
+			return &Features{
+				HideMenu:      rule.HideMenu,
+				ObjectBrowser: rule.ObjectBrowser,
+				CustomStyleOB: rule.CustomStyle,
+			}
+		}
+	}
+	return nil
+}
+
+func lookupClaim(claims map[string]interface{}, name string) interface{} {
+	var value interface{} = claims
+	for _, key := range strings.Split(name, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+func claimMatches(claim interface{}, value string) bool {
+	switch c := claim.(type) {
+	case nil:
+		return false
+	case []interface{}:
+		for _, v := range c {
+			if claimMatches(v, value) {
+				return true
+			}
+		}
+		return false
+	case string:
+		return c == value
+	default:
+		return fmt.Sprint(c) == value
+	}
+}
+
+// parseIDTokenClaims returns the claims of the id_token payload. The signature is not verified since
+// the token was received directly from the token endpoint and MinIO verifies it when exchanging it for
+// sts credentials.
+func parseIDTokenClaims(idToken string) (map[string]interface{}, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed id_token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, err
+	}
+	claims := map[string]interface{}{}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
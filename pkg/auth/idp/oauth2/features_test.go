@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package oauth2
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchFeatureRules(t *testing.T) {
+	rules := []FeatureRule{
+		{Claim: "groups", Value: "contractors", HideMenu: true, ObjectBrowser: true},
+		{Claim: "department", Value: "finance", CustomStyle: "c3R5bGU="},
+		{Claim: "realm_access.roles", Value: "auditor", HideMenu: true},
+		{Claim: "email_verified", Value: "false", ObjectBrowser: true},
+	}
+	tests := []struct {
+		name   string
+		claims map[string]interface{}
+		want   *Features
+	}{
+		{
+			name:   "no matching rule",
+			claims: map[string]interface{}{"groups": []interface{}{"admins"}, "department": "sales"},
+			want:   nil,
+		},
+		{
+			name:   "list claim contains the value",
+			claims: map[string]interface{}{"groups": []interface{}{"admins", "contractors"}},
+			want:   &Features{HideMenu: true, ObjectBrowser: true},
+		},
+		{
+			name:   "string claim is the value",
+			claims: map[string]interface{}{"department": "finance"},
+			want:   &Features{CustomStyleOB: "c3R5bGU="},
+		},
+		{
+			name:   "first matching rule wins",
+			claims: map[string]interface{}{"groups": "contractors", "department": "finance"},
+			want:   &Features{HideMenu: true, ObjectBrowser: true},
+		},
+		{
+			name:   "nested claim",
+			claims: map[string]interface{}{"realm_access": map[string]interface{}{"roles": []interface{}{"auditor"}}},
+			want:   &Features{HideMenu: true},
+		},
+		{
+			name:   "non string claim",
+			claims: map[string]interface{}{"email_verified": false},
+			want:   &Features{ObjectBrowser: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchFeatureRules(rules, tt.claims); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatchFeatureRules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseIDTokenClaims(t *testing.T) {
+	funcAssert := assert.New(t)
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"user","groups":["contractors"]}`))
+	claims, err := parseIDTokenClaims("eyJhbGciOiJSUzI1NiJ9." + payload + ".signature")
+	funcAssert.NoError(err)
+	funcAssert.Equal("user", claims["sub"])
+	funcAssert.Equal([]interface{}{"contractors"}, claims["groups"])
+
+	_, err = parseIDTokenClaims("not-a-jwt")
+	funcAssert.Error(err)
+}
+
+func TestGetIDPFeatureRules(t *testing.T) {
+	funcAssert := assert.New(t)
+	t.Setenv(ConsoleIDPFeatureRules, "")
+	rules, err := GetIDPFeatureRules()
+	funcAssert.NoError(err)
+	funcAssert.Empty(rules)
+
+	t.Setenv(ConsoleIDPFeatureRules, `[{"claim":"groups","value":"contractors","objectBrowser":true}]`)
+	rules, err = GetIDPFeatureRules()
+	funcAssert.NoError(err)
+	funcAssert.Equal([]FeatureRule{{Claim: "groups", Value: "contractors", ObjectBrowser: true}}, rules)
+
+	t.Setenv(ConsoleIDPFeatureRules, `[{"value":"contractors"}]`)
+	_, err = GetIDPFeatureRules()
+	funcAssert.Error(err)
+
+	t.Setenv(ConsoleIDPFeatureRules, `{`)
+	_, err = GetIDPFeatureRules()
+	funcAssert.Error(err)
+}
@@ -6,7 +6,9 @@ package oauth2
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -99,6 +101,11 @@ type Provider struct {
 	// if enabled means that we need extrace access_token as well
 	UserInfo     bool
 	RefreshToken string
+	// Features are the session features mapped from the id_token claims, nil if no rule matched
+	Features *Features
+	// if enabled the authorization code flow is protected with PKCE (S256)
+	pkce         bool
+	featureRules []FeatureRule
 	oauth2Config Configuration
 	client       *http.Client
 }
@@ -134,6 +141,26 @@ func getLoginCallbackURL(r *http.Request) string {
 
 var requiredResponseTypes = set.CreateStringSet("code")
 
+const pkceMethodS256 = "S256"
+
+// usePKCE returns whether the authorization code flow should use PKCE, it is
+// always used when requested and the IDP either advertises S256 support or does
+// not advertise code challenge methods at all.
+func usePKCE(requested bool, ddoc DiscoveryDoc) (bool, error) {
+	if !requested {
+		return false, nil
+	}
+	if len(ddoc.CodeChallengeMethodsSupported) == 0 {
+		return true, nil
+	}
+	for _, method := range ddoc.CodeChallengeMethodsSupported {
+		if method == pkceMethodS256 {
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("expected '%s' code challenge method - got %s, login not allowed", pkceMethodS256, ddoc.CodeChallengeMethodsSupported)
+}
+
 // NewOauth2ProviderClient instantiates a new oauth2 client using the configured credentials
 // it returns a *Provider object that contains the necessary configuration to initiate an
 // oauth2 authentication flow.
@@ -161,6 +188,16 @@ func NewOauth2ProviderClient(scopes []string, r *http.Request, httpClient *http.
 		return nil, fmt.Errorf("expected 'code' response type - got %s, login not allowed", ddoc.ResponseTypesSupported)
 	}
 
+	pkce, err := usePKCE(GetIDPPKCE() || GetIDPSecret() == "", ddoc)
+	if err != nil {
+		return nil, err
+	}
+
+	featureRules, err := GetIDPFeatureRules()
+	if err != nil {
+		return nil, err
+	}
+
 	// If provided scopes are empty we use a default list or the user configured list
 	if len(scopes) == 0 {
 		scopes = strings.Split(getIDPScopes(), ",")
@@ -191,6 +228,8 @@ func NewOauth2ProviderClient(scopes []string, r *http.Request, httpClient *http.
 
 	client.IDPName = GetIDPClientID()
 	client.UserInfo = GetIDPUserInfo()
+	client.pkce = pkce
+	client.featureRules = featureRules
 	client.client = httpClient
 
 	return client, nil
@@ -266,7 +305,7 @@ func (client *Provider) VerifyIdentity(ctx context.Context, code, state, roleARN
 	}
 	getWebTokenExpiry := func() (*credentials.WebIdentityToken, error) {
 		customCtx := context.WithValue(ctx, oauth2.HTTPClient, client.client)
-		oauth2Token, err := client.oauth2Config.Exchange(customCtx, code)
+		oauth2Token, err := client.oauth2Config.Exchange(customCtx, code, client.exchangeOptions(state, keyFunc)...)
 		if err != nil {
 			return nil, err
 		}
@@ -274,62 +313,105 @@ func (client *Provider) VerifyIdentity(ctx context.Context, code, state, roleARN
 			return nil, errors.New("invalid token")
 		}
 		client.RefreshToken = oauth2Token.RefreshToken
+		return client.webIdentityToken(oauth2Token)
+	}
+	return client.stsWebIdentity(roleARN, getWebTokenExpiry), nil
+}
 
-		envStsDuration := env.Get(token.ConsoleSTSDuration, "")
-		stsDuration, err := time.ParseDuration(envStsDuration)
-
-		expiration := 12 * time.Hour
-
-		if err == nil && stsDuration > 0 {
-			expiration = stsDuration
-		} else {
-			// Use the expiration configured in the token itself if it is closer than the configured value
-			if exp := oauth2Token.Expiry.Sub(time.Now().UTC()); exp < expiration {
-				expiration = exp
-			}
-		}
-
-		// Minimum duration in S3 spec is 15 minutes, do not bother returning
-		// an error to the user and force the minimum duration instead
-		if expiration < 900*time.Second {
-			expiration = 900 * time.Second
-		}
-
-		idToken := oauth2Token.Extra("id_token")
-		if idToken == nil {
-			return nil, errors.New("missing id_token")
+// RefreshIdentity uses the refresh token issued by the IDP during login to obtain a new id_token
+// and exchanges it against MinIO for a new set of sts credentials, this allows renewing a session
+// without the user going through the authorization code flow again.
+func (client *Provider) RefreshIdentity(ctx context.Context, refreshToken, roleARN string) (*credentials.Credentials, error) {
+	if refreshToken == "" {
+		return nil, errors.New("missing refresh_token")
+	}
+	getWebTokenExpiry := func() (*credentials.WebIdentityToken, error) {
+		customCtx := context.WithValue(ctx, oauth2.HTTPClient, client.client)
+		// an expired token forces the token source to use the refresh token grant
+		oauth2Token, err := client.oauth2Config.TokenSource(customCtx, &xoauth2.Token{
+			RefreshToken: refreshToken,
+			Expiry:       time.Now().Add(-time.Minute),
+		}).Token()
+		if err != nil {
+			return nil, err
 		}
-		token := &credentials.WebIdentityToken{
-			Token:  idToken.(string),
-			Expiry: int(expiration.Seconds()),
+		if !oauth2Token.Valid() {
+			return nil, errors.New("invalid token")
 		}
-		if client.UserInfo { // look for access_token only if userinfo is requested.
-			accessToken := oauth2Token.Extra("access_token")
-			if accessToken == nil {
-				return nil, errors.New("missing access_token")
-			}
-			token.AccessToken = accessToken.(string)
-			refreshToken := oauth2Token.Extra("refresh_token")
-			if refreshToken != nil {
-				token.RefreshToken = refreshToken.(string)
-			} else { //nolint:revive,staticcheck
-				// TODO in Nov 2026 : add an error when the refresh token is not found.
-				// This is not done yet because users may not have access_offline scope
-				// and this may break their deployments
-			}
-
+		// IDPs rotating refresh tokens return a new one, otherwise the current one stays valid
+		client.RefreshToken = refreshToken
+		if oauth2Token.RefreshToken != "" {
+			client.RefreshToken = oauth2Token.RefreshToken
 		}
-		return token, nil
+		return client.webIdentityToken(oauth2Token)
 	}
-	stsEndpoint := GetSTSEndpoint()
+	return client.stsWebIdentity(roleARN, getWebTokenExpiry), nil
+}
 
-	sts := credentials.New(&credentials.STSWebIdentity{
+// stsWebIdentity returns the credentials obtained from MinIO using AssumeRoleWithWebIdentity
+func (client *Provider) stsWebIdentity(roleARN string, getWebTokenExpiry func() (*credentials.WebIdentityToken, error)) *credentials.Credentials {
+	return credentials.New(&credentials.STSWebIdentity{
 		Client:              client.client,
-		STSEndpoint:         stsEndpoint,
+		STSEndpoint:         GetSTSEndpoint(),
 		GetWebIDTokenExpiry: getWebTokenExpiry,
 		RoleARN:             roleARN,
 	})
-	return sts, nil
+}
+
+// webIdentityToken builds the web identity token sent to MinIO out of the token returned by the IDP
+func (client *Provider) webIdentityToken(oauth2Token *xoauth2.Token) (*credentials.WebIdentityToken, error) {
+	envStsDuration := env.Get(token.ConsoleSTSDuration, "")
+	stsDuration, err := time.ParseDuration(envStsDuration)
+
+	expiration := 12 * time.Hour
+
+	if err == nil && stsDuration > 0 {
+		expiration = stsDuration
+	} else {
+		// Use the expiration configured in the token itself if it is closer than the configured value
+		if exp := oauth2Token.Expiry.Sub(time.Now().UTC()); exp < expiration {
+			expiration = exp
+		}
+	}
+
+	// Minimum duration in S3 spec is 15 minutes, do not bother returning
+	// an error to the user and force the minimum duration instead
+	if expiration < 900*time.Second {
+		expiration = 900 * time.Second
+	}
+
+	idToken := oauth2Token.Extra("id_token")
+	if idToken == nil {
+		return nil, errors.New("missing id_token")
+	}
+	if len(client.featureRules) > 0 {
+		claims, err := parseIDTokenClaims(idToken.(string))
+		if err != nil {
+			return nil, err
+		}
+		client.Features = MatchFeatureRules(client.featureRules, claims)
+	}
+	token := &credentials.WebIdentityToken{
+		Token:  idToken.(string),
+		Expiry: int(expiration.Seconds()),
+	}
+	if client.UserInfo { // look for access_token only if userinfo is requested.
+		accessToken := oauth2Token.Extra("access_token")
+		if accessToken == nil {
+			return nil, errors.New("missing access_token")
+		}
+		token.AccessToken = accessToken.(string)
+		refreshToken := oauth2Token.Extra("refresh_token")
+		if refreshToken != nil {
+			token.RefreshToken = refreshToken.(string)
+		} else { //nolint:revive,staticcheck
+			// TODO in Nov 2026 : add an error when the refresh token is not found.
+			// This is not done yet because users may not have access_offline scope
+			// and this may break their deployments
+		}
+
+	}
+	return token, nil
 }
 
 // VerifyIdentityForOperator will contact the configured IDP and validate the user identity based on the authorization code and state
@@ -339,7 +421,7 @@ func (client *Provider) VerifyIdentityForOperator(ctx context.Context, code, sta
 		return nil, err
 	}
 	customCtx := context.WithValue(ctx, oauth2.HTTPClient, client.client)
-	oauth2Token, err := client.oauth2Config.Exchange(customCtx, code)
+	oauth2Token, err := client.oauth2Config.Exchange(customCtx, code, client.exchangeOptions(state, keyFunc)...)
 	if err != nil {
 		return nil, err
 	}
@@ -349,6 +431,23 @@ func (client *Provider) VerifyIdentityForOperator(ctx context.Context, code, sta
 	return oauth2Token, nil
 }
 
+// pkceVerifier derives the PKCE code verifier from the signed state, this way console does not need
+// to keep track of the verifier between the login redirect and the callback, while it still can't be
+// computed by anyone not knowing the key used to sign the state.
+func pkceVerifier(state string, keyFunc StateKeyFunc) string {
+	mac := hmac.New(sha256.New, keyFunc())
+	mac.Write([]byte("pkce:" + state))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// exchangeOptions returns the options used when exchanging the authorization code for a token
+func (client *Provider) exchangeOptions(state string, keyFunc StateKeyFunc) []xoauth2.AuthCodeOption {
+	if !client.pkce {
+		return nil
+	}
+	return []xoauth2.AuthCodeOption{xoauth2.VerifierOption(pkceVerifier(state, keyFunc))}
+}
+
 // validateOauth2State validates the provided state was originated using the same
 // instance (or one configured using the same secrets) of Console, this is basically used to prevent CSRF attacks
 // https://security.stackexchange.com/questions/20187/oauth2-cross-site-request-forgery-and-state-parameter
@@ -395,7 +494,7 @@ func parseDiscoveryDoc(ctx context.Context, ustr string, httpClient *http.Client
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return d, err
+		return d, fmt.Errorf("unable to fetch the discovery doc: %s", resp.Status)
 	}
 	dec := json.NewDecoder(resp.Body)
 	if err = dec.Decode(&d); err != nil {
@@ -404,6 +503,19 @@ func parseDiscoveryDoc(ctx context.Context, ustr string, httpClient *http.Client
 	return d, nil
 }
 
+// CheckDiscoveryDoc verifies the discovery doc of an OAuth provider can be fetched and has the endpoints the
+// authorization code flow needs
+func CheckDiscoveryDoc(ctx context.Context, ustr string, httpClient *http.Client) error {
+	ddoc, err := parseDiscoveryDoc(ctx, ustr, httpClient)
+	if err != nil {
+		return err
+	}
+	if ddoc.AuthEndpoint == "" || ddoc.TokenEndpoint == "" {
+		return errors.New("the discovery doc has no authorization or token endpoint")
+	}
+	return nil
+}
+
 // GetRandomStateWithHMAC computes message + hmac(message, pbkdf2(key, salt)) to be used as state during the oauth authorization
 func GetRandomStateWithHMAC(length int, keyFunc StateKeyFunc) string {
 	state := utils.RandomCharString(length)
@@ -438,7 +550,11 @@ func (client *Provider) GenerateLoginURL(keyFunc StateKeyFunc, iDPName string) s
 	}
 
 	stEncode := base64.StdEncoding.EncodeToString(jsonEnc)
-	loginURL := client.oauth2Config.AuthCodeURL(stEncode)
+	var opts []xoauth2.AuthCodeOption
+	if client.pkce {
+		opts = append(opts, xoauth2.S256ChallengeOption(pkceVerifier(state, keyFunc)))
+	}
+	loginURL := client.oauth2Config.AuthCodeURL(stEncode, opts...)
 
 	return strings.TrimSpace(loginURL)
 }
@@ -6,7 +6,11 @@ package oauth2
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -57,3 +61,69 @@ func TestGenerateLoginURL(t *testing.T) {
 	url := oauth2Provider.GenerateLoginURL(DefaultDerivedKey, "testIDP")
 	funcAssert.NotEqual("", url)
 }
+
+func TestUsePKCE(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested bool
+		methods   []string
+		want      bool
+		wantErr   bool
+	}{
+		{name: "not requested", requested: false, methods: []string{"S256"}, want: false},
+		{name: "requested and advertised", requested: true, methods: []string{"plain", "S256"}, want: true},
+		{name: "requested and nothing advertised", requested: true, want: true},
+		{name: "requested and only plain advertised", requested: true, methods: []string{"plain"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := usePKCE(tt.requested, DiscoveryDoc{CodeChallengeMethodsSupported: tt.methods})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usePKCE() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("usePKCE() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPKCEAuthorizationCodeFlow(t *testing.T) {
+	funcAssert := assert.New(t)
+	var codeVerifier string
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		codeVerifier = r.PostForm.Get("code_verifier")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access","token_type":"Bearer","refresh_token":"refresh","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	oauth2Provider := Provider{
+		pkce:   true,
+		client: tokenServer.Client(),
+		oauth2Config: &oauth2.Config{
+			ClientID: "console",
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  "https://idp.example.com/auth",
+				TokenURL: tokenServer.URL,
+			},
+		},
+	}
+	loginURL, err := url.Parse(oauth2Provider.GenerateLoginURL(DefaultDerivedKey, "testIDP"))
+	funcAssert.NoError(err)
+	query := loginURL.Query()
+	funcAssert.Equal("S256", query.Get("code_challenge_method"))
+
+	stateJSON, err := base64.StdEncoding.DecodeString(query.Get("state"))
+	funcAssert.NoError(err)
+	var lgParams LoginURLParams
+	funcAssert.NoError(json.Unmarshal(stateJSON, &lgParams))
+
+	_, err = oauth2Provider.VerifyIdentityForOperator(context.Background(), "code", lgParams.State, DefaultDerivedKey)
+	funcAssert.NoError(err)
+	// the verifier sent on the exchange must match the challenge sent on the login URL
+	funcAssert.NotEmpty(codeVerifier)
+	funcAssert.Equal(query.Get("code_challenge"), oauth2.S256ChallengeFromVerifier(codeVerifier))
+}
@@ -10,6 +10,47 @@ import (
 	"github.com/openstor/pkg/v3/env"
 )
 
+// Default filters searching the directory, %s is replaced by the text searched
+const (
+	defaultUserSearchFilter  = "(&(|(objectClass=person)(objectClass=posixAccount))(|(uid=*%s*)(cn=*%s*)))"
+	defaultGroupSearchFilter = "(&(|(objectClass=groupOfNames)(objectClass=groupOfUniqueNames)(objectClass=posixGroup)(objectClass=group))(cn=*%s*))"
+)
+
 func GetLDAPEnabled() bool {
 	return strings.ToLower(env.Get(ConsoleLDAPEnabled, "off")) == "on"
 }
+
+// GetServerAddr returns the host:port of the LDAP server the directory is searched on
+func GetServerAddr() string {
+	return env.Get(ConsoleLDAPServerAddr, "")
+}
+
+// GetServerInsecure returns whether the LDAP server is reached without TLS
+func GetServerInsecure() bool {
+	return strings.ToLower(env.Get(ConsoleLDAPServerInsecure, "off")) == "on"
+}
+
+// GetServerStartTLS returns whether the connection to the LDAP server is upgraded with StartTLS
+func GetServerStartTLS() bool {
+	return strings.ToLower(env.Get(ConsoleLDAPServerStartTLS, "off")) == "on"
+}
+
+// GetTLSSkipVerify returns whether the certificate of the LDAP server isn't verified
+func GetTLSSkipVerify() bool {
+	return strings.ToLower(env.Get(ConsoleLDAPTLSSkipVerify, "off")) == "on"
+}
+
+// GetLookupBind returns the read-only credentials the directory is searched with
+func GetLookupBind() (dn, password string) {
+	return env.Get(ConsoleLDAPLookupBindDN, ""), env.Get(ConsoleLDAPLookupBindPassword, "")
+}
+
+// GetUserSearch returns the base DNs, separated by `;`, and the filter the users are searched with
+func GetUserSearch() (baseDNs, filter string) {
+	return env.Get(ConsoleLDAPUserSearchBaseDN, ""), env.Get(ConsoleLDAPUserSearchFilter, defaultUserSearchFilter)
+}
+
+// GetGroupSearch returns the base DNs, separated by `;`, and the filter the groups are searched with
+func GetGroupSearch() (baseDNs, filter string) {
+	return env.Get(ConsoleLDAPGroupSearchBaseDN, ""), env.Get(ConsoleLDAPGroupSearchFilter, defaultGroupSearchFilter)
+}
@@ -7,4 +7,16 @@ package ldap
 const (
 	// const for ldap configuration
 	ConsoleLDAPEnabled = "CONSOLE_LDAP_ENABLED"
+
+	// consts for the lookup bind searching the directory
+	ConsoleLDAPServerAddr         = "CONSOLE_LDAP_SERVER_ADDR"
+	ConsoleLDAPServerInsecure     = "CONSOLE_LDAP_SERVER_INSECURE"
+	ConsoleLDAPServerStartTLS     = "CONSOLE_LDAP_SERVER_STARTTLS"
+	ConsoleLDAPTLSSkipVerify      = "CONSOLE_LDAP_TLS_SKIP_VERIFY"
+	ConsoleLDAPLookupBindDN       = "CONSOLE_LDAP_LOOKUP_BIND_DN"
+	ConsoleLDAPLookupBindPassword = "CONSOLE_LDAP_LOOKUP_BIND_PASSWORD"
+	ConsoleLDAPUserSearchBaseDN   = "CONSOLE_LDAP_USER_SEARCH_BASE_DN"
+	ConsoleLDAPUserSearchFilter   = "CONSOLE_LDAP_USER_SEARCH_FILTER"
+	ConsoleLDAPGroupSearchBaseDN  = "CONSOLE_LDAP_GROUP_SEARCH_BASE_DN"
+	ConsoleLDAPGroupSearchFilter  = "CONSOLE_LDAP_GROUP_SEARCH_FILTER"
 )
@@ -56,6 +56,9 @@ type TokenClaims struct {
 	HideMenu           bool   `json:"hm,omitempty"`
 	ObjectBrowser      bool   `json:"ob,omitempty"`
 	CustomStyleOB      string `json:"customStyleOb,omitempty"`
+	STSExpiration      int64  `json:"stsExp,omitempty"`
+	IDPName            string `json:"idp,omitempty"`
+	LastActivity       int64  `json:"lastActivity,omitempty"`
 }
 
 // STSClaims claims struct for STS Token
@@ -68,6 +71,8 @@ type SessionFeatures struct {
 	HideMenu      bool
 	ObjectBrowser bool
 	CustomStyleOB string
+	// IDPName is the name of the IDP the session was created with, if any
+	IDPName string
 }
 
 // SessionTokenAuthenticate takes a session token, decode it, extract claims and validate the signature
@@ -108,11 +113,16 @@ func NewEncryptedTokenForClient(credentials *credentials.Value, accountAccessKey
 			STSSecretAccessKey: credentials.SecretAccessKey,
 			STSSessionToken:    credentials.SessionToken,
 			AccountAccessKey:   accountAccessKey,
+			LastActivity:       time.Now().Unix(),
+		}
+		if !credentials.Expiration.IsZero() {
+			tokenClaims.STSExpiration = credentials.Expiration.Unix()
 		}
 		if features != nil {
 			tokenClaims.HideMenu = features.HideMenu
 			tokenClaims.ObjectBrowser = features.ObjectBrowser
 			tokenClaims.CustomStyleOB = features.CustomStyleOB
+			tokenClaims.IDPName = features.IDPName
 		}
 
 		encryptedClaims, err := encryptClaims(tokenClaims)
@@ -124,6 +134,12 @@ func NewEncryptedTokenForClient(credentials *credentials.Value, accountAccessKey
 	return "", errors.New("provided credentials are empty")
 }
 
+// NewEncryptedTokenFromClaims generates a new session token for already existing claims, e.g. to keep track of the
+// last activity of the session
+func NewEncryptedTokenFromClaims(claims *TokenClaims) (string, error) {
+	return encryptClaims(claims)
+}
+
 // encryptClaims() receives the STS claims, concatenate them and encrypt them using AES-GCM
 // returns a base64 encoded ciphertext
 func encryptClaims(credentials *TokenClaims) (string, error) {
@@ -8,17 +8,20 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/openstor/console/pkg/utils"
 
+	xhttp "github.com/openstor/console/pkg/http"
 	"github.com/openstor/console/pkg/logger/message/audit"
 )
 
@@ -154,6 +157,52 @@ func GetAuditEntry(ctx context.Context) *audit.Entry {
 	return nil
 }
 
+// maxAuditBodySize is the size of the largest request body recorded in the audit entries
+const maxAuditBodySize = 64 << 10
+
+// auditRequestBody records the request body while the handler reads it
+type auditRequestBody struct {
+	io.ReadCloser
+	buf       bytes.Buffer
+	truncated bool
+}
+
+func (b *auditRequestBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if remaining := maxAuditBodySize - b.buf.Len(); n > remaining {
+		b.buf.Write(p[:remaining])
+		b.truncated = true
+	} else {
+		b.buf.Write(p[:n])
+	}
+	return n, err
+}
+
+// decode returns the decoded JSON body, nil when it was truncated or isn't valid JSON since it couldn't be
+// redacted
+func (b *auditRequestBody) decode() interface{} {
+	if b.truncated || b.buf.Len() == 0 {
+		return nil
+	}
+	var body interface{}
+	if err := json.Unmarshal(b.buf.Bytes(), &body); err != nil {
+		return nil
+	}
+	return body
+}
+
+// RecordRequestBody wraps the JSON request body so AuditLog can record it once the handler read it, it is a
+// no-op unless the request bodies are audited
+func RecordRequestBody(r *http.Request) {
+	if !auditRequestBodyFlag || atomic.LoadInt32(&nAuditTargets) == 0 || r.Body == nil || r.Body == http.NoBody {
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get(xhttp.ContentType), "application/json") {
+		return
+	}
+	r.Body = &auditRequestBody{ReadCloser: r.Body}
+}
+
 // AuditLog - logs audit logs to all audit targets.
 func AuditLog(ctx context.Context, w *ResponseWriter, r *http.Request, reqClaims map[string]interface{}, filterKeys ...string) {
 	// Fast exit if there is not audit target configured
@@ -179,6 +228,10 @@ func AuditLog(ctx context.Context, w *ResponseWriter, r *http.Request, reqClaims
 			delete(entry.RespHeader, filterKey)
 		}
 
+		if body, ok := r.Body.(*auditRequestBody); ok {
+			entry.ReqBody = body.decode()
+		}
+
 		var (
 			statusCode      int
 			timeToResponse  time.Duration
@@ -215,12 +268,15 @@ func AuditLog(ctx context.Context, w *ResponseWriter, r *http.Request, reqClaims
 		}
 	}
 
+	// redact the entry before it reaches any target
+	redactEntry(&entry, auditRedactionRules)
+
 	if anonFlag {
 		entry.SessionID = hashString(entry.SessionID)
 		entry.RemoteHost = hashString(entry.RemoteHost)
 	}
 
-	// Send audit logs only to http targets.
+	// Send audit logs to the webhook, file and syslog targets.
 	for _, t := range AuditTargets() {
 		if err := t.Send(entry, string(All)); err != nil {
 			LogAlwaysIf(context.Background(), fmt.Errorf("event(%v) was not sent to Audit target (%v): %v", entry, t, err), All)
@@ -6,13 +6,18 @@ package logger
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/dustin/go-humanize"
 	"github.com/openstor/console/pkg/logger/config"
+	"github.com/openstor/console/pkg/logger/target/file"
 	"github.com/openstor/console/pkg/logger/target/http"
+	"github.com/openstor/console/pkg/logger/target/syslog"
 	"github.com/openstor/pkg/v3/env"
 )
 
@@ -21,6 +26,8 @@ func NewConfig() Config {
 	cfg := Config{
 		HTTP:         make(map[string]http.Config),
 		AuditWebhook: make(map[string]http.Config),
+		AuditFile:    make(map[string]file.Config),
+		AuditSyslog:  make(map[string]syslog.Config),
 	}
 
 	return cfg
@@ -154,12 +161,105 @@ func lookupAuditWebhookConfig() (Config, error) {
 		if queueSize <= 0 {
 			return cfg, errors.New("invalid queue_size value")
 		}
+		batchSize, err := strconv.Atoi(env.Get(targetEnv(EnvAuditWebhookBatchSize, target), "1"))
+		if err != nil {
+			return cfg, err
+		}
+		if batchSize <= 0 {
+			return cfg, errors.New("invalid batch_size value")
+		}
+		maxAttempts, err := strconv.Atoi(env.Get(targetEnv(EnvAuditWebhookMaxAttempts, target), "3"))
+		if err != nil {
+			return cfg, err
+		}
+		if maxAttempts <= 0 {
+			return cfg, errors.New("invalid max_attempts value")
+		}
 		cfg.AuditWebhook[target] = http.Config{
+			Enabled:     true,
+			Name:        target,
+			Endpoint:    env.Get(endpointEnv, ""),
+			AuthToken:   env.Get(authTokenEnv, ""),
+			ClientCert:  env.Get(clientCertEnv, ""),
+			ClientKey:   env.Get(clientKeyEnv, ""),
+			QueueSize:   queueSize,
+			QueueDir:    env.Get(targetEnv(EnvAuditWebhookQueueDir, target), ""),
+			BatchSize:   batchSize,
+			MaxAttempts: maxAttempts,
+		}
+	}
+
+	return cfg, nil
+}
+
+// targetEnv returns the environment variable of a named target, the default target uses the variable itself
+func targetEnv(key, target string) string {
+	if target == config.Default {
+		return key
+	}
+	return key + config.Default + target
+}
+
+// listTargets returns the names of the targets configured by the environment variable
+func listTargets(key string) []string {
+	var targets []string
+	for _, k := range env.List(key) {
+		target := strings.TrimPrefix(k, key+config.Default)
+		if target == key {
+			target = config.Default
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// lookupQueueSize returns the queue size set in the environment variable of the target
+func lookupQueueSize(key, target string) (int, error) {
+	queueSize, err := strconv.Atoi(env.Get(targetEnv(key, target), "100000"))
+	if err != nil {
+		return 0, err
+	}
+	if queueSize <= 0 {
+		return 0, errors.New("invalid queue_size value")
+	}
+	return queueSize, nil
+}
+
+func lookupAuditFileConfig() (Config, error) {
+	cfg := NewConfig()
+	for _, target := range listTargets(EnvAuditFilePath) {
+		enable, err := config.ParseBool(env.Get(targetEnv(EnvAuditFileEnable, target), ""))
+		if err != nil || !enable {
+			continue
+		}
+		maxSize, err := humanize.ParseBytes(env.Get(targetEnv(EnvAuditFileMaxSize, target), "100MiB"))
+		if err != nil {
+			return cfg, fmt.Errorf("invalid max_size value: %w", err)
+		}
+		maxAge, err := time.ParseDuration(env.Get(targetEnv(EnvAuditFileMaxAge, target), "0s"))
+		if err != nil || maxAge < 0 {
+			return cfg, errors.New("invalid max_age value")
+		}
+		maxBackups, err := strconv.Atoi(env.Get(targetEnv(EnvAuditFileMaxBackups, target), "0"))
+		if err != nil || maxBackups < 0 {
+			return cfg, errors.New("invalid max_backups value")
+		}
+		compress, err := config.ParseBool(env.Get(targetEnv(EnvAuditFileCompress, target), "on"))
+		if err != nil {
+			return cfg, err
+		}
+		queueSize, err := lookupQueueSize(EnvAuditFileQueueSize, target)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.AuditFile[target] = file.Config{
 			Enabled:    true,
-			Endpoint:   env.Get(endpointEnv, ""),
-			AuthToken:  env.Get(authTokenEnv, ""),
-			ClientCert: env.Get(clientCertEnv, ""),
-			ClientKey:  env.Get(clientKeyEnv, ""),
+			Name:       target,
+			Path:       env.Get(targetEnv(EnvAuditFilePath, target), ""),
+			MaxSize:    int64(maxSize),
+			MaxAge:     maxAge,
+			MaxBackups: maxBackups,
+			Compress:   compress,
 			QueueSize:  queueSize,
 		}
 	}
@@ -167,6 +267,36 @@ func lookupAuditWebhookConfig() (Config, error) {
 	return cfg, nil
 }
 
+func lookupAuditSyslogConfig() (Config, error) {
+	cfg := NewConfig()
+	for _, target := range listTargets(EnvAuditSyslogAddress) {
+		enable, err := config.ParseBool(env.Get(targetEnv(EnvAuditSyslogEnable, target), ""))
+		if err != nil || !enable {
+			continue
+		}
+		facilityName := strings.ToLower(env.Get(targetEnv(EnvAuditSyslogFacility, target), "local0"))
+		facility, ok := syslog.Facilities[facilityName]
+		if !ok {
+			return cfg, fmt.Errorf("invalid facility value %s", facilityName)
+		}
+		queueSize, err := lookupQueueSize(EnvAuditSyslogQueueSize, target)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.AuditSyslog[target] = syslog.Config{
+			Enabled:   true,
+			Name:      target,
+			Network:   strings.ToLower(env.Get(targetEnv(EnvAuditSyslogNetwork, target), syslog.NetworkUDP)),
+			Address:   env.Get(targetEnv(EnvAuditSyslogAddress, target), ""),
+			Facility:  facility,
+			AppName:   env.Get(targetEnv(EnvAuditSyslogAppName, target), "console"),
+			QueueSize: queueSize,
+		}
+	}
+
+	return cfg, nil
+}
+
 // LookupConfigForSubSys - lookup logger config, override with ENVs if set, for the given sub-system
 func LookupConfigForSubSys(subSys string) (cfg Config, err error) {
 	switch subSys {
@@ -178,6 +308,14 @@ func LookupConfigForSubSys(subSys string) (cfg Config, err error) {
 		if cfg, err = lookupAuditWebhookConfig(); err != nil {
 			return cfg, err
 		}
+	case config.AuditFileSubSys:
+		if cfg, err = lookupAuditFileConfig(); err != nil {
+			return cfg, err
+		}
+	case config.AuditSyslogSubSys:
+		if cfg, err = lookupAuditSyslogConfig(); err != nil {
+			return cfg, err
+		}
 	}
 	return cfg, nil
 }
@@ -17,4 +17,6 @@ const (
 const (
 	LoggerWebhookSubSys = "logger_webhook"
 	AuditWebhookSubSys  = "audit_webhook"
+	AuditFileSubSys     = "audit_file"
+	AuditSyslogSubSys   = "audit_syslog"
 )
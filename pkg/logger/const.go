@@ -7,7 +7,9 @@ package logger
 import (
 	"context"
 
+	"github.com/openstor/console/pkg/logger/target/file"
 	"github.com/openstor/console/pkg/logger/target/http"
+	"github.com/openstor/console/pkg/logger/target/syslog"
 )
 
 // Audit/Logger constants
@@ -24,18 +26,42 @@ const (
 	EnvLoggerWebhookClientKey  = "CONSOLE_LOGGER_WEBHOOK_CLIENT_KEY"
 	EnvLoggerWebhookQueueSize  = "CONSOLE_LOGGER_WEBHOOK_QUEUE_SIZE"
 
-	EnvAuditWebhookEnable     = "CONSOLE_AUDIT_WEBHOOK_ENABLE"
-	EnvAuditWebhookEndpoint   = "CONSOLE_AUDIT_WEBHOOK_ENDPOINT"
-	EnvAuditWebhookAuthToken  = "CONSOLE_AUDIT_WEBHOOK_AUTH_TOKEN"
-	EnvAuditWebhookClientCert = "CONSOLE_AUDIT_WEBHOOK_CLIENT_CERT"
-	EnvAuditWebhookClientKey  = "CONSOLE_AUDIT_WEBHOOK_CLIENT_KEY"
-	EnvAuditWebhookQueueSize  = "CONSOLE_AUDIT_WEBHOOK_QUEUE_SIZE"
+	EnvAuditWebhookEnable      = "CONSOLE_AUDIT_WEBHOOK_ENABLE"
+	EnvAuditWebhookEndpoint    = "CONSOLE_AUDIT_WEBHOOK_ENDPOINT"
+	EnvAuditWebhookAuthToken   = "CONSOLE_AUDIT_WEBHOOK_AUTH_TOKEN"
+	EnvAuditWebhookClientCert  = "CONSOLE_AUDIT_WEBHOOK_CLIENT_CERT"
+	EnvAuditWebhookClientKey   = "CONSOLE_AUDIT_WEBHOOK_CLIENT_KEY"
+	EnvAuditWebhookQueueSize   = "CONSOLE_AUDIT_WEBHOOK_QUEUE_SIZE"
+	EnvAuditWebhookQueueDir    = "CONSOLE_AUDIT_WEBHOOK_QUEUE_DIR"
+	EnvAuditWebhookBatchSize   = "CONSOLE_AUDIT_WEBHOOK_BATCH_SIZE"
+	EnvAuditWebhookMaxAttempts = "CONSOLE_AUDIT_WEBHOOK_MAX_ATTEMPTS"
+
+	EnvAuditRequestBody    = "CONSOLE_AUDIT_REQUEST_BODY"
+	EnvAuditRedactionRules = "CONSOLE_AUDIT_REDACTION_RULES"
+	EnvAuditRedactionFile  = "CONSOLE_AUDIT_REDACTION_FILE"
+
+	EnvAuditFileEnable     = "CONSOLE_AUDIT_FILE_ENABLE"
+	EnvAuditFilePath       = "CONSOLE_AUDIT_FILE_PATH"
+	EnvAuditFileMaxSize    = "CONSOLE_AUDIT_FILE_MAX_SIZE"
+	EnvAuditFileMaxAge     = "CONSOLE_AUDIT_FILE_MAX_AGE"
+	EnvAuditFileMaxBackups = "CONSOLE_AUDIT_FILE_MAX_BACKUPS"
+	EnvAuditFileCompress   = "CONSOLE_AUDIT_FILE_COMPRESS"
+	EnvAuditFileQueueSize  = "CONSOLE_AUDIT_FILE_QUEUE_SIZE"
+
+	EnvAuditSyslogEnable    = "CONSOLE_AUDIT_SYSLOG_ENABLE"
+	EnvAuditSyslogNetwork   = "CONSOLE_AUDIT_SYSLOG_NETWORK"
+	EnvAuditSyslogAddress   = "CONSOLE_AUDIT_SYSLOG_ADDRESS"
+	EnvAuditSyslogFacility  = "CONSOLE_AUDIT_SYSLOG_FACILITY"
+	EnvAuditSyslogAppName   = "CONSOLE_AUDIT_SYSLOG_APP_NAME"
+	EnvAuditSyslogQueueSize = "CONSOLE_AUDIT_SYSLOG_QUEUE_SIZE"
 )
 
-// Config console and http logger targets
+// Config console, http, file and syslog logger targets
 type Config struct {
-	HTTP         map[string]http.Config `json:"http"`
-	AuditWebhook map[string]http.Config `json:"audit"`
+	HTTP         map[string]http.Config   `json:"http"`
+	AuditWebhook map[string]http.Config   `json:"audit"`
+	AuditFile    map[string]file.Config   `json:"auditFile"`
+	AuditSyslog  map[string]syslog.Config `json:"auditSyslog"`
 }
 
 var (
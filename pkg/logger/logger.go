@@ -72,6 +72,8 @@ func (level Level) String() string {
 // jsonFlag: Display in JSON format, if enabled
 var (
 	quietFlag, jsonFlag, anonFlag bool
+	// auditRequestBodyFlag records the JSON request bodies in the audit entries
+	auditRequestBodyFlag bool
 	// Custom function to format errors
 	errorFmtFunc func(string, error, bool) string
 )
@@ -397,6 +399,42 @@ func applyDynamicConfigForSubSys(ctx context.Context, transport *http.Transport,
 			LogIf(ctx, fmt.Errorf("Unable to update audit webhook targets: %w", err))
 			return err
 		}
+	case config.AuditFileSubSys:
+		loggerCfg, err := LookupConfigForSubSys(config.AuditFileSubSys)
+		if err != nil {
+			LogIf(ctx, fmt.Errorf("unable to load audit file config: %w", err))
+			return err
+		}
+		for n, l := range loggerCfg.AuditFile {
+			if l.Enabled {
+				l.LogOnce = LogOnceIf
+				loggerCfg.AuditFile[n] = l
+			}
+		}
+
+		err = UpdateAuditFileTargets(loggerCfg)
+		if err != nil {
+			LogIf(ctx, fmt.Errorf("unable to update audit file targets: %w", err))
+			return err
+		}
+	case config.AuditSyslogSubSys:
+		loggerCfg, err := LookupConfigForSubSys(config.AuditSyslogSubSys)
+		if err != nil {
+			LogIf(ctx, fmt.Errorf("unable to load audit syslog config: %w", err))
+			return err
+		}
+		for n, l := range loggerCfg.AuditSyslog {
+			if l.Enabled {
+				l.LogOnce = LogOnceIf
+				loggerCfg.AuditSyslog[n] = l
+			}
+		}
+
+		err = UpdateAuditSyslogTargets(loggerCfg)
+		if err != nil {
+			LogIf(ctx, fmt.Errorf("unable to update audit syslog targets: %w", err))
+			return err
+		}
 	}
 	return nil
 }
@@ -411,6 +449,14 @@ func InitializeLogger(ctx context.Context, transport *http.Transport) error {
 	if err != nil {
 		return err
 	}
+	err = applyDynamicConfigForSubSys(ctx, transport, config.AuditFileSubSys)
+	if err != nil {
+		return err
+	}
+	err = applyDynamicConfigForSubSys(ctx, transport, config.AuditSyslogSubSys)
+	if err != nil {
+		return err
+	}
 
 	if enable, _ := config.ParseBool(env.Get(EnvLoggerJSONEnable, "")); enable {
 		EnableJSON()
@@ -421,6 +467,14 @@ func InitializeLogger(ctx context.Context, transport *http.Transport) error {
 	if enable, _ := config.ParseBool(env.Get(EnvLoggerQuietEnable, "")); enable {
 		EnableQuiet()
 	}
+	if enable, _ := config.ParseBool(env.Get(EnvAuditRequestBody, "")); enable {
+		auditRequestBodyFlag = true
+	}
+	rules, err := lookupRedactionRules()
+	if err != nil {
+		return err
+	}
+	auditRedactionRules = rules
 
 	return nil
 }
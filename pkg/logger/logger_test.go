@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -35,6 +36,14 @@ func TestInitializeLogger(t *testing.T) {
 	auditWebhookClientKey := fmt.Sprintf("%s_TEST", EnvAuditWebhookClientKey)
 	auditWebhookQueueSize := fmt.Sprintf("%s_TEST", EnvAuditWebhookQueueSize)
 
+	auditFileEnable := fmt.Sprintf("%s_TEST", EnvAuditFileEnable)
+	auditFilePath := fmt.Sprintf("%s_TEST", EnvAuditFilePath)
+	auditFileMaxSize := fmt.Sprintf("%s_TEST", EnvAuditFileMaxSize)
+
+	auditSyslogEnable := fmt.Sprintf("%s_TEST", EnvAuditSyslogEnable)
+	auditSyslogAddress := fmt.Sprintf("%s_TEST", EnvAuditSyslogAddress)
+	auditSyslogFacility := fmt.Sprintf("%s_TEST", EnvAuditSyslogFacility)
+
 	type args struct {
 		ctx       context.Context
 		transport *http.Transport
@@ -154,6 +163,60 @@ func TestInitializeLogger(t *testing.T) {
 				os.Unsetenv(auditWebhookQueueSize)
 			},
 		},
+		{
+			name: "audit file initialized correctly",
+			args: args{
+				ctx:       context.Background(),
+				transport: http.DefaultTransport.(*http.Transport).Clone(),
+			},
+			wantErr: false,
+			setEnvVars: func() {
+				os.Setenv(auditFileEnable, "on")
+				os.Setenv(auditFilePath, filepath.Join(t.TempDir(), "audit.log"))
+				os.Setenv(auditFileMaxSize, "10MiB")
+			},
+			unsetEnvVars: func() {
+				os.Unsetenv(auditFileEnable)
+				os.Unsetenv(auditFilePath)
+				os.Unsetenv(auditFileMaxSize)
+			},
+		},
+		{
+			name: "audit file with an invalid max size",
+			args: args{
+				ctx:       context.Background(),
+				transport: http.DefaultTransport.(*http.Transport).Clone(),
+			},
+			wantErr: true,
+			setEnvVars: func() {
+				os.Setenv(auditFileEnable, "on")
+				os.Setenv(auditFilePath, filepath.Join(t.TempDir(), "audit.log"))
+				os.Setenv(auditFileMaxSize, "ten")
+			},
+			unsetEnvVars: func() {
+				os.Unsetenv(auditFileEnable)
+				os.Unsetenv(auditFilePath)
+				os.Unsetenv(auditFileMaxSize)
+			},
+		},
+		{
+			name: "audit syslog with an invalid facility",
+			args: args{
+				ctx:       context.Background(),
+				transport: http.DefaultTransport.(*http.Transport).Clone(),
+			},
+			wantErr: true,
+			setEnvVars: func() {
+				os.Setenv(auditSyslogEnable, "on")
+				os.Setenv(auditSyslogAddress, "127.0.0.1:514")
+				os.Setenv(auditSyslogFacility, "local9")
+			},
+			unsetEnvVars: func() {
+				os.Unsetenv(auditSyslogEnable)
+				os.Unsetenv(auditSyslogAddress)
+				os.Unsetenv(auditSyslogFacility)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(_ *testing.T) {
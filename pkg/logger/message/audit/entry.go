@@ -53,6 +53,8 @@ type Entry struct {
 	ReqHeader  map[string]string      `json:"requestHeader,omitempty"`
 	RespHeader map[string]string      `json:"responseHeader,omitempty"`
 	Tags       map[string]interface{} `json:"tags,omitempty"`
+	// ReqBody is the decoded JSON request body, only recorded when enabled
+	ReqBody interface{} `json:"requestBody,omitempty"`
 }
 
 // NewEntry - constructs an audit entry object with some fields filled
@@ -0,0 +1,347 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/openstor/console/pkg/logger/message/audit"
+	"github.com/openstor/pkg/v3/env"
+)
+
+// Fields of the audit entries the redaction rules apply to
+const (
+	RedactHeader = "header"
+	RedactQuery  = "query"
+	RedactBody   = "body"
+	RedactClaim  = "claim"
+	// RedactKeyValue redacts the value of the body objects holding a key and a value whose key is sensitive,
+	// e.g. the configuration of MinIO
+	RedactKeyValue = "keyValue"
+)
+
+// Redaction modes
+const (
+	// RedactDrop removes the value from the entry
+	RedactDrop = "drop"
+	// RedactHash replaces the value with its hash, so equal values can still be correlated
+	RedactHash = "hash"
+	// RedactMask keeps the first and last two characters of the value and masks the others
+	RedactMask = "mask"
+)
+
+// redactMaskedValue replaces the values too short to be partially masked
+const redactMaskedValue = "****"
+
+// RedactionRule redacts a value of the audit entries
+type RedactionRule struct {
+	// Field is the part of the entry holding the value: header, query, body, claim or keyValue
+	Field string `json:"field"`
+	// Key is the header, query parameter or claim name. For the body it is a JSON path of dot separated keys,
+	// where `*` matches any key or array element and `**` any number of them. For keyValue it is the JSON path
+	// of the objects holding a `key` and a `value`.
+	Key string `json:"key"`
+	// Names are the keys of the keyValue objects whose value is redacted, regardless of their case
+	Names []string `json:"names,omitempty"`
+	// Mode is drop, hash or mask
+	Mode string `json:"mode"`
+	// API restricts the rule to the API paths matching this regular expression, the rule applies to all of
+	// them when empty
+	API string `json:"api,omitempty"`
+
+	api   *regexp.Regexp
+	path  []string
+	names map[string]bool
+}
+
+// redactedConfigKeys are the keys of the MinIO configuration holding a secret
+var redactedConfigKeys = []string{
+	"secret_key", "client_secret", "password", "auth_token", "api_key", "lookup_bind_password", "sasl_password",
+}
+
+// defaultRedactionRules always apply, so the credentials sent to the console never reach the audit targets
+var defaultRedactionRules = []RedactionRule{
+	{Field: RedactHeader, Key: "Authorization", Mode: RedactDrop},
+	{Field: RedactHeader, Key: "Cookie", Mode: RedactDrop},
+	{Field: RedactHeader, Key: "Set-Cookie", Mode: RedactDrop},
+	{Field: RedactHeader, Key: "X-Amz-Security-Token", Mode: RedactDrop},
+	{Field: RedactBody, Key: "**.secretKey", Mode: RedactDrop},
+	{Field: RedactBody, Key: "**.current_secret_key", Mode: RedactDrop},
+	{Field: RedactBody, Key: "**.new_secret_key", Mode: RedactDrop},
+	{Field: RedactBody, Key: "**.newSecretKey", Mode: RedactDrop},
+	// the CSV of the users to provision has a secret_key column
+	{Field: RedactBody, Key: "csv", Mode: RedactDrop, API: "^/api/v1/users/provision$"},
+	{Field: RedactBody, Key: "**.password", Mode: RedactDrop},
+	{Field: RedactBody, Key: "**.sessionToken", Mode: RedactDrop},
+	// the configuration set through the console, e.g. the client secret of an OpenID provider
+	{Field: RedactKeyValue, Key: "**.key_values.*", Names: redactedConfigKeys, Mode: RedactDrop},
+}
+
+// auditRedactionRules are the rules applied to every audit entry before it is sent
+var auditRedactionRules = mustCompileRedactionRules(defaultRedactionRules)
+
+func (rule *RedactionRule) compile() error {
+	switch rule.Field {
+	case RedactHeader, RedactQuery, RedactBody, RedactClaim, RedactKeyValue:
+	default:
+		return fmt.Errorf("invalid redaction field %s, it must be header, query, body, claim or keyValue", rule.Field)
+	}
+	switch rule.Mode {
+	case RedactDrop, RedactHash, RedactMask:
+	default:
+		return fmt.Errorf("invalid redaction mode %s, it must be drop, hash or mask", rule.Mode)
+	}
+	if rule.Key == "" {
+		return fmt.Errorf("the key of the %s redaction rule is required", rule.Field)
+	}
+	if rule.API != "" {
+		api, err := regexp.Compile(rule.API)
+		if err != nil {
+			return fmt.Errorf("invalid redaction api %s: %w", rule.API, err)
+		}
+		rule.api = api
+	}
+	if rule.Field == RedactHeader {
+		rule.Key = http.CanonicalHeaderKey(rule.Key)
+	}
+	if rule.Field == RedactBody || rule.Field == RedactKeyValue {
+		rule.path = strings.Split(rule.Key, ".")
+	}
+	if rule.Field == RedactKeyValue {
+		if len(rule.Names) == 0 {
+			return fmt.Errorf("the names of the %s redaction rule are required", rule.Field)
+		}
+		rule.names = map[string]bool{}
+		for _, name := range rule.Names {
+			rule.names[strings.ToLower(name)] = true
+		}
+	}
+	return nil
+}
+
+func mustCompileRedactionRules(rules []RedactionRule) []RedactionRule {
+	compiled, err := compileRedactionRules(rules)
+	if err != nil {
+		panic(err)
+	}
+	return compiled
+}
+
+func compileRedactionRules(rules []RedactionRule) ([]RedactionRule, error) {
+	compiled := make([]RedactionRule, 0, len(rules))
+	for _, rule := range rules {
+		if err := rule.compile(); err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, rule)
+	}
+	return compiled, nil
+}
+
+// ParseRedactionRules parses a JSON array of redaction rules, the default rules are added to them
+func ParseRedactionRules(data []byte) ([]RedactionRule, error) {
+	var rules []RedactionRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("invalid redaction rules: %w", err)
+	}
+	return compileRedactionRules(append(append([]RedactionRule{}, defaultRedactionRules...), rules...))
+}
+
+// lookupRedactionRules returns the redaction rules set in the environment, inline or in a file
+func lookupRedactionRules() ([]RedactionRule, error) {
+	if path := env.Get(EnvAuditRedactionFile, ""); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return ParseRedactionRules(data)
+	}
+	if rules := env.Get(EnvAuditRedactionRules, ""); rules != "" {
+		return ParseRedactionRules([]byte(rules))
+	}
+	return compileRedactionRules(defaultRedactionRules)
+}
+
+// redactValue returns the redacted value and whether it is kept
+func redactValue(mode string, value interface{}) (interface{}, bool) {
+	s, ok := value.(string)
+	if !ok {
+		raw, _ := json.Marshal(value)
+		s = string(raw)
+	}
+	switch mode {
+	case RedactHash:
+		return hashString(s), true
+	case RedactMask:
+		if len(s) < 8 {
+			return redactMaskedValue, true
+		}
+		return s[:2] + strings.Repeat("*", len(s)-4) + s[len(s)-2:], true
+	default:
+		return nil, false
+	}
+}
+
+func redactStrings(values map[string]string, key, mode string) {
+	value, ok := values[key]
+	if !ok {
+		return
+	}
+	if redacted, keep := redactValue(mode, value); keep {
+		values[key] = redacted.(string)
+	} else {
+		delete(values, key)
+	}
+}
+
+// redactJSON redacts the values of the decoded JSON document at the path
+func redactJSON(doc interface{}, path []string, mode string) {
+	if len(path) == 0 {
+		return
+	}
+	segment, rest := path[0], path[1:]
+	if segment == "**" {
+		// match zero levels, then one level and keep matching any number of them
+		redactJSON(doc, rest, mode)
+		forEachJSONChild(doc, func(child interface{}) {
+			redactJSON(child, path, mode)
+		})
+		return
+	}
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		for key, value := range node {
+			if segment != "*" && segment != key {
+				continue
+			}
+			if len(rest) > 0 {
+				redactJSON(value, rest, mode)
+				continue
+			}
+			if redacted, keep := redactValue(mode, value); keep {
+				node[key] = redacted
+			} else {
+				delete(node, key)
+			}
+		}
+	case []interface{}:
+		if segment != "*" {
+			return
+		}
+		for i, value := range node {
+			if len(rest) > 0 {
+				redactJSON(value, rest, mode)
+				continue
+			}
+			// an array element can't be removed without shifting the others, it is masked instead
+			redacted, keep := redactValue(mode, value)
+			if !keep {
+				redacted = redactMaskedValue
+			}
+			node[i] = redacted
+		}
+	}
+}
+
+// redactKeyValues redacts the value of the objects of the decoded JSON document at the path whose key is one of
+// the names
+func redactKeyValues(doc interface{}, path []string, names map[string]bool, mode string) {
+	forEachJSONMatch(doc, path, func(match interface{}) {
+		node, ok := match.(map[string]interface{})
+		if !ok {
+			return
+		}
+		key, _ := node["key"].(string)
+		value, ok := node["value"]
+		if !ok || !names[strings.ToLower(key)] {
+			return
+		}
+		if redacted, keep := redactValue(mode, value); keep {
+			node["value"] = redacted
+		} else {
+			delete(node, "value")
+		}
+	})
+}
+
+// forEachJSONMatch calls fn with the values of the decoded JSON document at the path
+func forEachJSONMatch(doc interface{}, path []string, fn func(match interface{})) {
+	if len(path) == 0 {
+		fn(doc)
+		return
+	}
+	segment, rest := path[0], path[1:]
+	if segment == "**" {
+		forEachJSONMatch(doc, rest, fn)
+		forEachJSONChild(doc, func(child interface{}) {
+			forEachJSONMatch(child, path, fn)
+		})
+		return
+	}
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		for key, value := range node {
+			if segment == "*" || segment == key {
+				forEachJSONMatch(value, rest, fn)
+			}
+		}
+	case []interface{}:
+		if segment == "*" {
+			for _, value := range node {
+				forEachJSONMatch(value, rest, fn)
+			}
+		}
+	}
+}
+
+func forEachJSONChild(doc interface{}, fn func(child interface{})) {
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		for _, value := range node {
+			fn(value)
+		}
+	case []interface{}:
+		for _, value := range node {
+			fn(value)
+		}
+	}
+}
+
+// redactEntry applies the redaction rules to the audit entry
+func redactEntry(entry *audit.Entry, rules []RedactionRule) {
+	for _, rule := range rules {
+		if rule.api != nil && !rule.api.MatchString(entry.API.Path) {
+			continue
+		}
+		switch rule.Field {
+		case RedactHeader:
+			redactStrings(entry.ReqHeader, rule.Key, rule.Mode)
+			redactStrings(entry.RespHeader, rule.Key, rule.Mode)
+		case RedactQuery:
+			redactStrings(entry.ReqQuery, rule.Key, rule.Mode)
+		case RedactClaim:
+			if value, ok := entry.ReqClaims[rule.Key]; ok {
+				if redacted, keep := redactValue(rule.Mode, value); keep {
+					entry.ReqClaims[rule.Key] = redacted
+				} else {
+					delete(entry.ReqClaims, rule.Key)
+				}
+			}
+		case RedactBody:
+			if entry.ReqBody != nil {
+				redactJSON(entry.ReqBody, rule.path, rule.Mode)
+			}
+		case RedactKeyValue:
+			if entry.ReqBody != nil {
+				redactKeyValues(entry.ReqBody, rule.path, rule.names, rule.Mode)
+			}
+		}
+	}
+}
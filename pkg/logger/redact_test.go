@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/openstor/console/models"
+	"github.com/openstor/console/pkg/logger/message/audit"
+	"github.com/openstor/console/pkg/logger/target/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// captureTarget keeps the entries sent to it as JSON
+type captureTarget struct {
+	entries []string
+}
+
+func (c *captureTarget) String() string   { return "capture" }
+func (c *captureTarget) Endpoint() string { return "" }
+func (c *captureTarget) Init() error      { return nil }
+func (c *captureTarget) Cancel()          {}
+func (c *captureTarget) Send(entry interface{}, _ string) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	c.entries = append(c.entries, string(raw))
+	return nil
+}
+func (c *captureTarget) Type() types.TargetType { return types.TargetHTTP }
+
+func TestParseRedactionRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		wantErr bool
+	}{
+		{
+			name:  "valid rules",
+			rules: `[{"field":"header","key":"x-api-key","mode":"hash"},{"field":"body","key":"users.*.token","mode":"mask","api":"^/api/v1/users"}]`,
+		},
+		{
+			name:    "invalid field",
+			rules:   `[{"field":"cookie","key":"session","mode":"drop"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid mode",
+			rules:   `[{"field":"query","key":"token","mode":"encrypt"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			rules:   `[{"field":"claim","mode":"drop"}]`,
+			wantErr: true,
+		},
+		{
+			name:  "key value",
+			rules: `[{"field":"keyValue","key":"settings.*","names":["token"],"mode":"hash"},{"field":"claim","key":"sub","mode":"drop"}]`,
+		},
+		{
+			name:    "key value without names",
+			rules:   `[{"field":"keyValue","key":"settings.*","mode":"drop"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid api",
+			rules:   `[{"field":"claim","key":"sub","mode":"drop","api":"("}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			rules:   `{"field":"claim"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ParseRedactionRules([]byte(tt.rules))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			// the default rules always apply
+			assert.Len(t, rules, len(defaultRedactionRules)+2)
+		})
+	}
+}
+
+func TestRedactEntry(t *testing.T) {
+	funcAssert := assert.New(t)
+	rules, err := ParseRedactionRules([]byte(`[
+		{"field":"header","key":"x-api-key","mode":"hash"},
+		{"field":"query","key":"token","mode":"mask"},
+		{"field":"query","key":"code","mode":"mask"},
+		{"field":"claim","key":"email","mode":"drop"},
+		{"field":"body","key":"users.*.token","mode":"mask"},
+		{"field":"body","key":"tags.*","mode":"drop"},
+		{"field":"body","key":"note","mode":"drop","api":"^/api/v1/buckets"}
+	]`))
+	funcAssert.NoError(err)
+
+	var body interface{}
+	funcAssert.NoError(json.Unmarshal([]byte(`{
+		"accessKey": "admin",
+		"secretKey": "top-secret",
+		"note": "kept",
+		"tags": ["a", "b"],
+		"users": [{"name": "u1", "token": "abcdefghij"}],
+		"nested": {"deeper": {"password": "hunter2"}}
+	}`), &body))
+	entry := audit.Entry{
+		ReqHeader:  map[string]string{"X-Api-Key": "key", "Authorization": "Bearer token"},
+		RespHeader: map[string]string{"Set-Cookie": "token=value"},
+		ReqQuery:   map[string]string{"token": "abcdefghij", "code": "abc"},
+		ReqClaims:  map[string]interface{}{"email": "user@example.com", "sub": "user"},
+		ReqBody:    body,
+	}
+	entry.API.Path = "/api/v1/users"
+	redactEntry(&entry, rules)
+
+	funcAssert.Equal(map[string]string{"X-Api-Key": hashString("key")}, entry.ReqHeader)
+	funcAssert.Empty(entry.RespHeader)
+	funcAssert.Equal(map[string]string{"token": "ab******ij", "code": "****"}, entry.ReqQuery)
+	funcAssert.Equal(map[string]interface{}{"sub": "user"}, entry.ReqClaims)
+	funcAssert.Equal(map[string]interface{}{
+		"accessKey": "admin",
+		"note":      "kept",
+		"tags":      []interface{}{"****", "****"},
+		"users":     []interface{}{map[string]interface{}{"name": "u1", "token": "ab******ij"}},
+		"nested":    map[string]interface{}{"deeper": map[string]interface{}{}},
+	}, entry.ReqBody)
+}
+
+func TestRedactKeyValues(t *testing.T) {
+	funcAssert := assert.New(t)
+	rules, err := ParseRedactionRules([]byte(`[{"field":"keyValue","key":"settings.*","names":["Token"],"mode":"mask"}]`))
+	funcAssert.NoError(err)
+
+	var body interface{}
+	funcAssert.NoError(json.Unmarshal([]byte(`{
+		"key_values": [{"key": "client_id", "value": "console"}, {"key": "CLIENT_SECRET", "value": "top-secret"}],
+		"settings": [{"key": "token", "value": "abcdefghij"}, {"key": "url", "value": "https://example.com"}]
+	}`), &body))
+	entry := audit.Entry{ReqBody: body}
+	entry.API.Path = "/api/v1/configs/identity_openid"
+	redactEntry(&entry, rules)
+
+	// the values of the sensitive keys are redacted, their key is kept
+	funcAssert.Equal(map[string]interface{}{
+		"key_values": []interface{}{
+			map[string]interface{}{"key": "client_id", "value": "console"},
+			map[string]interface{}{"key": "CLIENT_SECRET"},
+		},
+		"settings": []interface{}{
+			map[string]interface{}{"key": "token", "value": "ab******ij"},
+			map[string]interface{}{"key": "url", "value": "https://example.com"},
+		},
+	}, entry.ReqBody)
+}
+
+// captureAuditLog sends the audit entries to a capture target until the returned function is called
+func captureAuditLog() (*captureTarget, func()) {
+	target := &captureTarget{}
+	swapMu.Lock()
+	previousTargets := auditTargets
+	auditTargets = []Target{target}
+	atomic.StoreInt32(&nAuditTargets, 1)
+	swapMu.Unlock()
+	auditRequestBodyFlag = true
+	return target, func() {
+		swapMu.Lock()
+		auditTargets = previousTargets
+		atomic.StoreInt32(&nAuditTargets, int32(len(previousTargets)))
+		swapMu.Unlock()
+		auditRequestBodyFlag = false
+	}
+}
+
+// auditRequest audits a request the handler read the body of
+func auditRequest(t *testing.T, path, body string) {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Authorization", "Bearer SESSION-TOKEN")
+	r.Header.Set("Cookie", "token=SESSION-COOKIE")
+	ctx := SetReqInfo(context.Background(), &ReqInfo{RequestID: "request"})
+	r = r.WithContext(ctx)
+	RecordRequestBody(r)
+	_, err := io.ReadAll(r.Body)
+	assert.NoError(t, err)
+	AuditLog(ctx, NewResponseWriter(httptest.NewRecorder()), r, map[string]interface{}{})
+}
+
+func TestAuditLogRedaction(t *testing.T) {
+	funcAssert := assert.New(t)
+	target, restore := captureAuditLog()
+	defer restore()
+
+	requests := []struct {
+		path string
+		body string
+	}{
+		{path: "/api/v1/users", body: `{"accessKey":"newuser","secretKey":"USER-SECRET-KEY","groups":["dev"]}`},
+		{path: "/api/v1/account/change-password", body: `{"current_secret_key":"OLD-SECRET-KEY","new_secret_key":"NEW-SECRET-KEY"}`},
+		{path: "/api/v1/login", body: `{"accessKey":"admin","secretKey":"LOGIN-SECRET-KEY"}`},
+	}
+	for _, request := range requests {
+		auditRequest(t, request.path, request.body)
+	}
+
+	if funcAssert.Len(target.entries, len(requests)) {
+		funcAssert.Contains(target.entries[0], `"requestBody":{"accessKey":"newuser","groups":["dev"]}`)
+		for _, entry := range target.entries {
+			for _, secret := range []string{"SECRET-KEY", "SESSION-TOKEN", "SESSION-COOKIE"} {
+				funcAssert.NotContains(entry, secret)
+			}
+		}
+	}
+}
+
+// TestAuditLogRedactsRequestModels audits the body of every request model holding a secret or a password
+func TestAuditLogRedactsRequestModels(t *testing.T) {
+	const secret = "REQUEST-SECRET"
+	tests := []struct {
+		name string
+		path string
+		body interface{}
+	}{
+		{name: "login", path: "/api/v1/login", body: &models.LoginRequest{AccessKey: "admin", SecretKey: secret}},
+		{name: "add user", path: "/api/v1/users", body: &models.AddUserRequest{AccessKey: swag.String("user"), SecretKey: swag.String(secret)}},
+		{name: "change user password", path: "/api/v1/account/change-user-password", body: &models.ChangeUserPasswordRequest{SelectedUser: swag.String("user"), NewSecretKey: swag.String(secret)}},
+		{name: "change password", path: "/api/v1/account/change-password", body: &models.AccountChangePasswordRequest{CurrentSecretKey: swag.String(secret), NewSecretKey: swag.String(secret)}},
+		{name: "service account", path: "/api/v1/service-account-credentials", body: &models.ServiceAccountRequestCreds{AccessKey: "svc", SecretKey: secret}},
+		{name: "update service account", path: "/api/v1/service-accounts/svc", body: &models.UpdateServiceAccountRequest{Policy: swag.String(""), SecretKey: secret}},
+		{name: "remote bucket", path: "/api/v1/remote-buckets", body: &models.CreateRemoteBucket{AccessKey: swag.String("remote"), SecretKey: swag.String(secret)}},
+		{name: "external buckets", path: "/api/v1/list-external-buckets", body: &models.ListExternalBucketsParams{AccessKey: swag.String("remote"), SecretKey: swag.String(secret)}},
+		{name: "multi bucket replication", path: "/api/v1/buckets-replication", body: &models.MultiBucketReplication{AccessKey: swag.String("remote"), SecretKey: swag.String(secret)}},
+		{
+			name: "set config",
+			path: "/api/v1/configs/identity_openid",
+			body: &models.SetConfigRequest{KeyValues: []*models.ConfigurationKV{
+				{Key: "client_id", Value: "console"},
+				{Key: "client_secret", Value: secret},
+			}},
+		},
+		{
+			name: "bulk provision",
+			path: "/api/v1/users/provision",
+			body: &models.BulkProvisionUsersRequest{
+				Csv:   "access_key,secret_key\nuser," + secret,
+				Users: []*models.BulkProvisionUser{{AccessKey: "user", SecretKey: secret}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, restore := captureAuditLog()
+			defer restore()
+			body, err := json.Marshal(tt.body)
+			assert.NoError(t, err)
+			assert.Contains(t, string(body), secret)
+			auditRequest(t, tt.path, string(body))
+			if assert.Len(t, target.entries, 1) {
+				assert.Contains(t, target.entries[0], `"requestBody"`)
+				assert.NotContains(t, target.entries[0], secret)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package file
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openstor/console/pkg/logger/target/types"
+)
+
+// backupTimeFormat is the timestamp added to the name of the rotated files
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// Config file logger target
+type Config struct {
+	Enabled bool   `json:"enabled"`
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	// MaxSize is the size in bytes the file is rotated at, 0 disables the size rotation
+	MaxSize int64 `json:"maxSize"`
+	// MaxAge is the age the file is rotated at, 0 disables the age rotation
+	MaxAge time.Duration `json:"maxAge"`
+	// MaxBackups is the number of rotated files kept, 0 keeps all of them
+	MaxBackups int  `json:"maxBackups"`
+	Compress   bool `json:"compress"`
+	QueueSize  int  `json:"queueSize"`
+
+	// Custom logger
+	LogOnce func(ctx context.Context, err error, id interface{}, errKind ...interface{}) `json:"-"`
+}
+
+// Target implements logger.Target and appends the json
+// format of a log entry to a local file, one entry per line.
+// The file is rotated once it reaches its maximum size or
+// age, the rotated files are optionally gzipped.
+type Target struct {
+	status int32
+	wg     sync.WaitGroup
+
+	// Channel of log entries
+	logCh chan interface{}
+
+	// file, size and openedAt are only used by the writer routine
+	file     *os.File
+	size     int64
+	openedAt time.Time
+
+	config Config
+}
+
+// Endpoint returns the path of the file
+func (f *Target) Endpoint() string {
+	return f.config.Path
+}
+
+func (f *Target) String() string {
+	return f.config.Name
+}
+
+// Init validate and initialize the file target
+func (f *Target) Init() error {
+	if f.config.Path == "" {
+		return errors.New("the path of the audit file is required")
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+	f.status = 1
+	f.startFileLogger()
+	return nil
+}
+
+// open opens the file for appending, creating it along with its directory when missing
+func (f *Target) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.config.Path), 0o700); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.config.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	f.openedAt = time.Now()
+	return nil
+}
+
+// needsRotation returns whether writing n more bytes exceeds the size or age of the file
+func (f *Target) needsRotation(n int64, now time.Time) bool {
+	if f.size == 0 {
+		return false
+	}
+	if f.config.MaxSize > 0 && f.size+n > f.config.MaxSize {
+		return true
+	}
+	return f.config.MaxAge > 0 && now.Sub(f.openedAt) >= f.config.MaxAge
+}
+
+// rotate renames the current file with a timestamp, opens a new one and removes the backups in excess
+func (f *Target) rotate(now time.Time) error {
+	err := f.file.Close()
+	f.file = nil
+	if err != nil {
+		return err
+	}
+	ext := filepath.Ext(f.config.Path)
+	backup := fmt.Sprintf("%s-%s%s", strings.TrimSuffix(f.config.Path, ext), now.UTC().Format(backupTimeFormat), ext)
+	if err := os.Rename(f.config.Path, backup); err != nil {
+		return err
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+	if f.config.Compress {
+		if err := compressFile(backup); err != nil {
+			return err
+		}
+	}
+	return f.removeOldBackups()
+}
+
+// compressFile gzips the file and removes the uncompressed one
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(dst)
+	if _, err := io.Copy(gz, src); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// backups returns the rotated files, oldest first
+func (f *Target) backups() ([]string, error) {
+	ext := filepath.Ext(f.config.Path)
+	matches, err := filepath.Glob(strings.TrimSuffix(f.config.Path, ext) + "-*" + ext + "*")
+	if err != nil {
+		return nil, err
+	}
+	// the timestamp of the name sorts them by rotation time
+	sort.Strings(matches)
+	return matches, nil
+}
+
+func (f *Target) removeOldBackups() error {
+	if f.config.MaxBackups <= 0 {
+		return nil
+	}
+	backups, err := f.backups()
+	if err != nil {
+		return err
+	}
+	for len(backups) > f.config.MaxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+func (f *Target) logEntry(entry interface{}) {
+	logJSON, err := json.Marshal(&entry)
+	if err != nil {
+		return
+	}
+	logJSON = append(logJSON, '\n')
+
+	ctx := context.Background()
+	now := time.Now()
+	if f.needsRotation(int64(len(logJSON)), now) {
+		if err := f.rotate(now); err != nil {
+			f.config.LogOnce(ctx, fmt.Errorf("unable to rotate the audit file %s: %w", f.config.Path, err), f.config.Path)
+			if f.file == nil {
+				// the new file couldn't be opened, retry on the next entry
+				if err := f.open(); err != nil {
+					return
+				}
+			}
+		}
+	}
+	n, err := f.file.Write(logJSON)
+	f.size += int64(n)
+	if err != nil {
+		f.config.LogOnce(ctx, fmt.Errorf("unable to write to the audit file %s: %w", f.config.Path, err), f.config.Path)
+	}
+}
+
+func (f *Target) startFileLogger() {
+	// Create a routine which writes json logs received
+	// from an internal channel.
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		for entry := range f.logCh {
+			f.logEntry(entry)
+		}
+		if f.file != nil {
+			f.file.Close()
+		}
+	}()
+}
+
+// New initializes a new logger target which
+// appends the logs to a local file
+func New(config Config) *Target {
+	if config.LogOnce == nil {
+		config.LogOnce = func(context.Context, error, interface{}, ...interface{}) {}
+	}
+	return &Target{
+		logCh:  make(chan interface{}, config.QueueSize),
+		config: config,
+	}
+}
+
+// Send log message 'e' to file target.
+func (f *Target) Send(entry interface{}, _ string) error {
+	if atomic.LoadInt32(&f.status) == 0 {
+		// Channel was closed or used before init.
+		return nil
+	}
+
+	select {
+	case f.logCh <- entry:
+	default:
+		// log channel is full, do not wait and return
+		// an errors immediately to the caller
+		return errors.New("log buffer full")
+	}
+
+	return nil
+}
+
+// Cancel - cancels the target
+func (f *Target) Cancel() {
+	if atomic.CompareAndSwapInt32(&f.status, 1, 0) {
+		close(f.logCh)
+	}
+	f.wg.Wait()
+}
+
+// QueueLength - returns the number of log entries waiting to be written
+func (f *Target) QueueLength() int {
+	return len(f.logCh)
+}
+
+// Type - returns type of the target
+func (f *Target) Type() types.TargetType {
+	return types.TargetFile
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package file
+
+import (
+	"bufio"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func countLines(t *testing.T, path string) int {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var scanner *bufio.Scanner
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scanner = bufio.NewScanner(gz)
+	} else {
+		scanner = bufio.NewScanner(f)
+	}
+	lines := 0
+	for scanner.Scan() {
+		lines++
+	}
+	return lines
+}
+
+func TestTargetRotation(t *testing.T) {
+	funcAssert := assert.New(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit", "audit.log")
+	entry := map[string]string{"api": "ListBuckets"}
+	// every entry is 22 bytes, so each file holds two of them
+	target := New(Config{Enabled: true, Path: path, MaxSize: 50, MaxBackups: 2, Compress: true, QueueSize: 10})
+	funcAssert.NoError(target.Init())
+
+	for i := 0; i < 7; i++ {
+		funcAssert.NoError(target.Send(entry, ""))
+		// the backups are named after the rotation time
+		time.Sleep(2 * time.Millisecond)
+	}
+	target.Cancel()
+
+	funcAssert.Equal(1, countLines(t, path))
+	backups, err := target.backups()
+	funcAssert.NoError(err)
+	// the oldest backup was removed
+	if funcAssert.Len(backups, 2) {
+		for _, backup := range backups {
+			funcAssert.True(strings.HasSuffix(backup, ".log.gz"))
+			funcAssert.Equal(2, countLines(t, backup))
+		}
+	}
+
+	// sending to a cancelled target is a no-op
+	funcAssert.NoError(target.Send(entry, ""))
+}
+
+func TestTargetMaxAge(t *testing.T) {
+	funcAssert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "audit.log")
+	target := New(Config{Enabled: true, Path: path, MaxAge: time.Hour, QueueSize: 10})
+	funcAssert.NoError(target.Init())
+	target.logEntry("first")
+	// the file was opened more than an hour ago
+	target.openedAt = time.Now().Add(-2 * time.Hour)
+	target.logEntry("second")
+	target.Cancel()
+
+	funcAssert.Equal(1, countLines(t, path))
+	backups, err := target.backups()
+	funcAssert.NoError(err)
+	if funcAssert.Len(backups, 1) {
+		funcAssert.True(strings.HasSuffix(backups[0], ".log"))
+		funcAssert.Equal(1, countLines(t, backups[0]))
+	}
+}
+
+func TestTargetInit(t *testing.T) {
+	funcAssert := assert.New(t)
+	funcAssert.Error(New(Config{Enabled: true}).Init())
+
+	// the size of an existing file counts toward its rotation
+	path := filepath.Join(t.TempDir(), "audit.log")
+	funcAssert.NoError(os.WriteFile(path, []byte("previous\n"), 0o600))
+	target := New(Config{Enabled: true, Path: path, QueueSize: 10})
+	funcAssert.NoError(target.Init())
+	funcAssert.Equal(int64(9), target.size)
+	target.Cancel()
+}
@@ -23,6 +23,22 @@ import (
 // Timeout for the webhook http call
 const webhookCallTimeout = 5 * time.Second
 
+// queueAppendBatch is the maximum number of entries appended to the queue directory with a single fsync
+const queueAppendBatch = 1000
+
+// queueAppend is an entry handed over by Send to the goroutine appending the entries to the queue directory, done
+// receives the outcome once the entry was synced
+type queueAppend struct {
+	entry []byte
+	done  chan error
+}
+
+// The delivery of the queued entries is retried with an exponential backoff between these bounds
+var (
+	retryMinBackoff = time.Second
+	retryMaxBackoff = time.Minute
+)
+
 // Config http logger target
 type Config struct {
 	Enabled    bool              `json:"enabled"`
@@ -35,6 +51,17 @@ type Config struct {
 	QueueSize  int               `json:"queueSize"`
 	Transport  http.RoundTripper `json:"-"`
 
+	// QueueDir keeps the entries on disk until the endpoint accepts
+	// them, QueueSize is then the maximum number of queued entries
+	QueueDir string `json:"queueDir"`
+	// BatchSize is the maximum number of entries sent in a single
+	// POST, as newline delimited JSON
+	BatchSize int `json:"batchSize"`
+	// MaxAttempts is the number of times an entry rejected by the
+	// endpoint is sent before it is moved to the dead letter file
+	// of the queue directory
+	MaxAttempts int `json:"maxAttempts"`
+
 	// Custom logger
 	LogOnce func(ctx context.Context, err error, id interface{}, errKind ...interface{}) `json:"-"`
 }
@@ -44,6 +71,9 @@ type Config struct {
 // An internal buffer of logs is maintained but when the
 // buffer is full, new logs are just ignored and an errors
 // is returned to the caller.
+// When a queue directory is configured the entries are
+// kept on disk instead, and their delivery is retried
+// until the endpoint accepts them.
 type Target struct {
 	status int32
 	wg     sync.WaitGroup
@@ -51,6 +81,24 @@ type Target struct {
 	// Channel of log entries
 	logCh chan interface{}
 
+	// store, appendCh, queuedCh and doneCh are only used with a queue directory
+	store *queueStore
+	// appendCh holds the entries to append to the queue directory, appendMu is held by Send handing an entry
+	// over, so it isn't closed meanwhile
+	appendCh chan queueAppend
+	appendMu sync.RWMutex
+	// queuedCh wakes the sending goroutine up once entries were appended
+	queuedCh chan struct{}
+	doneCh   chan struct{}
+	// rejections is the number of times the endpoint rejected the
+	// oldest queued entry, only used by the sending goroutine
+	rejections int
+
+	// Number of POST requests that failed
+	failedRequests int64
+	// Number of entries moved to the dead letter file
+	deadLetters int64
+
 	config Config
 }
 
@@ -65,6 +113,31 @@ func (h *Target) String() string {
 
 // Init validate and initialize the http target
 func (h *Target) Init() error {
+	if h.config.QueueDir != "" {
+		store, err := newQueueStore(h.config.QueueDir, h.config.QueueSize)
+		if err != nil {
+			return err
+		}
+		h.store = store
+		// the queued entries wait for the endpoint, so it doesn't need to be up
+		if err := h.checkEndpoint(); err != nil {
+			h.config.LogOnce(context.Background(), err, h.config.Endpoint)
+		}
+		h.status = 1
+		h.startQueueAppender()
+		h.startQueueSender()
+		return nil
+	}
+	if err := h.checkEndpoint(); err != nil {
+		return err
+	}
+	h.status = 1
+	go h.startHTTPLogger()
+	return nil
+}
+
+// checkEndpoint sends an empty entry to validate the endpoint
+func (h *Target) checkEndpoint() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*webhookCallTimeout)
 	defer cancel()
 
@@ -100,9 +173,6 @@ func (h *Target) Init() error {
 		return fmt.Errorf("%s returned '%s', please check your endpoint configuration",
 			h.config.Endpoint, resp.Status)
 	}
-
-	h.status = 1
-	go h.startHTTPLogger()
 	return nil
 }
 
@@ -113,19 +183,48 @@ func acceptedResponseStatusCode(code int) bool {
 	return acceptedStatusCodeMap[code]
 }
 
-func (h *Target) logEntry(entry interface{}) {
-	logJSON, err := json.Marshal(&entry)
-	if err != nil {
-		return
+// errEntriesRejected is returned when the endpoint rejects the entries themselves, sending them again as is
+// won't succeed
+var errEntriesRejected = errors.New("the entries were rejected")
+
+// rejectedResponseStatusCode returns whether the status code rejects the entries sent. The client errors due to
+// the configuration or the load of the endpoint aren't.
+func rejectedResponseStatusCode(code int) bool {
+	switch code {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return false
+	}
+	return code >= http.StatusBadRequest && code < http.StatusInternalServerError
+}
+
+func (h *Target) batchSize() int {
+	if h.config.BatchSize > 1 {
+		return h.config.BatchSize
 	}
+	return 1
+}
+
+func (h *Target) maxAttempts() int {
+	if h.config.MaxAttempts > 0 {
+		return h.config.MaxAttempts
+	}
+	return 3
+}
+
+// send POSTs a batch of entries, as newline delimited JSON when there are several
+func (h *Target) send(entries [][]byte) (err error) {
+	defer func() {
+		if err != nil {
+			atomic.AddInt64(&h.failedRequests, 1)
+		}
+	}()
 
 	ctx, cancel := context.WithTimeout(context.Background(), webhookCallTimeout)
+	defer cancel()
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
-		h.config.Endpoint, bytes.NewReader(logJSON))
+		h.config.Endpoint, bytes.NewReader(bytes.Join(entries, []byte("\n"))))
 	if err != nil {
-		h.config.LogOnce(ctx, fmt.Errorf("%s returned '%w', please check your endpoint configuration", h.config.Endpoint, err), h.config.Endpoint)
-		cancel()
-		return
+		return fmt.Errorf("%s returned '%w', please check your endpoint configuration", h.config.Endpoint, err)
 	}
 	req.Header.Set(xhttp.ContentType, "application/json")
 
@@ -139,23 +238,24 @@ func (h *Target) logEntry(entry interface{}) {
 
 	client := http.Client{Transport: h.config.Transport}
 	resp, err := client.Do(req)
-	cancel()
 	if err != nil {
-		h.config.LogOnce(ctx, fmt.Errorf("%s returned '%w', please check your endpoint configuration", h.config.Endpoint, err), h.config.Endpoint)
-		return
+		return fmt.Errorf("%s returned '%w', please check your endpoint configuration", h.config.Endpoint, err)
 	}
 
 	// Drain any response.
 	xhttp.DrainBody(resp.Body)
 
 	if !acceptedResponseStatusCode(resp.StatusCode) {
-		switch resp.StatusCode {
-		case http.StatusForbidden:
-			h.config.LogOnce(ctx, fmt.Errorf("%s returned '%s', please check if your auth token is correctly set", h.config.Endpoint, resp.Status), h.config.Endpoint)
+		switch {
+		case resp.StatusCode == http.StatusForbidden:
+			return fmt.Errorf("%s returned '%s', please check if your auth token is correctly set", h.config.Endpoint, resp.Status)
+		case rejectedResponseStatusCode(resp.StatusCode):
+			return fmt.Errorf("%s returned '%s': %w", h.config.Endpoint, resp.Status, errEntriesRejected)
 		default:
-			h.config.LogOnce(ctx, fmt.Errorf("%s returned '%s', please check your endpoint configuration", h.config.Endpoint, resp.Status), h.config.Endpoint)
+			return fmt.Errorf("%s returned '%s', please check your endpoint configuration", h.config.Endpoint, resp.Status)
 		}
 	}
+	return nil
 }
 
 func (h *Target) startHTTPLogger() {
@@ -165,7 +265,171 @@ func (h *Target) startHTTPLogger() {
 	go func() {
 		defer h.wg.Done()
 		for entry := range h.logCh {
-			h.logEntry(entry)
+			batch := make([][]byte, 0, h.batchSize())
+			if logJSON, err := json.Marshal(&entry); err == nil {
+				batch = append(batch, logJSON)
+			}
+			// send along the entries already waiting
+		gather:
+			for len(batch) < h.batchSize() {
+				select {
+				case entry, ok := <-h.logCh:
+					if !ok {
+						break gather
+					}
+					if logJSON, err := json.Marshal(&entry); err == nil {
+						batch = append(batch, logJSON)
+					}
+				default:
+					break gather
+				}
+			}
+			if len(batch) == 0 {
+				continue
+			}
+			if err := h.send(batch); err != nil {
+				h.config.LogOnce(context.Background(), err, h.config.Endpoint)
+			}
+		}
+	}()
+}
+
+// appendQueued appends the entries handed over by Send to the queue directory, along with the ones waiting in
+// the channel, with a single fsync. Send returns once its entry was synced.
+func (h *Target) appendQueued(requests ...queueAppend) {
+gather:
+	for len(requests) < queueAppendBatch {
+		select {
+		case request, ok := <-h.appendCh:
+			if !ok {
+				break gather
+			}
+			requests = append(requests, request)
+		default:
+			break gather
+		}
+	}
+	batch := make([][]byte, 0, len(requests))
+	for _, request := range requests {
+		batch = append(batch, request.entry)
+	}
+	err := h.store.append(batch)
+	if err != nil {
+		h.store.release(len(batch))
+		h.config.LogOnce(context.Background(), fmt.Errorf("unable to queue the entries: %w", err), h.config.QueueDir)
+	}
+	for _, request := range requests {
+		request.done <- err
+	}
+	if err == nil {
+		select {
+		case h.queuedCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// sendQueued sends a batch of queued entries and removes them once the endpoint accepted them. Once the endpoint
+// rejected a batch, the oldest entry is sent alone until it is accepted or moved to the dead letter file after
+// MaxAttempts attempts, so a single invalid entry doesn't block the queue.
+func (h *Target) sendQueued() error {
+	n := h.batchSize()
+	if h.rejections > 0 {
+		n = 1
+	}
+	batch, err := h.store.peek(n)
+	if err != nil {
+		h.config.LogOnce(context.Background(), fmt.Errorf("unable to read the queued entries: %w", err), h.config.QueueDir)
+		if len(batch) == 0 {
+			// an unreadable entry would block the queue forever
+			return h.store.ack(1)
+		}
+	}
+	err = h.send(batch)
+	switch {
+	case errors.Is(err, errEntriesRejected):
+		h.rejections++
+		if len(batch) > 1 || h.rejections < h.maxAttempts() {
+			return err
+		}
+		h.rejections = 0
+		if err := h.store.deadLetter(batch[0]); err != nil {
+			return err
+		}
+		atomic.AddInt64(&h.deadLetters, 1)
+		h.config.LogOnce(context.Background(), fmt.Errorf("%w, the entry was moved to %s", err, queueDeadLetterFile), h.config.QueueDir)
+		return h.store.ack(1)
+	case err != nil:
+		return err
+	}
+	h.rejections = 0
+	return h.store.ack(len(batch))
+}
+
+func nextBackoff(backoff time.Duration) time.Duration {
+	if backoff < retryMinBackoff {
+		return retryMinBackoff
+	}
+	if backoff *= 2; backoff > retryMaxBackoff {
+		return retryMaxBackoff
+	}
+	return backoff
+}
+
+// startQueueAppender starts the goroutine appending the entries handed over by Send to the queue directory, it
+// stops once Cancel closed the channel and the entries left were appended
+func (h *Target) startQueueAppender() {
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		for request := range h.appendCh {
+			h.appendQueued(request)
+		}
+	}()
+}
+
+func (h *Target) startQueueSender() {
+	// Create a routine which sends the entries of the queue
+	// directory, retrying with a backoff while the endpoint
+	// fails.
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		var backoff time.Duration
+		var retry *time.Timer
+		for {
+			if retry == nil && h.store.queued() > 0 {
+				if err := h.sendQueued(); err != nil {
+					h.config.LogOnce(context.Background(), err, h.config.Endpoint)
+					backoff = nextBackoff(backoff)
+					retry = time.NewTimer(backoff)
+				} else {
+					backoff = 0
+				}
+			}
+			var retryCh <-chan time.Time
+			if retry != nil {
+				retryCh = retry.C
+			} else if h.store.queued() > 0 {
+				// keep sending the queued entries until stopped
+				select {
+				case <-h.doneCh:
+					return
+				default:
+					continue
+				}
+			}
+			select {
+			case <-h.doneCh:
+				if retry != nil {
+					retry.Stop()
+				}
+				// the queued entries are sent on the next start
+				return
+			case <-h.queuedCh:
+			case <-retryCh:
+				retry = nil
+			}
 		}
 	}()
 }
@@ -173,9 +437,17 @@ func (h *Target) startHTTPLogger() {
 // New initializes a new logger target which
 // sends log over http to the specified endpoint
 func New(config Config) *Target {
+	if config.LogOnce == nil {
+		config.LogOnce = func(context.Context, error, interface{}, ...interface{}) {}
+	}
 	h := &Target{
-		logCh:  make(chan interface{}, config.QueueSize),
 		config: config,
+		logCh:  make(chan interface{}, config.QueueSize),
+		doneCh: make(chan struct{}),
+	}
+	if config.QueueDir != "" {
+		h.appendCh = make(chan queueAppend, config.QueueSize)
+		h.queuedCh = make(chan struct{}, 1)
 	}
 
 	return h
@@ -188,6 +460,10 @@ func (h *Target) Send(entry interface{}, _ string) error {
 		return nil
 	}
 
+	if h.store != nil {
+		return h.queue(entry)
+	}
+
 	select {
 	case h.logCh <- entry:
 	default:
@@ -199,14 +475,71 @@ func (h *Target) Send(entry interface{}, _ string) error {
 	return nil
 }
 
+// queue appends the entry to the queue directory, it returns once the entry was synced along with the entries
+// sent concurrently
+func (h *Target) queue(entry interface{}) error {
+	logJSON, err := json.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	if err := h.store.reserve(); err != nil {
+		return err
+	}
+	request := queueAppend{entry: logJSON, done: make(chan error, 1)}
+	h.appendMu.RLock()
+	if atomic.LoadInt32(&h.status) == 0 {
+		// Cancel closed the channel
+		h.appendMu.RUnlock()
+		h.store.release(1)
+		return nil
+	}
+	select {
+	case h.appendCh <- request:
+		h.appendMu.RUnlock()
+	default:
+		h.appendMu.RUnlock()
+		h.store.release(1)
+		return errors.New("log buffer full")
+	}
+	return <-request.done
+}
+
 // Cancel - cancels the target
 func (h *Target) Cancel() {
 	if atomic.CompareAndSwapInt32(&h.status, 1, 0) {
+		if h.store != nil {
+			// the entries handed over are appended before stopping, the queued entries are sent on the next start
+			h.appendMu.Lock()
+			close(h.appendCh)
+			h.appendMu.Unlock()
+			close(h.doneCh)
+			h.wg.Wait()
+			h.store.close()
+			return
+		}
 		close(h.logCh)
 	}
 	h.wg.Wait()
 }
 
+// QueueLength - returns the number of log entries waiting to be sent
+func (h *Target) QueueLength() int {
+	if h.store != nil {
+		return h.store.len()
+	}
+	return len(h.logCh)
+}
+
+// FailedRequests - returns the number of POST requests that failed
+func (h *Target) FailedRequests() int64 {
+	return atomic.LoadInt64(&h.failedRequests)
+}
+
+// DeadLetters - returns the number of entries moved to the dead letter file
+func (h *Target) DeadLetters() int64 {
+	return atomic.LoadInt64(&h.deadLetters)
+}
+
 // Type - returns type of the target
 func (h *Target) Type() types.TargetType {
 	return types.TargetHTTP
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package http
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// receiver records the entries of the POSTs it accepts while it is up, it rejects the POSTs holding an
+// invalid entry
+type receiver struct {
+	up       atomic.Bool
+	mu       sync.Mutex
+	entries  []string
+	requests int
+	invalid  string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if !r.up.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests++
+	var entries []string
+	scanner := bufio.NewScanner(req.Body)
+	for scanner.Scan() {
+		entry := scanner.Text()
+		if r.invalid != "" && entry == r.invalid {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if entry != "{}" {
+			entries = append(entries, entry)
+		}
+	}
+	r.entries = append(r.entries, entries...)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *receiver) received() ([]string, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.entries...), r.requests
+}
+
+func TestTargetQueueDir(t *testing.T) {
+	funcAssert := assert.New(t)
+	retryMinBackoff, retryMaxBackoff = 10*time.Millisecond, 50*time.Millisecond
+	recv := &receiver{}
+	srv := httptest.NewServer(recv)
+	defer srv.Close()
+	dir := t.TempDir()
+	config := Config{Enabled: true, Endpoint: srv.URL, QueueSize: 3, QueueDir: dir, BatchSize: 10}
+
+	// Test-1: the receiver is down, the entries are kept on disk up to the queue size
+	target := New(config)
+	funcAssert.NoError(target.Init())
+	for _, entry := range []string{"a", "b", "c"} {
+		funcAssert.NoError(target.Send(entry, ""))
+	}
+	funcAssert.ErrorIs(target.Send("d", ""), errQueueFull)
+	funcAssert.Equal(3, target.QueueLength())
+	// Send returns once the entries are on disk
+	segment, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf("%020d%s", 1, queueSegmentExt)))
+	funcAssert.NoError(err)
+	funcAssert.Equal("\"a\"\n\"b\"\n\"c\"\n", string(segment))
+	funcAssert.Eventually(func() bool {
+		return target.FailedRequests() > 1
+	}, 5*time.Second, 10*time.Millisecond)
+	target.Cancel()
+
+	// Test-2: the entries left by the previous run are sent in a single batch, in order
+	recv.up.Store(true)
+	target = New(config)
+	funcAssert.NoError(target.Init())
+	funcAssert.Eventually(func() bool {
+		return target.QueueLength() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	entries, requests := recv.received()
+	funcAssert.Equal([]string{`"a"`, `"b"`, `"c"`}, entries)
+	// the endpoint check and the batch
+	funcAssert.Equal(2, requests)
+
+	funcAssert.NoError(target.Send("e", ""))
+	funcAssert.Eventually(func() bool {
+		entries, _ := recv.received()
+		return len(entries) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	target.Cancel()
+}
+
+func TestTargetDeadLetter(t *testing.T) {
+	funcAssert := assert.New(t)
+	retryMinBackoff, retryMaxBackoff = 10*time.Millisecond, 50*time.Millisecond
+	recv := &receiver{invalid: `"b"`}
+	recv.up.Store(true)
+	srv := httptest.NewServer(recv)
+	defer srv.Close()
+	dir := t.TempDir()
+
+	// Test-1: an entry the endpoint rejects is moved to the dead letter file after the attempts, the others are sent
+	target := New(Config{Enabled: true, Endpoint: srv.URL, QueueSize: 10, QueueDir: dir, BatchSize: 10, MaxAttempts: 2})
+	funcAssert.NoError(target.Init())
+	for _, entry := range []string{"a", "b", "c"} {
+		funcAssert.NoError(target.Send(entry, ""))
+	}
+	funcAssert.Eventually(func() bool {
+		return target.QueueLength() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	target.Cancel()
+	entries, _ := recv.received()
+	funcAssert.Equal([]string{`"a"`, `"c"`}, entries)
+	funcAssert.Equal(int64(1), target.DeadLetters())
+	deadLetters, err := os.ReadFile(filepath.Join(dir, queueDeadLetterFile))
+	funcAssert.NoError(err)
+	funcAssert.Equal("\"b\"\n", string(deadLetters))
+
+	// Test-2: the client errors due to the endpoint don't reject the entries
+	funcAssert.True(rejectedResponseStatusCode(http.StatusRequestEntityTooLarge))
+	funcAssert.False(rejectedResponseStatusCode(http.StatusForbidden))
+	funcAssert.False(rejectedResponseStatusCode(http.StatusTooManyRequests))
+	funcAssert.False(rejectedResponseStatusCode(http.StatusBadGateway))
+}
+
+func TestQueueStore(t *testing.T) {
+	funcAssert := assert.New(t)
+	defer func(size int64) {
+		queueSegmentSize = size
+	}(queueSegmentSize)
+	queueSegmentSize = 8
+	dir := t.TempDir()
+
+	// Test-1: the entries are appended to segments and read in order
+	store, err := newQueueStore(dir, 0)
+	funcAssert.NoError(err)
+	for _, entry := range []string{`"a"`, `"b"`, `"c"`, `"d"`, `"e"`} {
+		funcAssert.NoError(store.append([][]byte{[]byte(entry)}))
+	}
+	batch, err := store.peek(2)
+	funcAssert.NoError(err)
+	funcAssert.Equal([][]byte{[]byte(`"a"`), []byte(`"b"`)}, batch)
+	segments, _ := filepath.Glob(filepath.Join(dir, "*"+queueSegmentExt))
+	funcAssert.Len(segments, 3)
+
+	// Test-2: the segments sent are removed
+	funcAssert.NoError(store.ack(3))
+	segments, _ = filepath.Glob(filepath.Join(dir, "*"+queueSegmentExt))
+	funcAssert.Len(segments, 2)
+	store.close()
+
+	// Test-3: the index is rebuilt from the cursor and a partially written entry is dropped
+	f, err := os.OpenFile(segments[len(segments)-1], os.O_WRONLY|os.O_APPEND, 0o600)
+	funcAssert.NoError(err)
+	_, err = f.WriteString(`"f`)
+	funcAssert.NoError(err)
+	funcAssert.NoError(f.Close())
+	store, err = newQueueStore(dir, 0)
+	funcAssert.NoError(err)
+	funcAssert.Equal(2, store.len())
+	batch, err = store.peek(10)
+	funcAssert.NoError(err)
+	funcAssert.Equal([][]byte{[]byte(`"d"`), []byte(`"e"`)}, batch)
+	funcAssert.NoError(store.append([][]byte{[]byte(`"g"`)}))
+	funcAssert.NoError(store.ack(2))
+	batch, err = store.peek(10)
+	funcAssert.NoError(err)
+	funcAssert.Equal([][]byte{[]byte(`"g"`)}, batch)
+	store.close()
+}
+
+func TestTargetBatch(t *testing.T) {
+	funcAssert := assert.New(t)
+	recv := &receiver{}
+	recv.up.Store(true)
+	srv := httptest.NewServer(recv)
+	defer srv.Close()
+
+	target := New(Config{Enabled: true, Endpoint: srv.URL, QueueSize: 10, BatchSize: 2})
+	funcAssert.NoError(target.send([][]byte{[]byte(`"a"`), []byte(`"b"`)}))
+	entries, requests := recv.received()
+	funcAssert.Equal([]string{`"a"`, `"b"`}, entries)
+	funcAssert.Equal(1, requests)
+
+	recv.up.Store(false)
+	funcAssert.Error(target.send([][]byte{[]byte(`"c"`)}))
+	funcAssert.Equal(int64(1), target.FailedRequests())
+}
+
+func TestNextBackoff(t *testing.T) {
+	funcAssert := assert.New(t)
+	retryMinBackoff, retryMaxBackoff = time.Second, time.Minute
+	funcAssert.Equal(time.Second, nextBackoff(0))
+	funcAssert.Equal(2*time.Second, nextBackoff(time.Second))
+	funcAssert.Equal(time.Minute, nextBackoff(40*time.Second))
+}
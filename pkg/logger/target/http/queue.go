@@ -0,0 +1,375 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package http
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Files of the queue directory, the files being written have an additional .tmp
+const (
+	queueSegmentExt     = ".seg"
+	queueCursorFile     = "cursor.json"
+	queueDeadLetterFile = "dead-letter.json"
+)
+
+// queueSegmentSize is the size after which the entries are appended to a new segment, so the segments whose
+// entries were all sent can be removed
+var queueSegmentSize int64 = 16 << 20
+
+// errQueueFull is returned when the queue directory holds its maximum number of entries
+var errQueueFull = errors.New("queue directory full")
+
+// queueEntry locates a queued entry in its segment
+type queueEntry struct {
+	segment uint64
+	offset  int64
+	length  int64
+}
+
+// queueCursor is the position of the first entry not sent yet
+type queueCursor struct {
+	Segment uint64 `json:"segment"`
+	Offset  int64  `json:"offset"`
+}
+
+// queueStore keeps the entries waiting to be sent in a directory, so they survive a restart of the console.
+// The entries are appended as lines to segment files whose names sort in the order they were written, and the
+// cursor file holds the position of the first entry not sent yet. The positions of the queued entries are
+// indexed in memory when the queue is opened.
+//
+// One goroutine appends the entries and another one reads and acknowledges them. The goroutines logging reserve
+// their room in the queue before handing their entry over to the appending one.
+type queueStore struct {
+	dir   string
+	limit int
+
+	mu sync.Mutex
+	// count is the number of entries queued or handed over to the appending goroutine
+	count int
+
+	// filesMu guards the segments and the index of the queued entries
+	filesMu    sync.Mutex
+	entries    []queueEntry
+	first      uint64
+	readers    map[uint64]*os.File
+	writer     *os.File
+	writerID   uint64
+	writerSize int64
+}
+
+// newQueueStore opens the queue directory, creating it when missing. The entries left by a previous run are
+// indexed, the segments already sent and the partially written files are removed.
+func newQueueStore(dir string, limit int) (*queueStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	q := &queueStore{dir: dir, limit: limit, readers: map[uint64]*os.File{}}
+	cursor, err := q.readCursor()
+	if err != nil {
+		return nil, err
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var segments []uint64
+	for _, f := range files {
+		switch name := f.Name(); {
+		case strings.HasSuffix(name, ".tmp"):
+			os.Remove(filepath.Join(dir, name))
+		case strings.HasSuffix(name, queueSegmentExt):
+			id, err := strconv.ParseUint(strings.TrimSuffix(name, queueSegmentExt), 10, 64)
+			if err != nil {
+				continue
+			}
+			if id < cursor.Segment {
+				os.Remove(filepath.Join(dir, name))
+				continue
+			}
+			segments = append(segments, id)
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+
+	q.first, q.writerID = max(cursor.Segment, 1), max(cursor.Segment, 1)
+	if len(segments) > 0 {
+		q.first, q.writerID = segments[0], segments[len(segments)-1]
+	}
+	for _, id := range segments {
+		offset := int64(0)
+		if id == cursor.Segment {
+			offset = cursor.Offset
+		}
+		if err := q.index(id, offset); err != nil {
+			return nil, err
+		}
+	}
+	q.count = len(q.entries)
+	if err := q.openWriter(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func (q *queueStore) segmentPath(id uint64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", id, queueSegmentExt))
+}
+
+// index adds the entries of a segment from the offset to the index, a line partially written by a crash is
+// truncated
+func (q *queueStore) index(id uint64, offset int64) error {
+	f, err := os.OpenFile(q.segmentPath(id), os.O_RDWR, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				return f.Truncate(offset)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(line) > 1 {
+			q.entries = append(q.entries, queueEntry{segment: id, offset: offset, length: int64(len(line) - 1)})
+		}
+		offset += int64(len(line))
+	}
+}
+
+// openWriter opens the segment the entries are appended to
+func (q *queueStore) openWriter() error {
+	f, err := os.OpenFile(q.segmentPath(q.writerID), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	if err := syncDir(q.dir); err != nil {
+		f.Close()
+		return err
+	}
+	q.writer, q.writerSize = f, info.Size()
+	return nil
+}
+
+// reserve takes the room of an entry in the queue before it is handed over to the appending goroutine
+func (q *queueStore) reserve() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.limit > 0 && q.count >= q.limit {
+		return errQueueFull
+	}
+	q.count++
+	return nil
+}
+
+// release frees the room of entries sent, dropped or that couldn't be queued
+func (q *queueStore) release(n int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.count = max(q.count-n, 0)
+}
+
+// len returns the number of entries waiting to be sent
+func (q *queueStore) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.count
+}
+
+// queued returns the number of entries written to the queue directory
+func (q *queueStore) queued() int {
+	q.filesMu.Lock()
+	defer q.filesMu.Unlock()
+	return len(q.entries)
+}
+
+// append writes entries to the segment with a single fsync, the entries are JSON documents without newlines
+func (q *queueStore) append(entries [][]byte) error {
+	q.filesMu.Lock()
+	defer q.filesMu.Unlock()
+	if q.writerSize >= queueSegmentSize {
+		q.writer.Close()
+		q.writerID++
+		if err := q.openWriter(); err != nil {
+			return err
+		}
+	}
+	var buf bytes.Buffer
+	positions := make([]queueEntry, 0, len(entries))
+	for _, entry := range entries {
+		positions = append(positions, queueEntry{segment: q.writerID, offset: q.writerSize + int64(buf.Len()), length: int64(len(entry))})
+		buf.Write(entry)
+		buf.WriteByte('\n')
+	}
+	if _, err := q.writer.Write(buf.Bytes()); err != nil {
+		q.writer.Truncate(q.writerSize)
+		return err
+	}
+	if err := q.writer.Sync(); err != nil {
+		q.writer.Truncate(q.writerSize)
+		return err
+	}
+	q.writerSize += int64(buf.Len())
+	q.entries = append(q.entries, positions...)
+	return nil
+}
+
+// peek returns up to n queued entries, oldest first. The entries read are returned along with the error of
+// the first one that couldn't be.
+func (q *queueStore) peek(n int) ([][]byte, error) {
+	q.filesMu.Lock()
+	defer q.filesMu.Unlock()
+	n = min(n, len(q.entries))
+	batch := make([][]byte, 0, n)
+	for _, entry := range q.entries[:n] {
+		reader, ok := q.readers[entry.segment]
+		if !ok {
+			f, err := os.Open(q.segmentPath(entry.segment))
+			if err != nil {
+				return batch, err
+			}
+			q.readers[entry.segment] = f
+			reader = f
+		}
+		data := make([]byte, entry.length)
+		if _, err := reader.ReadAt(data, entry.offset); err != nil {
+			return batch, err
+		}
+		batch = append(batch, data)
+	}
+	return batch, nil
+}
+
+// ack removes the n oldest entries once they were sent, the cursor is written first so they are sent again
+// when it can't be
+func (q *queueStore) ack(n int) error {
+	q.filesMu.Lock()
+	defer q.filesMu.Unlock()
+	n = min(n, len(q.entries))
+	if n == 0 {
+		return nil
+	}
+	last := q.entries[n-1]
+	cursor := queueCursor{Segment: last.segment, Offset: last.offset + last.length + 1}
+	if err := q.writeCursor(cursor); err != nil {
+		return err
+	}
+	q.entries = q.entries[n:]
+	q.release(n)
+	// the segments before the cursor were all sent
+	for ; q.first < cursor.Segment; q.first++ {
+		if reader, ok := q.readers[q.first]; ok {
+			reader.Close()
+			delete(q.readers, q.first)
+		}
+		os.Remove(q.segmentPath(q.first))
+	}
+	return nil
+}
+
+// deadLetter appends an entry the endpoint keeps rejecting to the dead letter file, it is removed from the
+// queue once acknowledged
+func (q *queueStore) deadLetter(entry []byte) error {
+	f, err := os.OpenFile(filepath.Join(q.dir, queueDeadLetterFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Write(append(entry, '\n')); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func (q *queueStore) readCursor() (queueCursor, error) {
+	var cursor queueCursor
+	data, err := os.ReadFile(filepath.Join(q.dir, queueCursorFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return cursor, nil
+	}
+	if err != nil {
+		return cursor, err
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return cursor, fmt.Errorf("invalid queue cursor %s: %w", filepath.Join(q.dir, queueCursorFile), err)
+	}
+	return cursor, nil
+}
+
+// writeCursor replaces the cursor file, it is written aside and renamed so a crash never leaves a partial one
+func (q *queueStore) writeCursor(cursor queueCursor) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(q.dir, queueCursorFile)
+	f, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(path+".tmp", path)
+	}
+	if err != nil {
+		os.Remove(path + ".tmp")
+		return err
+	}
+	return syncDir(q.dir)
+}
+
+// close closes the segments, the queued entries are sent on the next start
+func (q *queueStore) close() {
+	q.filesMu.Lock()
+	defer q.filesMu.Unlock()
+	for id, reader := range q.readers {
+		reader.Close()
+		delete(q.readers, id)
+	}
+	if q.writer != nil {
+		q.writer.Close()
+	}
+}
+
+// syncDir flushes the entries of a directory, so the files created or renamed in it survive a crash
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
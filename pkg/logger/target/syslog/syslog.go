@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package syslog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openstor/console/pkg/logger/target/types"
+)
+
+// Timeout for connecting and writing to the syslog server
+const syslogCallTimeout = 5 * time.Second
+
+// severityInfo is the syslog severity of the log entries
+const severityInfo = 6
+
+// Networks of the syslog server
+const (
+	NetworkUDP  = "udp"
+	NetworkTCP  = "tcp"
+	NetworkUnix = "unix"
+)
+
+// Facilities maps the syslog facility names to their code
+var Facilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"audit":    13,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// Config syslog logger target
+type Config struct {
+	Enabled bool   `json:"enabled"`
+	Name    string `json:"name"`
+	// Network is udp, tcp or unix
+	Network   string `json:"network"`
+	Address   string `json:"address"`
+	Facility  int    `json:"facility"`
+	AppName   string `json:"appName"`
+	QueueSize int    `json:"queueSize"`
+
+	// Custom logger
+	LogOnce func(ctx context.Context, err error, id interface{}, errKind ...interface{}) `json:"-"`
+}
+
+// Target implements logger.Target and sends the json
+// format of a log entry to a syslog server as the message
+// of a RFC 5424 record. Over TCP and unix stream sockets the
+// records are framed by octet counting (RFC 6587).
+type Target struct {
+	status int32
+	wg     sync.WaitGroup
+
+	// Channel of log entries
+	logCh chan interface{}
+
+	// conn and stream are only used by the sender routine,
+	// stream is set when the records are framed by octet counting
+	conn     net.Conn
+	stream   bool
+	hostname string
+
+	config Config
+}
+
+// Endpoint returns the address of the syslog server
+func (s *Target) Endpoint() string {
+	return s.config.Network + "://" + s.config.Address
+}
+
+func (s *Target) String() string {
+	return s.config.Name
+}
+
+// Init validate and initialize the syslog target
+func (s *Target) Init() error {
+	switch s.config.Network {
+	case NetworkUDP, NetworkTCP, NetworkUnix:
+	default:
+		return fmt.Errorf("invalid syslog network %s, it must be udp, tcp or unix", s.config.Network)
+	}
+	if s.config.Address == "" {
+		return errors.New("the address of the syslog server is required")
+	}
+	if s.config.Facility < 0 || s.config.Facility > 23 {
+		return fmt.Errorf("invalid syslog facility %d", s.config.Facility)
+	}
+	if err := s.connect(); err != nil {
+		return err
+	}
+	s.status = 1
+	s.startSyslogLogger()
+	return nil
+}
+
+func (s *Target) connect() error {
+	network := s.config.Network
+	if network == NetworkUnix {
+		// local syslog daemons usually listen on a datagram socket
+		conn, err := net.DialTimeout("unixgram", s.config.Address, syslogCallTimeout)
+		if err == nil {
+			s.conn, s.stream = conn, false
+			return nil
+		}
+	}
+	conn, err := net.DialTimeout(network, s.config.Address, syslogCallTimeout)
+	if err != nil {
+		return err
+	}
+	s.conn, s.stream = conn, network != NetworkUDP
+	return nil
+}
+
+// format returns the RFC 5424 record of the message
+func (s *Target) format(msg []byte, now time.Time) []byte {
+	appName := s.config.AppName
+	if appName == "" {
+		appName = "console"
+	}
+	// <PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+	record := fmt.Sprintf("<%d>1 %s %s %s %d - - ", s.config.Facility*8+severityInfo,
+		now.UTC().Format(time.RFC3339Nano), s.hostname, appName, os.Getpid())
+	return append([]byte(record), msg...)
+}
+
+func (s *Target) write(record []byte) error {
+	if s.conn == nil {
+		if err := s.connect(); err != nil {
+			return err
+		}
+	}
+	if s.stream {
+		record = append([]byte(strconv.Itoa(len(record))+" "), record...)
+	}
+	s.conn.SetWriteDeadline(time.Now().Add(syslogCallTimeout))
+	_, err := s.conn.Write(record)
+	if err != nil {
+		// reconnect on the next entry
+		s.conn.Close()
+		s.conn = nil
+	}
+	return err
+}
+
+func (s *Target) logEntry(entry interface{}) {
+	logJSON, err := json.Marshal(&entry)
+	if err != nil {
+		return
+	}
+	ctx := context.Background()
+	if err := s.write(s.format(logJSON, time.Now())); err != nil {
+		// retry once on a new connection, the server may have closed the previous one
+		if err = s.write(s.format(logJSON, time.Now())); err != nil {
+			s.config.LogOnce(ctx, fmt.Errorf("%s returned '%w', please check your syslog configuration", s.Endpoint(), err), s.Endpoint())
+		}
+	}
+}
+
+func (s *Target) startSyslogLogger() {
+	// Create a routine which sends json logs received
+	// from an internal channel.
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for entry := range s.logCh {
+			s.logEntry(entry)
+		}
+		if s.conn != nil {
+			s.conn.Close()
+		}
+	}()
+}
+
+// New initializes a new logger target which
+// sends log to the specified syslog server
+func New(config Config) *Target {
+	if config.LogOnce == nil {
+		config.LogOnce = func(context.Context, error, interface{}, ...interface{}) {}
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &Target{
+		logCh:    make(chan interface{}, config.QueueSize),
+		hostname: strings.ReplaceAll(hostname, " ", "_"),
+		config:   config,
+	}
+}
+
+// Send log message 'e' to syslog target.
+func (s *Target) Send(entry interface{}, _ string) error {
+	if atomic.LoadInt32(&s.status) == 0 {
+		// Channel was closed or used before init.
+		return nil
+	}
+
+	select {
+	case s.logCh <- entry:
+	default:
+		// log channel is full, do not wait and return
+		// an errors immediately to the caller
+		return errors.New("log buffer full")
+	}
+
+	return nil
+}
+
+// Cancel - cancels the target
+func (s *Target) Cancel() {
+	if atomic.CompareAndSwapInt32(&s.status, 1, 0) {
+		close(s.logCh)
+	}
+	s.wg.Wait()
+}
+
+// QueueLength - returns the number of log entries waiting to be sent
+func (s *Target) QueueLength() int {
+	return len(s.logCh)
+}
+
+// Type - returns type of the target
+func (s *Target) Type() types.TargetType {
+	return types.TargetSyslog
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package syslog
+
+import (
+	"bufio"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var recordRegexp = regexp.MustCompile(`^<134>1 \S+ \S+ console-test \d+ - - \{"api":"ListBuckets"\}$`)
+
+func TestTargetUDP(t *testing.T) {
+	funcAssert := assert.New(t)
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	target := New(Config{Enabled: true, Network: NetworkUDP, Address: server.LocalAddr().String(), Facility: Facilities["local0"], AppName: "console-test", QueueSize: 10})
+	funcAssert.NoError(target.Init())
+	funcAssert.NoError(target.Send(map[string]string{"api": "ListBuckets"}, ""))
+
+	buf := make([]byte, 1024)
+	server.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := server.ReadFrom(buf)
+	funcAssert.NoError(err)
+	funcAssert.Regexp(recordRegexp, string(buf[:n]))
+	target.Cancel()
+}
+
+func TestTargetTCP(t *testing.T) {
+	funcAssert := assert.New(t)
+	server, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	records := make(chan string, 2)
+	go func() {
+		conn, err := server.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		for {
+			// octet counting framing: MSG-LEN SP SYSLOG-MSG
+			length, err := reader.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, _ := strconv.Atoi(strings.TrimSpace(length))
+			record := make([]byte, n)
+			if _, err := reader.Read(record); err != nil {
+				return
+			}
+			records <- string(record)
+		}
+	}()
+
+	target := New(Config{Enabled: true, Network: NetworkTCP, Address: server.Addr().String(), Facility: Facilities["local0"], AppName: "console-test", QueueSize: 10})
+	funcAssert.NoError(target.Init())
+	funcAssert.NoError(target.Send(map[string]string{"api": "ListBuckets"}, ""))
+	funcAssert.NoError(target.Send(map[string]string{"api": "ListBuckets"}, ""))
+	for i := 0; i < 2; i++ {
+		select {
+		case record := <-records:
+			funcAssert.Regexp(recordRegexp, record)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the record wasn't received")
+		}
+	}
+	target.Cancel()
+}
+
+func TestTargetInit(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+	}{
+		{
+			name:   "invalid network",
+			config: Config{Network: "http", Address: "127.0.0.1:514"},
+		},
+		{
+			name:   "missing address",
+			config: Config{Network: NetworkUDP},
+		},
+		{
+			name:   "invalid facility",
+			config: Config{Network: NetworkUDP, Address: "127.0.0.1:514", Facility: 24},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, New(tt.config).Init())
+		})
+	}
+}
@@ -12,4 +12,6 @@ const (
 	_ TargetType = iota
 	TargetConsole
 	TargetHTTP
+	TargetFile
+	TargetSyslog
 )
@@ -8,7 +8,9 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/openstor/console/pkg/logger/target/file"
 	"github.com/openstor/console/pkg/logger/target/http"
+	"github.com/openstor/console/pkg/logger/target/syslog"
 	"github.com/openstor/console/pkg/logger/target/types"
 )
 
@@ -123,6 +125,20 @@ func cancelAuditTargetType(t types.TargetType) {
 	}
 }
 
+// swapAuditTargets swaps the audit targets of type t with the updated ones, preserving the other types
+func swapAuditTargets(t types.TargetType, updated []Target) {
+	swapMu.Lock()
+	for _, tgt := range auditTargets {
+		if tgt.Type() != t {
+			updated = append(updated, tgt)
+		}
+	}
+	atomic.StoreInt32(&nAuditTargets, int32(len(updated)))
+	cancelAuditTargetType(t) // cancel running targets
+	auditTargets = updated
+	swapMu.Unlock()
+}
+
 // UpdateAuditWebhookTargets swaps audit webhook targets with newly loaded ones from the cfg
 func UpdateAuditWebhookTargets(cfg Config) error {
 	updated, err := initSystemTargets(cfg.AuditWebhook)
@@ -130,10 +146,54 @@ func UpdateAuditWebhookTargets(cfg Config) error {
 		return err
 	}
 
-	swapMu.Lock()
-	atomic.StoreInt32(&nAuditTargets, int32(len(updated)))
-	cancelAuditTargetType(types.TargetHTTP) // cancel running targets
-	auditTargets = updated
-	swapMu.Unlock()
+	swapAuditTargets(types.TargetHTTP, updated)
+	return nil
+}
+
+func initFileTargets(cfgMap map[string]file.Config) (tgts []Target, err error) {
+	for _, l := range cfgMap {
+		if l.Enabled {
+			t := file.New(l)
+			if err = t.Init(); err != nil {
+				return tgts, err
+			}
+			tgts = append(tgts, t)
+		}
+	}
+	return tgts, err
+}
+
+// UpdateAuditFileTargets swaps audit file targets with newly loaded ones from the cfg
+func UpdateAuditFileTargets(cfg Config) error {
+	updated, err := initFileTargets(cfg.AuditFile)
+	if err != nil {
+		return err
+	}
+
+	swapAuditTargets(types.TargetFile, updated)
+	return nil
+}
+
+func initSyslogTargets(cfgMap map[string]syslog.Config) (tgts []Target, err error) {
+	for _, l := range cfgMap {
+		if l.Enabled {
+			t := syslog.New(l)
+			if err = t.Init(); err != nil {
+				return tgts, err
+			}
+			tgts = append(tgts, t)
+		}
+	}
+	return tgts, err
+}
+
+// UpdateAuditSyslogTargets swaps audit syslog targets with newly loaded ones from the cfg
+func UpdateAuditSyslogTargets(cfg Config) error {
+	updated, err := initSyslogTargets(cfg.AuditSyslog)
+	if err != nil {
+		return err
+	}
+
+	swapAuditTargets(types.TargetSyslog, updated)
 	return nil
 }
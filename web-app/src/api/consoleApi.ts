@@ -142,6 +142,8 @@ export interface User {
   memberOf?: string[];
   status?: string;
   hasPolicy?: boolean;
+  expiresAt?: string;
+  deleteAt?: string;
 }
 
 export interface ListUsersResponse {
@@ -156,6 +158,48 @@ export interface AddUserRequest {
   secretKey: string;
   groups: string[];
   policies: string[];
+  /** RFC 3339 date the user is disabled at */
+  expiresAt?: string;
+  /** how long the user is kept once disabled before it is deleted, e.g. 720h, it is never deleted when empty */
+  retention?: string;
+}
+
+export interface UserExpiryRequest {
+  /** RFC 3339 date the user is disabled at, the expiry is cleared when empty */
+  expiresAt?: string;
+  /** how long the user is kept once disabled before it is deleted, e.g. 720h, it is never deleted when empty */
+  retention?: string;
+}
+
+export interface BulkProvisionUsersRequest {
+  /** CSV rows with a header, groups and policies are separated by semicolons */
+  csv?: string;
+  users?: BulkProvisionUser[];
+  generateSecrets?: boolean;
+  dryRun?: boolean;
+}
+
+export interface BulkProvisionUser {
+  accessKey?: string;
+  secretKey?: string;
+  groups?: string[];
+  policies?: string[];
+  status?: string;
+}
+
+export interface BulkProvisionUsersResponse {
+  applied?: boolean;
+  results?: BulkProvisionUserResult[];
+  /** CSV file with the generated secret keys, only returned once */
+  credentialsFile?: string;
+}
+
+export interface BulkProvisionUserResult {
+  /** @format int32 */
+  row?: number;
+  accessKey?: string;
+  status?: string;
+  errors?: string[];
 }
 
 export interface Group {
@@ -183,6 +227,8 @@ export interface ListGroupsResponse {
 export interface Policy {
   name?: string;
   policy?: string;
+  /** Why the change of the policy couldn't be recorded in its history, empty when it was */
+  historyError?: string;
 }
 
 /** @default "user" */
@@ -213,6 +259,124 @@ export type IamEntity = string;
 export interface AddPolicyRequest {
   name: string;
   policy: string;
+  /** Optional comment stored in the policy history */
+  comment?: string;
+}
+
+export interface RollbackPolicyRequest {
+  comment?: string;
+}
+
+export interface PolicyHistoryResponse {
+  entries?: PolicyHistoryEntry[];
+  /** The changes that can't be read, e.g. because they weren't recorded by the console */
+  errors?: string[];
+}
+
+export interface PolicyHistoryEntry {
+  /** @format int64 */
+  version?: number;
+  policy?: string;
+  action?: string;
+  editor?: string;
+  timestamp?: string;
+  comment?: string;
+  previousDocument?: string;
+  document?: string;
+  diff?: PolicyDiffEntry[];
+}
+
+export interface PolicyDiffEntry {
+  op?: string;
+  path?: string;
+  from?: string;
+  to?: string;
+}
+
+export enum SimulationEntity {
+  User = "user",
+  Group = "group",
+  ServiceAccount = "serviceAccount",
+}
+
+export interface SimulatePolicyRequest {
+  entityType: SimulationEntity;
+  entityName: string;
+  actions: string[];
+  resource: string;
+  conditions?: SimulationCondition[];
+}
+
+export interface SimulationCondition {
+  key: string;
+  values?: string[];
+}
+
+export interface SimulatePolicyResponse {
+  policies?: SimulatedPolicy[];
+  results?: SimulatedActionResult[];
+  /** Issues found getting the policies of the entity, e.g. a mapped policy missing on the server */
+  findings?: string[];
+}
+
+export interface SimulatedPolicy {
+  name?: string;
+  source?: string;
+}
+
+export interface SimulatedActionResult {
+  action?: string;
+  allowed?: boolean;
+  /** Why the action is denied regardless of the policies, e.g. the account is disabled */
+  reason?: string;
+  matchedStatements?: MatchedStatement[];
+}
+
+export interface MatchedStatement {
+  policy?: string;
+  source?: string;
+  sid?: string;
+  effect?: string;
+  statement?: string;
+}
+
+export interface LintPolicyRequest {
+  policy: string;
+}
+
+export interface LintPolicyResponse {
+  /** False when any of the findings is an error */
+  valid?: boolean;
+  findings?: PolicyLintFinding[];
+}
+
+export interface PolicyLintFinding {
+  severity?: "error" | "warning" | "info";
+  code?: string;
+  /**
+   * Index of the statement the finding refers to, -1 for the whole document
+   * @format int32
+   */
+  statement?: number;
+  sid?: string;
+  message?: string;
+  suggestion?: string;
+}
+
+export interface EffectivePermissionsResponse {
+  user?: string;
+  groups?: string[];
+  policies?: SimulatedPolicy[];
+  buckets?: BucketEffectivePermissions[];
+}
+
+export interface BucketEffectivePermissions {
+  bucket?: string;
+  read?: string;
+  write?: string;
+  delete?: string;
+  admin?: string;
+  allowedActions?: string[];
 }
 
 export interface UpdateServiceAccountRequest {
@@ -579,6 +743,96 @@ export interface WidgetDetails {
   targets?: ResultTarget[];
 }
 
+export interface CustomWidget {
+  /** Namespaced ID of the widget, `<namespace>/<widgetId>` */
+  id?: string;
+  namespace?: string;
+  /** @format int32 */
+  widgetId?: number;
+  title?: string;
+  type?: string;
+  /** Where the widget is defined, `file` or `bucket` */
+  source?: string;
+}
+
+export interface CustomWidgetsResponse {
+  widgets?: CustomWidget[];
+  /** Errors of the widget definitions that couldn't be loaded */
+  errors?: string[];
+}
+
+export interface CustomWidgetTestRequest {
+  /** YAML or JSON definition of the widget */
+  definition: string;
+  start?: number;
+  end?: number;
+  /** @format int32 */
+  step?: number;
+}
+
+export interface Alert {
+  id?: string;
+  rule?: string;
+  kind?: string;
+  severity?: string;
+  /** What the alert is about, e.g. the drive, bucket or tier */
+  subject?: string;
+  message?: string;
+  status?: "firing" | "resolved";
+  startsAt?: string;
+  endsAt?: string;
+  silenced?: boolean;
+}
+
+export interface AlertRule {
+  name?: string;
+  kind?:
+    | "driveOffline"
+    | "serverOffline"
+    | "bucketQuota"
+    | "kmsUnreachable"
+    | "tierUnreachable"
+    | "prometheus";
+  severity?: "info" | "warning" | "critical";
+  /**
+   * Quota percentage of the bucketQuota rules, value above which the series of the prometheus rules fire
+   * @format double
+   */
+  threshold?: number;
+  /** Prometheus query of the prometheus rules */
+  query?: string;
+}
+
+export interface AlertSilence {
+  id?: string;
+  /** Rule of the silenced alerts, any rule when empty */
+  rule?: string;
+  /** Subject of the silenced alerts, `*` matches any characters */
+  subject?: string;
+  comment?: string;
+  createdBy?: string;
+  startsAt?: string;
+  endsAt?: string;
+}
+
+export interface AlertSilenceRequest {
+  rule?: string;
+  subject?: string;
+  comment?: string;
+  /** How long the alerts are silenced, e.g. `2h` */
+  duration: string;
+}
+
+export interface AlertsResponse {
+  /** Whether the alert rules are evaluated */
+  enabled?: boolean;
+  alerts?: Alert[];
+  /** Recently resolved alerts, newest first */
+  history?: Alert[];
+  rules?: AlertRule[];
+  silences?: AlertSilence[];
+}
+
 export interface AdminInfoResponse {
   buckets?: number;
   objects?: number;
@@ -778,6 +1032,32 @@ export interface ServiceAccountCreds {
   url?: string;
 }
 
+export interface ServiceAccountNotifications {
+  notifications?: ServiceAccountNotification[];
+}
+
+export interface ServiceAccountNotification {
+  id?: string;
+  kind?:
+    | "expiring"
+    | "expired"
+    | "aged"
+    | "rotated"
+    | "rotationFailed"
+    | "retired";
+  accessKey?: string;
+  parentUser?: string;
+  message?: string;
+  time?: string;
+  expiration?: string;
+  /** Access key replacing a rotated one */
+  newAccessKey?: string;
+  /** Secret key of the replacing access key, only returned once to its owner by the console that rotated it */
+  secretKey?: string;
+  /** When the rotated access key gets deleted */
+  deleteAfter?: string;
+}
+
 export interface RemoteBucket {
   /** @minLength 3 */
   accessKey: string;
@@ -899,6 +1179,32 @@ export interface PrefixAccessPair {
   access?: string;
 }
 
+export interface BucketAccessGrantRequest {
+  prefix?: string;
+  access: "readonly" | "writeonly" | "readwrite";
+  users?: string[];
+  groups?: string[];
+}
+
+export interface BucketAccessRevokeRequest {
+  prefix?: string;
+  /** users losing the access, every user and group loses it when both users and groups are empty */
+  users?: string[];
+  groups?: string[];
+}
+
+export interface BucketAccessGrant {
+  prefix?: string;
+  access?: string;
+  policy?: string;
+  users?: string[];
+  groups?: string[];
+}
+
+export interface BucketAccessGrantsResponse {
+  grants?: BucketAccessGrant[];
+}
+
 export interface PrefixWrapper {
   prefix?: string;
 }
@@ -914,6 +1220,110 @@ export interface ConfigExportResponse {
   status?: string;
 }
 
+export interface IamBundle {
+  /** @format int32 */
+  version?: number;
+  exportedAt?: string;
+  policies?: IamBundlePolicy[];
+  users?: IamBundleUser[];
+  groups?: IamBundleGroup[];
+  policyMappings?: IamBundlePolicyMapping[];
+  serviceAccounts?: IamBundleServiceAccount[];
+}
+
+export interface IamBundlePolicy {
+  name?: string;
+  policy?: string;
+}
+
+export interface IamBundleUser {
+  accessKey?: string;
+  status?: string;
+}
+
+export interface IamBundleGroup {
+  name?: string;
+  status?: string;
+  members?: string[];
+}
+
+export interface IamBundlePolicyMapping {
+  entityType?: string;
+  entity?: string;
+  policies?: string[];
+}
+
+export interface IamBundleServiceAccount {
+  accessKey?: string;
+  parentUser?: string;
+  name?: string;
+  description?: string;
+  status?: string;
+  expiration?: string;
+  impliedPolicy?: boolean;
+  policy?: string;
+}
+
+export interface IamImportRequest {
+  bundle?: IamBundle;
+  dryRun?: boolean;
+  conflictStrategy?: string;
+}
+
+export interface IamImportResponse {
+  dryRun?: boolean;
+  changes?: IamImportChange[];
+}
+
+export interface IamImportChange {
+  entityType?: string;
+  name?: string;
+  action?: string;
+  detail?: string;
+}
+
+export interface StaleIdentityReport {
+  generatedAt?: string;
+  /** @format int32 */
+  disabledDays?: number;
+  findings?: StaleIdentityFinding[];
+}
+
+export interface StaleIdentityFinding {
+  kind?:
+    | "userWithoutPolicy"
+    | "emptyGroup"
+    | "unusedPolicy"
+    | "disabledUser"
+    | "orphanServiceAccount";
+  entityType?: string;
+  name?: string;
+  detail?: string;
+  cleanupAction?: string;
+}
+
+export interface StaleIdentityCleanupRequest {
+  /** @format int32 */
+  disabledDays?: number;
+  items: StaleIdentityCleanupItem[];
+}
+
+export interface StaleIdentityCleanupItem {
+  entityType?: string;
+  name?: string;
+}
+
+export interface StaleIdentityCleanupResponse {
+  results?: StaleIdentityCleanupResult[];
+}
+
+export interface StaleIdentityCleanupResult {
+  entityType?: string;
+  name?: string;
+  status?: string;
+  error?: string;
+}
+
 export interface License {
   email?: string;
   organization?: string;
@@ -1180,6 +1590,37 @@ export interface LdapPolicyEntity {
   groups?: string[];
 }
 
+export interface LdapPolicyAssociationRequest {
+  policies: string[];
+  /** User DNs */
+  users?: string[];
+  /** Group DNs */
+  groups?: string[];
+}
+
+export interface LdapPolicyAssociationResponse {
+  results?: LdapPolicyAssociationResult[];
+}
+
+export interface LdapPolicyAssociationResult {
+  entityType?: string;
+  dn?: string;
+  status?: string;
+  /** Policies actually attached or detached */
+  policies?: string[];
+  error?: string;
+}
+
+export interface LdapEntitiesSearchResponse {
+  results?: LdapEntitySearchResult[];
+}
+
+export interface LdapEntitySearchResult {
+  dn?: string;
+  entityType?: string;
+  policies?: string[];
+}
+
 export interface MaxShareLinkExpResponse {
   /** @format int64 */
   exp: number;
@@ -2720,6 +3161,25 @@ export class Api<
         ...params,
       }),
   };
+  serviceAccountNotifications = {
+    /**
+     * No description
+     *
+     * @tags ServiceAccount
+     * @name ListServiceAccountNotifications
+     * @summary List the expiry and rotation notifications of the service accounts
+     * @request GET:/service-account-notifications
+     * @secure
+     */
+    listServiceAccountNotifications: (params: RequestParams = {}) =>
+      this.request<ServiceAccountNotifications, ApiError>({
+        path: `/service-account-notifications`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+  };
   users = {
     /**
      * No description
@@ -2774,6 +3234,29 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags User
+     * @name BulkProvisionUsers
+     * @summary Validates and creates a list of users, optionally generating their secret keys
+     * @request POST:/users/provision
+     * @secure
+     */
+    bulkProvisionUsers: (
+      body: BulkProvisionUsersRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<BulkProvisionUsersResponse, ApiError>({
+        path: `/users/provision`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -2881,6 +3364,29 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags User
+     * @name UpdateUserExpiry
+     * @summary Set or clear the expiry of a user
+     * @request PUT:/user/{name}/expiry
+     * @secure
+     */
+    updateUserExpiry: (
+      name: string,
+      body: UserExpiryRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/user/${encodeURIComponent(name)}/expiry`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -2921,14 +3427,52 @@ export class Api<
      * No description
      *
      * @tags User
-     * @name ListAUserServiceAccounts
-     * @summary returns a list of service accounts for a user
-     * @request GET:/user/{name}/service-accounts
+     * @name GetUserEffectivePermissions
+     * @summary returns the permissions a user effectively holds on every bucket
+     * @request GET:/user/{name}/effective-permissions
      * @secure
      */
-    listAUserServiceAccounts: (name: string, params: RequestParams = {}) =>
-      this.request<ServiceAccounts, ApiError>({
-        path: `/user/${encodeURIComponent(name)}/service-accounts`,
+    getUserEffectivePermissions: (name: string, params: RequestParams = {}) =>
+      this.request<EffectivePermissionsResponse, ApiError>({
+        path: `/user/${encodeURIComponent(name)}/effective-permissions`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags User
+     * @name ExportUserEffectivePermissions
+     * @summary exports the permissions a user effectively holds on every bucket as CSV
+     * @request GET:/user/{name}/effective-permissions/csv
+     * @secure
+     */
+    exportUserEffectivePermissions: (
+      name: string,
+      params: RequestParams = {},
+    ) =>
+      this.request<File, ApiError>({
+        path: `/user/${encodeURIComponent(name)}/effective-permissions/csv`,
+        method: "GET",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags User
+     * @name ListAUserServiceAccounts
+     * @summary returns a list of service accounts for a user
+     * @request GET:/user/{name}/service-accounts
+     * @secure
+     */
+    listAUserServiceAccounts: (name: string, params: RequestParams = {}) =>
+      this.request<ServiceAccounts, ApiError>({
+        path: `/user/${encodeURIComponent(name)}/service-accounts`,
         method: "GET",
         secure: true,
         format: "json",
@@ -3321,6 +3865,71 @@ export class Api<
         format: "json",
         ...params,
       }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ListBucketAccessGrants
+     * @summary List the users and groups granted access to a prefix of the bucket
+     * @request GET:/bucket/{bucket}/access-grants
+     * @secure
+     */
+    listBucketAccessGrants: (bucket: string, params: RequestParams = {}) =>
+      this.request<BucketAccessGrantsResponse, ApiError>({
+        path: `/bucket/${encodeURIComponent(bucket)}/access-grants`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GrantBucketAccess
+     * @summary Grant users and groups access to a prefix of the bucket
+     * @request PUT:/bucket/{bucket}/access-grants
+     * @secure
+     */
+    grantBucketAccess: (
+      bucket: string,
+      body: BucketAccessGrantRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<BucketAccessGrant, ApiError>({
+        path: `/bucket/${encodeURIComponent(bucket)}/access-grants`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name RevokeBucketAccess
+     * @summary Revoke the access of users and groups to a prefix of the bucket
+     * @request POST:/bucket/{bucket}/access-grants/revoke
+     * @secure
+     */
+    revokeBucketAccess: (
+      bucket: string,
+      body: BucketAccessRevokeRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/bucket/${encodeURIComponent(bucket)}/access-grants/revoke`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
   };
   bucketUsers = {
     /**
@@ -3392,6 +4001,92 @@ export class Api<
         secure: true,
         ...params,
       }),
+
+    /**
+     * No description
+     *
+     * @tags Policy
+     * @name ListPolicyHistory
+     * @summary Lists the changes of a policy along with the differences between versions
+     * @request GET:/policy/{name}/history
+     * @secure
+     */
+    listPolicyHistory: (name: string, params: RequestParams = {}) =>
+      this.request<PolicyHistoryResponse, ApiError>({
+        path: `/policy/${encodeURIComponent(name)}/history`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Policy
+     * @name RollbackPolicy
+     * @summary Reverts a change of a policy restoring the document it replaced
+     * @request POST:/policy/{name}/history/{version}/rollback
+     * @secure
+     */
+    rollbackPolicy: (
+      name: string,
+      version: number,
+      body: RollbackPolicyRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<Policy, ApiError>({
+        path: `/policy/${encodeURIComponent(name)}/history/${version}/rollback`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Policy
+     * @name SimulatePolicy
+     * @summary Simulate the policy evaluation of a user, group or service account
+     * @request POST:/policy/simulate
+     * @secure
+     */
+    simulatePolicy: (
+      body: SimulatePolicyRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<SimulatePolicyResponse, ApiError>({
+        path: `/policy/simulate`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Policy
+     * @name LintPolicy
+     * @summary Lint a policy document for risky or broken constructs
+     * @request POST:/policy/lint
+     * @secure
+     */
+    lintPolicy: (body: LintPolicyRequest, params: RequestParams = {}) =>
+      this.request<LintPolicyResponse, ApiError>({
+        path: `/policy/lint`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
   };
   configs = {
     /**
@@ -3530,6 +4225,93 @@ export class Api<
         ...params,
       }),
   };
+  iam = {
+    /**
+     * No description
+     *
+     * @tags Configuration
+     * @name ExportIam
+     * @summary Export the canned policies, users, groups, policy mappings and service accounts
+     * @request GET:/iam/export
+     * @secure
+     */
+    exportIam: (params: RequestParams = {}) =>
+      this.request<IamBundle, ApiError>({
+        path: `/iam/export`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Configuration
+     * @name ImportIam
+     * @summary Import an IAM bundle previously exported
+     * @request POST:/iam/import
+     * @secure
+     */
+    importIam: (body: IamImportRequest, params: RequestParams = {}) =>
+      this.request<IamImportResponse, ApiError>({
+        path: `/iam/import`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Configuration
+     * @name GetStaleIdentityReport
+     * @summary Report the unused users, groups, policies and service accounts
+     * @request GET:/iam/stale-report
+     * @secure
+     */
+    getStaleIdentityReport: (
+      query?: {
+        /** @format int32 */
+        disabledDays?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<StaleIdentityReport, ApiError>({
+        path: `/iam/stale-report`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Configuration
+     * @name CleanupStaleIdentities
+     * @summary Remove the identities flagged by the stale identity report
+     * @request POST:/iam/stale-report/cleanup
+     * @secure
+     */
+    cleanupStaleIdentities: (
+      body: StaleIdentityCleanupRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<StaleIdentityCleanupResponse, ApiError>({
+        path: `/iam/stale-report/cleanup`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+  };
   setPolicy = {
     /**
      * No description
@@ -3645,6 +4427,136 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags System
+     * @name ListCustomWidgets
+     * @summary Returns the user-defined dashboard widgets
+     * @request GET:/admin/info/custom-widgets
+     * @secure
+     */
+    listCustomWidgets: (params: RequestParams = {}) =>
+      this.request<CustomWidgetsResponse, ApiError>({
+        path: `/admin/info/custom-widgets`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name CustomWidgetDetails
+     * @summary Returns the results of a user-defined dashboard widget
+     * @request GET:/admin/info/custom-widgets/{namespace}/{widgetId}
+     * @secure
+     */
+    customWidgetDetails: (
+      namespace: string,
+      widgetId: number,
+      query?: {
+        start?: number;
+        end?: number;
+        /** @format int32 */
+        step?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WidgetDetails, ApiError>({
+        path: `/admin/info/custom-widgets/${encodeURIComponent(
+          namespace,
+        )}/${encodeURIComponent(widgetId)}`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name TestCustomWidget
+     * @summary Validates a dashboard widget definition and returns the results of its queries
+     * @request POST:/admin/info/custom-widgets/test
+     * @secure
+     */
+    testCustomWidget: (
+      body: CustomWidgetTestRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<WidgetDetails, ApiError>({
+        path: `/admin/info/custom-widgets/test`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name ListAlerts
+     * @summary Returns the firing and recently resolved alerts, the alert rules and the silences
+     * @request GET:/admin/alerts
+     * @secure
+     */
+    listAlerts: (params: RequestParams = {}) =>
+      this.request<AlertsResponse, ApiError>({
+        path: `/admin/alerts`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name CreateAlertSilence
+     * @summary Silences the alerts matching a rule and a subject
+     * @request POST:/admin/alerts/silences
+     * @secure
+     */
+    createAlertSilence: (
+      body: AlertSilenceRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<AlertSilence, ApiError>({
+        path: `/admin/alerts/silences`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name DeleteAlertSilence
+     * @summary Removes an alert silence
+     * @request DELETE:/admin/alerts/silences/{id}
+     * @secure
+     */
+    deleteAlertSilence: (id: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/admin/alerts/silences/${encodeURIComponent(id)}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -4132,6 +5044,84 @@ export class Api<
         format: "json",
         ...params,
       }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name AttachLdapPolicies
+     * @summary Attach policies to LDAP user and group DNs
+     * @request POST:/ldap-entities/policies/attach
+     * @secure
+     */
+    attachLdapPolicies: (
+      body: LdapPolicyAssociationRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<LdapPolicyAssociationResponse, ApiError>({
+        path: `/ldap-entities/policies/attach`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name DetachLdapPolicies
+     * @summary Detach policies from LDAP user and group DNs
+     * @request POST:/ldap-entities/policies/detach
+     * @secure
+     */
+    detachLdapPolicies: (
+      body: LdapPolicyAssociationRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<LdapPolicyAssociationResponse, ApiError>({
+        path: `/ldap-entities/policies/detach`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name SearchLdapEntities
+     * @summary Search the LDAP directory for user and group DNs with the lookup bind
+     * @request GET:/ldap-entities/search
+     * @secure
+     */
+    searchLdapEntities: (
+      query?: {
+        /** Text the user and group names must contain */
+        query?: string;
+        /** Restrict the results to `user` or `group` DNs */
+        type?: string;
+        /**
+         * @format int32
+         * @default 20
+         */
+        limit?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<LdapEntitiesSearchResponse, ApiError>({
+        path: `/ldap-entities/search`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
   };
   releases = {
     /**